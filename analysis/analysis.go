@@ -0,0 +1,32 @@
+package analysis
+
+import (
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+// Analyzer derives documents from the packets of a single source.
+// Packets are given in the order they were received, decoded into their models/packet type
+// ( ie: *packet.PacketLapData ), so an analyzer can follow every car of the session.
+// A new set of analyzers is created for every session, an analyzer never sees two sessions.
+type Analyzer interface {
+	// Analyze returns the documents derived from the packet, if any
+	Analyze(packet interface{}) []models.F1Data
+	// Flush is called once the session is over and returns the documents still pending
+	Flush() []models.F1Data
+}
+
+// Factory creates an analyzer for a session received from a source
+type Factory func(source models.Source) Analyzer
+
+// PlayerCars returns the index of the cars driven by the players of the rig, splitscreen included
+func PlayerCars(header f1packet.PacketHeader) []uint8 {
+	cars := []uint8{}
+	if header.PlayerCarIndex < 22 {
+		cars = append(cars, header.PlayerCarIndex)
+	}
+	if header.SecondaryPlayerCarIndex < 22 && header.SecondaryPlayerCarIndex != header.PlayerCarIndex {
+		cars = append(cars, header.SecondaryPlayerCarIndex)
+	}
+	return cars
+}
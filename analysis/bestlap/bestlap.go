@@ -0,0 +1,183 @@
+package bestlap
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/trace"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+const (
+	DocumentType = "theoretical_best"
+
+	// MiniSectors is the number of mini-sectors of equal length a lap is split into
+	MiniSectors = 20
+)
+
+// car holds the best times of a car during the session
+type car struct {
+	laps int
+
+	bestLapTime    float32
+	bestLapNum     uint8
+	bestLapSectors []float32
+
+	sectors [3]models.SectorBest
+
+	minis          []models.MiniSector // Best time in every mini-sector, nil until a complete lap is recorded
+	referenceNum   uint8
+	referenceTime  float32
+	referenceMinis []float32 // Mini-sector times of the best complete lap
+}
+
+// Analyzer computes the theoretical best lap of every car from its best sectors and mini-sectors,
+// keeps the report of the session up to date in reports, and stores it once the session is over.
+type Analyzer struct {
+	source  models.Source
+	reports *Reports
+
+	header      models.Header
+	trackID     int8
+	sessionType uint8
+	cars        map[uint8]*car
+}
+
+// NewFactory returns a trace.ConsumerFactory creating analyzers publishing their reports to reports
+func NewFactory(reports *Reports) trace.ConsumerFactory {
+	return func(source models.Source) trace.Consumer {
+		return &Analyzer{
+			source:  source,
+			reports: reports,
+			cars:    map[uint8]*car{},
+		}
+	}
+}
+
+// Lap ...
+func (a *Analyzer) Lap(lap *trace.Lap) []models.F1Data {
+	// invalid laps and laps through the pit lane do not count towards the best times
+	if !lap.Valid || lap.PitLap || lap.LapTime <= 0 {
+		return nil
+	}
+
+	c, ok := a.cars[lap.CarIndex]
+	if !ok {
+		c = &car{}
+		a.cars[lap.CarIndex] = c
+	}
+	c.laps++
+
+	sectors := []float32{lap.Sector1Time, lap.Sector2Time, lap.Sector3Time()}
+	if c.bestLapTime == 0 || lap.LapTime < c.bestLapTime {
+		c.bestLapTime = lap.LapTime
+		c.bestLapNum = lap.LapNum
+		c.bestLapSectors = sectors
+	}
+	if sectors[2] > 0 {
+		for i, t := range sectors {
+			if c.sectors[i].Time == 0 || t < c.sectors[i].Time {
+				c.sectors[i] = models.SectorBest{Time: t, LapNum: lap.LapNum}
+			}
+		}
+	}
+
+	if lap.Complete && lap.TrackLength > 0 && len(lap.Samples) > 1 {
+		c.mini(lap)
+	}
+
+	a.header = models.NewHeader(lap.Header)
+	a.header.Source = a.source
+	a.trackID = lap.TrackID
+	a.sessionType = lap.SessionType
+	a.reports.Update(a.report())
+	return nil
+}
+
+// Flush returns the report of the session
+func (a *Analyzer) Flush() []models.F1Data {
+	if len(a.cars) == 0 {
+		return nil
+	}
+	report := a.report()
+	a.reports.Update(report)
+	a.cars = map[uint8]*car{}
+	return []models.F1Data{report}
+}
+
+// mini times the mini-sectors of a complete lap
+func (c *car) mini(lap *trace.Lap) {
+	length := float32(lap.TrackLength) / MiniSectors
+	if c.minis == nil {
+		c.minis = make([]models.MiniSector, MiniSectors)
+		for i := range c.minis {
+			c.minis[i] = models.MiniSector{
+				Number: i + 1,
+				From:   float32(i) * length,
+				To:     float32(i+1) * length,
+			}
+		}
+	}
+
+	times := make([]float32, MiniSectors)
+	for i := range c.minis {
+		times[i] = lap.At(c.minis[i].To).SessionTime - lap.At(c.minis[i].From).SessionTime
+		if c.minis[i].BestTime == 0 || times[i] < c.minis[i].BestTime {
+			c.minis[i].BestTime = times[i]
+			c.minis[i].BestLapNum = lap.LapNum
+		}
+	}
+
+	if c.referenceTime == 0 || lap.LapTime < c.referenceTime {
+		c.referenceNum = lap.LapNum
+		c.referenceTime = lap.LapTime
+		c.referenceMinis = times
+	}
+}
+
+// report builds the report of the session from the best times of every car
+func (a *Analyzer) report() *models.TheoreticalBest {
+	report := &models.TheoreticalBest{
+		Header:      a.header,
+		Timestamp:   time.Now().UTC(),
+		Type:        DocumentType,
+		TrackID:     a.trackID,
+		SessionType: a.sessionType,
+		Drivers:     make([]models.DriverBest, 0, len(a.cars)),
+	}
+
+	for index, c := range a.cars {
+		driver := models.DriverBest{
+			CarIndex:       index,
+			Laps:           c.laps,
+			BestLapTime:    c.bestLapTime,
+			BestLapNum:     c.bestLapNum,
+			BestLapSectors: c.bestLapSectors,
+			BestSectors:    append([]models.SectorBest(nil), c.sectors[:]...), // the report is encoded while the next laps update the sectors
+			MiniSectors:    []models.MiniSector{},
+		}
+		if c.sectors[0].Time > 0 {
+			for _, s := range c.sectors {
+				driver.TheoreticalBestTime += s.Time
+			}
+			driver.Gap = driver.BestLapTime - driver.TheoreticalBestTime
+		}
+
+		if c.minis != nil {
+			driver.MiniSectorsReferenceLapNum = c.referenceNum
+			for i, m := range c.minis {
+				m.LapTime = c.referenceMinis[i]
+				m.TimeToBest = m.LapTime - m.BestTime
+				driver.MiniSectorsGap += m.TimeToBest
+				driver.MiniSectors = append(driver.MiniSectors, m)
+			}
+			driver.MiniSectorsTheoreticalBestTime = c.referenceTime - driver.MiniSectorsGap
+		}
+		report.Drivers = append(report.Drivers, driver)
+	}
+
+	sort.Slice(report.Drivers, func(i, j int) bool {
+		return report.Drivers[i].BestLapTime < report.Drivers[j].BestLapTime
+	})
+	return report
+}
@@ -0,0 +1,107 @@
+package bestlap
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/api"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+// MaxReports is the number of reports kept in memory
+const MaxReports = 50
+
+// Reports keeps the latest theoretical best report of the recent sessions of every rig, and serves them over HTTP.
+// The rigs of an online session share its UID, each rig has its own report.
+type Reports struct {
+	mu      sync.Mutex
+	reports map[string]*models.TheoreticalBest // Reports by session UID and source key
+}
+
+func reportKey(report *models.TheoreticalBest) string {
+	return report.Header.SessionUID + "/" + report.Header.Source.Key()
+}
+
+// NewReports creates an empty set of reports
+func NewReports() *Reports {
+	return &Reports{
+		reports: map[string]*models.TheoreticalBest{},
+	}
+}
+
+// Update replaces the report of a session of a rig, the oldest report is dropped past MaxReports
+func (r *Reports) Update(report *models.TheoreticalBest) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.reports[reportKey(report)] = report
+	if len(r.reports) <= MaxReports {
+		return
+	}
+	oldest := ""
+	for key, report := range r.reports {
+		if oldest == "" || report.Timestamp.Before(r.reports[oldest].Timestamp) {
+			oldest = key
+		}
+	}
+	delete(r.reports, oldest)
+}
+
+// Get returns the report of a session of a rig, given by name or by address,
+// the latest report of the session when rig is empty
+func (r *Reports) Get(sessionUID string, rig string) (*models.TheoreticalBest, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var latest *models.TheoreticalBest
+	for _, report := range r.reports {
+		if report.Header.SessionUID != sessionUID {
+			continue
+		}
+		if rig != "" && report.Header.Source.Rig != rig && report.Header.Source.Address != rig {
+			continue
+		}
+		if latest == nil || report.Timestamp.After(latest.Timestamp) {
+			latest = report
+		}
+	}
+	return latest, latest != nil
+}
+
+// List returns the reports of every session, the latest first
+func (r *Reports) List() []*models.TheoreticalBest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reports := make([]*models.TheoreticalBest, 0, len(r.reports))
+	for _, report := range r.reports {
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Timestamp.After(reports[j].Timestamp)
+	})
+	return reports
+}
+
+// ServeHTTP returns the report of the session given by the session query parameter, of the rig given by the rig one,
+// or the reports of every session without it.
+func (r *Reports) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		api.Error(w, http.StatusMethodNotAllowed, "method %s not allowed", req.Method)
+		return
+	}
+
+	uid := req.URL.Query().Get("session")
+	if uid == "" {
+		api.JSON(w, http.StatusOK, r.List())
+		return
+	}
+	rig := req.URL.Query().Get("rig")
+	report, ok := r.Get(uid, rig)
+	if !ok {
+		api.Error(w, http.StatusNotFound, "no report for session %s %s", uid, rig)
+		return
+	}
+	api.JSON(w, http.StatusOK, report)
+}
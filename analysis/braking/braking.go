@@ -0,0 +1,204 @@
+package braking
+
+import (
+	"math"
+	"time"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/trace"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+const (
+	EventDocumentType = "braking_event"
+	LapDocumentType   = "braking_lap"
+
+	// BrakingThreshold is the amount of brake a car is considered braking from
+	BrakingThreshold = 0.05
+	// LockUpSlip is the wheel slip ratio, in absolute value, a wheel is considered locked from
+	LockUpSlip = 0.3
+
+	// minDuration is the duration in seconds of the shortest braking event
+	minDuration = 0.1
+	// matchDistance is the distance in metres under which two braking points of consecutive laps are the same
+	matchDistance = 100
+)
+
+var wheels = [4]string{"RL", "RR", "FL", "FR"}
+
+// Analyzer records every braking event of the player cars, detects lock-ups,
+// and sums them up per lap.
+type Analyzer struct {
+	source models.Source
+
+	previous map[uint8][]float32 // Braking points of the previous lap of each car
+}
+
+// New creates a braking analyzer, it satisfies trace.ConsumerFactory
+func New(source models.Source) trace.Consumer {
+	return &Analyzer{
+		source:   source,
+		previous: map[uint8][]float32{},
+	}
+}
+
+// Lap ...
+func (a *Analyzer) Lap(lap *trace.Lap) []models.F1Data {
+	if !lap.Player() {
+		return nil
+	}
+
+	events := Events(lap)
+
+	summary := &models.BrakingLap{
+		Header:    models.NewHeader(lap.Header),
+		Timestamp: time.Now().UTC(),
+		Type:      LapDocumentType,
+		CarIndex:  lap.CarIndex,
+		LapNum:    lap.LapNum,
+		LapTime:   lap.LapTime,
+		Valid:     lap.Valid,
+		Events:    len(events),
+	}
+	summary.Header.Source = a.source
+
+	docs := make([]models.F1Data, 0, len(events)+1)
+	points := make([]float32, 0, len(events))
+	pressures, decelerations := []float64{}, []float64{}
+	for _, e := range events {
+		e.Header = models.NewHeader(lap.Header)
+		e.Header.Source = a.source
+		docs = append(docs, e)
+
+		points = append(points, e.StartDistance)
+		pressures = append(pressures, float64(e.PeakPressure))
+		decelerations = append(decelerations, float64(e.Deceleration))
+		summary.TotalBrakingTime += e.Duration
+		if e.LockUp {
+			summary.LockUps++
+		}
+	}
+	summary.AveragePeakPressure = float32(analysis.Mean(pressures))
+	summary.AverageDeceleration = float32(analysis.Mean(decelerations))
+	summary.BrakingPointVariation = variation(points, a.previous[lap.CarIndex])
+
+	if lap.Complete {
+		a.previous[lap.CarIndex] = points
+	}
+
+	return append(docs, summary)
+}
+
+// Flush ...
+func (a *Analyzer) Flush() []models.F1Data {
+	return nil
+}
+
+// Events returns the braking events of a lap, the headers are left to be set by the caller
+func Events(lap *trace.Lap) []*models.BrakingEvent {
+	events := []*models.BrakingEvent{}
+
+	var current *models.BrakingEvent
+	end := func(s trace.Sample) {
+		e := current
+		current = nil
+		e.EndDistance = s.Distance
+		e.EndSpeed = s.Speed
+		e.Duration = s.SessionTime - e.StartSessionTime
+		if e.Duration < minDuration {
+			return
+		}
+		e.Deceleration = (e.StartSpeed - e.EndSpeed) / 3.6 / e.Duration / 9.81
+		e.EventNumber = len(events) + 1
+		events = append(events, e)
+	}
+
+	for i, s := range lap.Samples {
+		braking := s.Brake >= BrakingThreshold
+		if !braking {
+			if current != nil {
+				end(s)
+			}
+			continue
+		}
+
+		if current == nil {
+			current = &models.BrakingEvent{
+				Type:             EventDocumentType,
+				CarIndex:         lap.CarIndex,
+				LapNum:           lap.LapNum,
+				StartDistance:    s.Distance,
+				StartSessionTime: s.SessionTime,
+				StartSpeed:       s.Speed,
+				LockedWheels:     []string{},
+			}
+		}
+
+		if s.Brake > current.PeakPressure {
+			current.PeakPressure = s.Brake
+		}
+		if g := float32(math.Abs(float64(s.GForceLongitudinal))); g > current.PeakDeceleration {
+			current.PeakDeceleration = g
+		}
+		current.RearLeftBrakesTemperature = max16(current.RearLeftBrakesTemperature, s.BrakesTemperature[0])
+		current.RearRightBrakesTemperature = max16(current.RearRightBrakesTemperature, s.BrakesTemperature[1])
+		current.FrontLeftBrakesTemperature = max16(current.FrontLeftBrakesTemperature, s.BrakesTemperature[2])
+		current.FrontRightBrakesTemperature = max16(current.FrontRightBrakesTemperature, s.BrakesTemperature[3])
+
+		if s.HasWheels {
+			locked := false
+			for w, slip := range s.WheelSlip {
+				if math.Abs(float64(slip)) < LockUpSlip {
+					continue
+				}
+				locked = true
+				if !contains(current.LockedWheels, wheels[w]) {
+					current.LockedWheels = append(current.LockedWheels, wheels[w])
+				}
+			}
+			if locked {
+				current.LockUp = true
+				if i+1 < len(lap.Samples) {
+					current.LockUpDuration += lap.Samples[i+1].SessionTime - s.SessionTime
+				}
+			}
+		}
+	}
+	if current != nil {
+		end(lap.Samples[len(lap.Samples)-1])
+	}
+	return events
+}
+
+// variation returns the average distance between the braking points and the closest braking points of the previous lap
+func variation(points, previous []float32) float32 {
+	deltas := []float64{}
+	for _, p := range points {
+		closest := math.Inf(1)
+		for _, q := range previous {
+			if d := math.Abs(float64(p - q)); d < closest {
+				closest = d
+			}
+		}
+		if closest <= matchDistance {
+			deltas = append(deltas, closest)
+		}
+	}
+	return float32(analysis.Mean(deltas))
+}
+
+func max16(a, b uint16) uint16 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
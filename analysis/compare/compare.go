@@ -0,0 +1,127 @@
+package compare
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/trace"
+)
+
+const (
+	// DefaultStep is the distance in metres between two points of a comparison
+	DefaultStep = 5
+	// MinStep is the shortest distance in metres between two points of a comparison
+	MinStep = 0.1
+)
+
+// Point is the state of both laps at a distance from the start line
+type Point struct {
+	Distance float32 // Distance around the lap in metres
+
+	SpeedA    float32 // Speed in kilometres per hour
+	SpeedB    float32
+	ThrottleA float32 // Amount of throttle applied (0.0 to 1.0)
+	ThrottleB float32
+	BrakeA    float32 // Amount of brake applied (0.0 to 1.0)
+	BrakeB    float32
+	GearA     int8 // Gear selected (1-8, N=0, R=-1)
+	GearB     int8
+	SteerA    float32 // Steering (-1.0 (full lock left) to 1.0 (full lock right))
+	SteerB    float32
+
+	// Time in seconds B is behind A since the start of the comparison, negative when B is ahead
+	Delta float32
+}
+
+// Comparison overlays two laps aligned by distance, A is the reference lap
+type Comparison struct {
+	A      Info
+	B      Info
+	Step   float32
+	Delta  float32 // Time in seconds B is behind A at the end of the comparison
+	Points []Point
+}
+
+// Compare aligns two laps by distance, every step metres over the distance both laps were recorded on.
+// No point is returned for a step shorter than MinStep.
+func Compare(a, b *trace.Lap, step float32) *Comparison {
+	c := &Comparison{
+		A:      InfoOf(a),
+		B:      InfoOf(b),
+		Step:   step,
+		Points: []Point{},
+	}
+	if len(a.Samples) == 0 || len(b.Samples) == 0 || step < MinStep {
+		return c
+	}
+
+	from, to := a.Samples[0].Distance, a.Samples[len(a.Samples)-1].Distance
+	if d := b.Samples[0].Distance; d > from {
+		from = d
+	}
+	if d := b.Samples[len(b.Samples)-1].Distance; d < to {
+		to = d
+	}
+
+	startA, startB := a.At(from).SessionTime, b.At(from).SessionTime
+	// the distance is computed from the index, adding a short step to a long distance would not advance it
+	for i := 0; from+float32(i)*step <= to; i++ {
+		d := from + float32(i)*step
+		sa, sb := a.At(d), b.At(d)
+		c.Points = append(c.Points, Point{
+			Distance:  d,
+			SpeedA:    sa.Speed,
+			SpeedB:    sb.Speed,
+			ThrottleA: sa.Throttle,
+			ThrottleB: sb.Throttle,
+			BrakeA:    sa.Brake,
+			BrakeB:    sb.Brake,
+			GearA:     sa.Gear,
+			GearB:     sb.Gear,
+			SteerA:    sa.Steer,
+			SteerB:    sb.Steer,
+			Delta:     (sb.SessionTime - startB) - (sa.SessionTime - startA),
+		})
+	}
+	if n := len(c.Points); n > 0 {
+		c.Delta = c.Points[n-1].Delta
+	}
+	return c
+}
+
+// WriteCSV writes the points of the comparison as csv, with a header line
+func (c *Comparison) WriteCSV(w io.Writer) error {
+	out := csv.NewWriter(w)
+	if err := out.Write([]string{
+		"distance",
+		"speed_a", "speed_b",
+		"throttle_a", "throttle_b",
+		"brake_a", "brake_b",
+		"gear_a", "gear_b",
+		"steer_a", "steer_b",
+		"delta",
+	}); err != nil {
+		return err
+	}
+
+	for _, p := range c.Points {
+		if err := out.Write([]string{
+			float(p.Distance),
+			float(p.SpeedA), float(p.SpeedB),
+			float(p.ThrottleA), float(p.ThrottleB),
+			float(p.BrakeA), float(p.BrakeB),
+			strconv.Itoa(int(p.GearA)), strconv.Itoa(int(p.GearB)),
+			float(p.SteerA), float(p.SteerB),
+			float(p.Delta),
+		}); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+func float(f float32) string {
+	return strconv.FormatFloat(float64(f), 'f', 3, 32)
+}
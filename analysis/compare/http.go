@@ -0,0 +1,116 @@
+package compare
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/api"
+)
+
+// LapsHandler lists the laps of the session given by the session query parameter
+func LapsHandler(store *Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			api.Error(w, http.StatusMethodNotAllowed, "method %s not allowed", req.Method)
+			return
+		}
+
+		laps, err := store.Laps(req.URL.Query().Get("session"))
+		if err != nil {
+			api.Error(w, http.StatusBadRequest, "%v", err)
+			return
+		}
+		api.JSON(w, http.StatusOK, laps)
+	})
+}
+
+// Handler compares two laps given by the query parameters:
+//   - session, car and lap for the reference lap, lap defaults to the best valid lap of the car
+//   - with_session, with_car and with_lap for the other lap, they default to the ones of the reference lap
+//   - step, the distance in metres between two points, and format, json or csv
+func Handler(store *Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			api.Error(w, http.StatusMethodNotAllowed, "method %s not allowed", req.Method)
+			return
+		}
+
+		query := req.URL.Query()
+		session := query.Get("session")
+		car, err := uint8Param(query.Get("car"), 0)
+		if err != nil {
+			api.Error(w, http.StatusBadRequest, "invalid car: %v", err)
+			return
+		}
+		lap, err := uint8Param(query.Get("lap"), 0)
+		if err != nil {
+			api.Error(w, http.StatusBadRequest, "invalid lap: %v", err)
+			return
+		}
+
+		withSession := session
+		if s := query.Get("with_session"); s != "" {
+			withSession = s
+		}
+		withCar, err := uint8Param(query.Get("with_car"), car)
+		if err != nil {
+			api.Error(w, http.StatusBadRequest, "invalid with_car: %v", err)
+			return
+		}
+		withLap, err := uint8Param(query.Get("with_lap"), 0)
+		if err != nil {
+			api.Error(w, http.StatusBadRequest, "invalid with_lap: %v", err)
+			return
+		}
+
+		step := float64(DefaultStep)
+		if s := query.Get("step"); s != "" {
+			if step, err = strconv.ParseFloat(s, 32); err != nil || step < MinStep {
+				api.Error(w, http.StatusBadRequest, "invalid step %q, the step is at least %v metres", s, MinStep)
+				return
+			}
+		}
+
+		a, err := store.Get(session, car, lap)
+		if err != nil {
+			lapError(w, err)
+			return
+		}
+		b, err := store.Get(withSession, withCar, withLap)
+		if err != nil {
+			lapError(w, err)
+			return
+		}
+		comparison := Compare(a, b, float32(step))
+
+		switch format := query.Get("format"); format {
+		case "", "json":
+			api.JSON(w, http.StatusOK, comparison)
+		case "csv":
+			w.Header().Set("Content-Type", "text/csv")
+			if err := comparison.WriteCSV(w); err != nil {
+				api.Error(w, http.StatusInternalServerError, "could not write csv: %v", err)
+			}
+		default:
+			api.Error(w, http.StatusBadRequest, "unknown format %q", format)
+		}
+	})
+}
+
+func lapError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrNotFound) {
+		api.Error(w, http.StatusNotFound, "%v", err)
+		return
+	}
+	api.Error(w, http.StatusBadRequest, "%v", err)
+}
+
+func uint8Param(value string, fallback uint8) (uint8, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	v, err := strconv.ParseUint(value, 10, 8)
+	return uint8(v), err
+}
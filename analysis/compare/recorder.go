@@ -0,0 +1,39 @@
+package compare
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/trace"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+// Recorder saves the complete laps of every car to a store, to be compared later on
+type Recorder struct {
+	store *Store
+}
+
+// NewFactory returns a trace.ConsumerFactory creating recorders sharing the same store
+func NewFactory(store *Store) trace.ConsumerFactory {
+	return func(source models.Source) trace.Consumer {
+		return &Recorder{
+			store: store,
+		}
+	}
+}
+
+// Lap ...
+func (r *Recorder) Lap(lap *trace.Lap) []models.F1Data {
+	// a lap is aligned from the start line, partial laps can not be compared
+	if !lap.Complete {
+		return nil
+	}
+	if err := r.store.Save(lap.Resample(DefaultStep)); err != nil {
+		logrus.WithError(err).Errorf("could not save lap %d of car %d", lap.LapNum, lap.CarIndex)
+	}
+	return nil
+}
+
+// Flush ...
+func (r *Recorder) Flush() []models.F1Data {
+	return nil
+}
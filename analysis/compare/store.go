@@ -0,0 +1,224 @@
+package compare
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/trace"
+)
+
+// MaxLaps is the number of laps kept by a store without directory, the oldest laps are dropped first
+const MaxLaps = 500
+
+// ErrNotFound is returned when a lap is not in the store
+var ErrNotFound = errors.New("lap not found")
+
+// Info describes a lap kept in the store
+type Info struct {
+	SessionUID string
+	TrackID    int8
+	CarIndex   uint8
+	LapNum     uint8
+	LapTime    float32
+	Valid      bool
+}
+
+// InfoOf returns the description of a lap
+func InfoOf(lap *trace.Lap) Info {
+	return Info{
+		SessionUID: strconv.FormatUint(lap.Header.SessionUID, 10),
+		TrackID:    lap.TrackID,
+		CarIndex:   lap.CarIndex,
+		LapNum:     lap.LapNum,
+		LapTime:    lap.LapTime,
+		Valid:      lap.Valid,
+	}
+}
+
+// Store keeps the laps of every car of every session.
+// Laps are saved as json files in a directory per session when one is given, and the latest MaxLaps are kept in memory otherwise.
+type Store struct {
+	mu    sync.Mutex
+	dir   string
+	laps  map[string]*trace.Lap // Laps by key, when kept in memory
+	order []string              // Keys of the laps kept in memory, the oldest first
+}
+
+// NewStore creates a store saving the laps to dir, an empty dir keeps the laps in memory only
+func NewStore(dir string) (*Store, error) {
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, errors.Wrapf(err, "could not create lap directory %s", dir)
+		}
+	}
+	return &Store{
+		dir:  dir,
+		laps: map[string]*trace.Lap{},
+	}, nil
+}
+
+func key(sessionUID string, car, lapNum uint8) string {
+	return fmt.Sprintf("%s/car-%d-lap-%d", sessionUID, car, lapNum)
+}
+
+func (s *Store) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key)+".json")
+}
+
+// Save keeps a lap, replacing the lap of the same car and number in the session if any
+func (s *Store) Save(lap *trace.Lap) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info := InfoOf(lap)
+	k := key(info.SessionUID, info.CarIndex, info.LapNum)
+
+	if s.dir == "" {
+		if _, ok := s.laps[k]; !ok {
+			s.order = append(s.order, k)
+		}
+		s.laps[k] = lap
+		if len(s.order) > MaxLaps {
+			delete(s.laps, s.order[0])
+			s.order = s.order[1:]
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(lap)
+	if err != nil {
+		return errors.Wrap(err, "could not encode lap")
+	}
+	if err := os.MkdirAll(filepath.Join(s.dir, info.SessionUID), 0755); err != nil {
+		return errors.Wrapf(err, "could not create lap directory of session %s", info.SessionUID)
+	}
+	if err := ioutil.WriteFile(s.path(k), data, 0644); err != nil {
+		return errors.Wrapf(err, "could not write lap %d of car %d", info.LapNum, info.CarIndex)
+	}
+	return nil
+}
+
+// Get returns a lap of a car during a session, lapNum 0 returns its best valid lap
+func (s *Store) Get(sessionUID string, car, lapNum uint8) (*trace.Lap, error) {
+	if lapNum == 0 {
+		return s.best(sessionUID, car)
+	}
+	if err := checkSession(sessionUID); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.load(key(sessionUID, car, lapNum))
+}
+
+// Laps describes the laps of a session, ordered by car and lap number
+func (s *Store) Laps(sessionUID string) ([]Info, error) {
+	if err := checkSession(sessionUID); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	infos := []Info{}
+	for _, k := range s.keys(sessionUID) {
+		lap, err := s.load(k)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, InfoOf(lap))
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].CarIndex != infos[j].CarIndex {
+			return infos[i].CarIndex < infos[j].CarIndex
+		}
+		return infos[i].LapNum < infos[j].LapNum
+	})
+	return infos, nil
+}
+
+func (s *Store) best(sessionUID string, car uint8) (*trace.Lap, error) {
+	infos, err := s.Laps(sessionUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *Info
+	for i, info := range infos {
+		if info.CarIndex != car || !info.Valid || info.LapTime <= 0 {
+			continue
+		}
+		if best == nil || info.LapTime < best.LapTime {
+			best = &infos[i]
+		}
+	}
+	if best == nil {
+		return nil, ErrNotFound
+	}
+	return s.Get(sessionUID, car, best.LapNum)
+}
+
+// keys returns the keys of the laps of a session
+func (s *Store) keys(sessionUID string) []string {
+	keys := []string{}
+	if s.dir == "" {
+		for _, k := range s.order {
+			if strings.HasPrefix(k, sessionUID+"/") {
+				keys = append(keys, k)
+			}
+		}
+		return keys
+	}
+
+	files, err := ioutil.ReadDir(filepath.Join(s.dir, sessionUID))
+	if err != nil {
+		return keys
+	}
+	for _, f := range files {
+		if name := f.Name(); strings.HasSuffix(name, ".json") {
+			keys = append(keys, sessionUID+"/"+strings.TrimSuffix(name, ".json"))
+		}
+	}
+	return keys
+}
+
+func (s *Store) load(k string) (*trace.Lap, error) {
+	if s.dir == "" {
+		lap, ok := s.laps[k]
+		if !ok {
+			return nil, ErrNotFound
+		}
+		return lap, nil
+	}
+
+	data, err := ioutil.ReadFile(s.path(k))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrapf(err, "could not read lap %s", k)
+	}
+	lap := &trace.Lap{}
+	if err := json.Unmarshal(data, lap); err != nil {
+		return nil, errors.Wrapf(err, "could not decode lap %s", k)
+	}
+	return lap, nil
+}
+
+// checkSession makes sure a session UID, that may come from a request, is a number and not a path
+func checkSession(sessionUID string) error {
+	if _, err := strconv.ParseUint(sessionUID, 10, 64); err != nil {
+		return errors.Errorf("invalid session %q", sessionUID)
+	}
+	return nil
+}
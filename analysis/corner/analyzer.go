@@ -0,0 +1,147 @@
+package corner
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/trace"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+const (
+	CornersDocumentType = "corners"
+	LapDocumentType     = "corner_lap"
+)
+
+// Analyzer detects the corners of the track from the first clean lap when none are stored yet,
+// and reports how every car went through each corner, lap after lap.
+type Analyzer struct {
+	source models.Source
+	store  *Store
+
+	trackID int8
+	loaded  bool
+	corners []models.Corner
+
+	best        map[uint8][]float32 // Best time of each car in every corner
+	sessionBest []float32           // Best time of any car in every corner
+}
+
+// NewFactory returns a trace.ConsumerFactory creating corner analyzers sharing the same store
+func NewFactory(store *Store) trace.ConsumerFactory {
+	return func(source models.Source) trace.Consumer {
+		return &Analyzer{
+			source: source,
+			store:  store,
+			best:   map[uint8][]float32{},
+		}
+	}
+}
+
+// Lap ...
+func (a *Analyzer) Lap(lap *trace.Lap) []models.F1Data {
+	if lap.TrackID < 0 {
+		return nil
+	}
+
+	if !a.loaded || lap.TrackID != a.trackID {
+		corners, err := a.store.Get(lap.TrackID)
+		if err != nil {
+			logrus.WithError(err).Errorf("could not load corners of track %d", lap.TrackID)
+		}
+		a.trackID = lap.TrackID
+		a.loaded = true
+		a.setCorners(corners)
+	}
+
+	docs := []models.F1Data{}
+	added := false
+	if a.corners == nil {
+		if !lap.Usable() {
+			return nil
+		}
+		detected := Detect(lap)
+		if len(detected) == 0 {
+			return nil
+		}
+		// another rig may have detected the corners meanwhile, its corners are kept
+		var corners []models.Corner
+		var err error
+		corners, added, err = a.store.Add(lap.TrackID, detected)
+		if err != nil {
+			logrus.WithError(err).Errorf("could not save corners of track %d", lap.TrackID)
+		}
+		if corners == nil {
+			corners = detected
+		}
+		a.setCorners(corners)
+	}
+	if added {
+		logrus.Infof("detected %d corners on track %d", len(a.corners), lap.TrackID)
+
+		doc := &models.Corners{
+			Header:    models.NewHeader(lap.Header),
+			Timestamp: time.Now().UTC(),
+			Type:      CornersDocumentType,
+			TrackID:   lap.TrackID,
+			Corners:   a.corners,
+		}
+		doc.Header.Source = a.source
+		docs = append(docs, doc)
+	}
+
+	if !lap.Complete {
+		return docs
+	}
+
+	reports := Report(lap, a.corners)
+
+	best, ok := a.best[lap.CarIndex]
+	if !ok {
+		best = make([]float32, len(a.corners))
+		a.best[lap.CarIndex] = best
+	}
+	for i, r := range reports {
+		if lap.Usable() {
+			if best[i] == 0 || r.Time < best[i] {
+				best[i] = r.Time
+			}
+			if a.sessionBest[i] == 0 || r.Time < a.sessionBest[i] {
+				a.sessionBest[i] = r.Time
+			}
+		}
+		if best[i] > 0 {
+			reports[i].TimeLost = r.Time - best[i]
+		}
+		if a.sessionBest[i] > 0 {
+			reports[i].TimeLostToSessionBest = r.Time - a.sessionBest[i]
+		}
+	}
+
+	doc := &models.CornerLap{
+		Header:    models.NewHeader(lap.Header),
+		Timestamp: time.Now().UTC(),
+		Type:      LapDocumentType,
+		TrackID:   lap.TrackID,
+		CarIndex:  lap.CarIndex,
+		LapNum:    lap.LapNum,
+		LapTime:   lap.LapTime,
+		Valid:     lap.Valid,
+		PitLap:    lap.PitLap,
+		Corners:   reports,
+	}
+	doc.Header.Source = a.source
+	return append(docs, doc)
+}
+
+// Flush ...
+func (a *Analyzer) Flush() []models.F1Data {
+	return nil
+}
+
+func (a *Analyzer) setCorners(corners []models.Corner) {
+	a.corners = corners
+	a.best = map[uint8][]float32{}
+	a.sessionBest = make([]float32, len(corners))
+}
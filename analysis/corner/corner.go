@@ -0,0 +1,181 @@
+package corner
+
+import (
+	"math"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/trace"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+const (
+	// lateralThreshold is the lateral G-Force a car is considered cornering from
+	lateralThreshold = 1.0
+	// steerThreshold is the steering a car is considered cornering from
+	steerThreshold = 0.15
+
+	// mergeDistance is the distance in metres under which two parts of a corner going the same way are merged
+	mergeDistance = 40
+	// minLength is the length in metres of the shortest corner
+	minLength = 15
+
+	// brakingThreshold is the brake pressure a car is considered braking from
+	brakingThreshold = 0.1
+	// brakingWindow is the distance in metres before the corner entry the braking point is looked for
+	brakingWindow = 300
+
+	// ExitWindow is the distance in metres after the exit of a corner a car is still considered exiting it
+	ExitWindow = 150
+)
+
+// Detect finds the corners of a track from a lap driven on it.
+// A corner is a part of the lap where the car pulls lateral G or steers, it is split when the steering changes side.
+func Detect(lap *trace.Lap) []models.Corner {
+	samples := lap.Samples
+	if len(samples) < 3 {
+		return nil
+	}
+
+	// smooth out the noise of the telemetry over three samples
+	lateral := make([]float64, len(samples))
+	steer := make([]float64, len(samples))
+	for i := range samples {
+		from, to := i-1, i+1
+		if from < 0 {
+			from = 0
+		}
+		if to >= len(samples) {
+			to = len(samples) - 1
+		}
+		for j := from; j <= to; j++ {
+			lateral[i] += math.Abs(float64(samples[j].GForceLateral))
+			steer[i] += float64(samples[j].Steer)
+		}
+		lateral[i] /= float64(to - from + 1)
+		steer[i] /= float64(to - from + 1)
+	}
+
+	type region struct {
+		from, to int
+		steer    float64 // sum of the steering, its sign gives the direction
+	}
+	regions := []region{}
+	var current *region
+	for i := range samples {
+		cornering := lateral[i] >= lateralThreshold || math.Abs(steer[i]) >= steerThreshold
+		if !cornering {
+			current = nil
+			continue
+		}
+
+		// a change of steering side starts a new corner, ie: chicanes
+		if current != nil && math.Abs(steer[i]) >= steerThreshold && current.steer*steer[i] < 0 && math.Abs(current.steer) > 0 {
+			current = nil
+		}
+		if current == nil {
+			regions = append(regions, region{from: i, to: i})
+			current = &regions[len(regions)-1]
+		}
+		current.to = i
+		current.steer += steer[i]
+	}
+
+	// merge the parts of a corner going the same way
+	merged := []region{}
+	for _, r := range regions {
+		if n := len(merged); n > 0 {
+			last := &merged[n-1]
+			if samples[r.from].Distance-samples[last.to].Distance < mergeDistance && last.steer*r.steer >= 0 {
+				last.to = r.to
+				last.steer += r.steer
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+
+	corners := []models.Corner{}
+	for _, r := range merged {
+		if samples[r.to].Distance-samples[r.from].Distance < minLength {
+			continue
+		}
+
+		apex := r.from
+		for i := r.from; i <= r.to; i++ {
+			if samples[i].Speed < samples[apex].Speed {
+				apex = i
+			}
+		}
+
+		direction := "right"
+		if r.steer < 0 {
+			direction = "left"
+		}
+		corners = append(corners, models.Corner{
+			Number:    len(corners) + 1,
+			Direction: direction,
+			Entry:     samples[r.from].Distance,
+			Apex:      samples[apex].Distance,
+			Exit:      samples[r.to].Distance,
+		})
+	}
+	return corners
+}
+
+// Report details how the car went through every corner during a lap
+func Report(lap *trace.Lap, corners []models.Corner) []models.CornerReport {
+	reports := make([]models.CornerReport, 0, len(corners))
+	for n, c := range corners {
+		entry, exit := lap.At(c.Entry), lap.At(c.Exit)
+		report := models.CornerReport{
+			Number:     c.Number,
+			Direction:  c.Direction,
+			EntrySpeed: entry.Speed,
+			ExitSpeed:  exit.Speed,
+			Time:       exit.SessionTime - entry.SessionTime,
+		}
+
+		// the braking point is looked for after the previous corner
+		from := c.Entry - brakingWindow
+		if n > 0 && corners[n-1].Exit > from {
+			from = corners[n-1].Exit
+		}
+
+		minimum := -1
+		braking := false
+		for i, s := range lap.Samples {
+			if s.Distance < from {
+				continue
+			}
+			if s.Distance > c.Exit {
+				break
+			}
+
+			if s.Distance <= c.Apex && !braking && s.Brake >= brakingThreshold {
+				braking = true
+				report.Braking = true
+				report.BrakingPoint = s.Distance
+			}
+			if s.Distance >= c.Entry && (minimum < 0 || s.Speed < lap.Samples[minimum].Speed) {
+				minimum = i
+			}
+		}
+
+		if minimum >= 0 {
+			report.MinimumSpeed = lap.Samples[minimum].Speed
+			report.MinimumSpeedDistance = lap.Samples[minimum].Distance
+			report.Gear = lap.Samples[minimum].Gear
+		}
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+// At returns the number of the corner a car is going through or exiting at a distance, 0 if none
+func At(corners []models.Corner, distance float32) int {
+	for _, c := range corners {
+		if distance >= c.Entry && distance <= c.Exit+ExitWindow {
+			return c.Number
+		}
+	}
+	return 0
+}
@@ -0,0 +1,99 @@
+package corner
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+// Store keeps the corners of every track.
+// Corners are saved as json files in a directory when one is given, and kept in memory otherwise.
+type Store struct {
+	mu      sync.Mutex
+	dir     string
+	corners map[int8][]models.Corner
+}
+
+// NewStore creates a store saving the corners to dir, an empty dir keeps the corners in memory only
+func NewStore(dir string) (*Store, error) {
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, errors.Wrapf(err, "could not create corner directory %s", dir)
+		}
+	}
+	return &Store{
+		dir:     dir,
+		corners: map[int8][]models.Corner{},
+	}, nil
+}
+
+func (s *Store) path(trackID int8) string {
+	return filepath.Join(s.dir, fmt.Sprintf("corners-%d.json", trackID))
+}
+
+// Get returns the corners of a track, nil if they were not detected yet
+func (s *Store) Get(trackID int8) ([]models.Corner, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.get(trackID)
+}
+
+// get returns the corners of a track, s.mu has to be held
+func (s *Store) get(trackID int8) ([]models.Corner, error) {
+	if corners, ok := s.corners[trackID]; ok {
+		return corners, nil
+	}
+	if s.dir == "" {
+		return nil, nil
+	}
+
+	data, err := ioutil.ReadFile(s.path(trackID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "could not read corners of track %d", trackID)
+	}
+	corners := []models.Corner{}
+	if err := json.Unmarshal(data, &corners); err != nil {
+		return nil, errors.Wrapf(err, "could not decode corners of track %d", trackID)
+	}
+	s.corners[trackID] = corners
+	return corners, nil
+}
+
+// Add saves the corners detected on a track unless the track already has corners, so the corner numbers never change.
+// It returns the corners of the track and whether they are the ones given.
+func (s *Store) Add(trackID int8, corners []models.Corner) ([]models.Corner, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, err := s.get(trackID)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(existing) > 0 {
+		return existing, false, nil
+	}
+
+	s.corners[trackID] = corners
+	if s.dir == "" {
+		return corners, true, nil
+	}
+
+	data, err := json.MarshalIndent(corners, "", "  ")
+	if err != nil {
+		return corners, true, errors.Wrap(err, "could not encode corners")
+	}
+	if err := ioutil.WriteFile(s.path(trackID), data, 0644); err != nil {
+		return corners, true, errors.Wrapf(err, "could not write corners of track %d", trackID)
+	}
+	return corners, true, nil
+}
@@ -0,0 +1,299 @@
+package fuel
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+const (
+	LapDocumentType      = "fuel_lap"
+	StrategyDocumentType = "fuel_strategy"
+
+	// SafetyMarginLaps is the fuel, in laps, added on top of the recommended fuel load
+	SafetyMarginLaps = 1.0
+
+	// minMixLaps is the fraction of lap a fuel mix has to be used for to tell its consumption
+	minMixLaps = 0.05
+)
+
+// car holds the fuel state of a player car
+type car struct {
+	lapNum        uint8   // Lap the car is currently on
+	pitted        bool    // Whether the car went through the pit lane during the current lap
+	totalDistance float32 // Latest total distance travelled in metres
+
+	status    f1packet.CarStatusData // Latest status received
+	hasStatus bool
+
+	// Fuel used and distance travelled by fuel mix during the current lap
+	lapFuel     [4]float64
+	lapDistance [4]float64
+
+	// Fuel used by fuel mix during the session, pit laps excluded
+	session [4]MixHistory
+}
+
+// Analyzer follows the fuel used by the player cars, lap by lap and by fuel mix.
+// At the end of the session the fuel used is added to the history of the track
+// to recommend a starting fuel load for the next race.
+type Analyzer struct {
+	source  models.Source
+	history *History
+
+	header     f1packet.PacketHeader // Header of the latest packet
+	session    f1packet.PacketSessionData
+	hasSession bool
+	flushed    bool
+
+	cars map[uint8]*car
+}
+
+// NewFactory returns an analysis.Factory creating fuel analyzers sharing the same history
+func NewFactory(history *History) analysis.Factory {
+	return func(source models.Source) analysis.Analyzer {
+		return &Analyzer{
+			source:  source,
+			history: history,
+			cars:    map[uint8]*car{},
+		}
+	}
+}
+
+// Analyze ...
+func (a *Analyzer) Analyze(packet interface{}) []models.F1Data {
+	switch p := packet.(type) {
+	case *f1packet.PacketSessionData:
+		a.header = p.Header
+		a.session = *p
+		a.hasSession = true
+
+	case *f1packet.PacketCarStatusData:
+		a.header = p.Header
+		for _, i := range analysis.PlayerCars(p.Header) {
+			a.carStatus(a.car(i), p.CarStatusData[i])
+		}
+
+	case *f1packet.PacketLapData:
+		a.header = p.Header
+		docs := []models.F1Data{}
+		for _, i := range analysis.PlayerCars(p.Header) {
+			if doc := a.lapData(i, p.LapData[i]); doc != nil {
+				docs = append(docs, doc)
+			}
+		}
+		return docs
+
+	case *f1packet.PacketFinalClassificationData:
+		a.header = p.Header
+		return a.Flush()
+	}
+	return nil
+}
+
+// Flush records the fuel used during the session to the history and returns the fuel strategy of the player cars
+func (a *Analyzer) Flush() []models.F1Data {
+	if a.flushed || !a.hasSession {
+		return nil
+	}
+	a.flushed = true
+
+	var raceLaps uint8
+	// 10 = R, 11 = R2
+	if a.session.SessionType == 10 || a.session.SessionType == 11 {
+		raceLaps = a.session.TotalLaps
+	}
+
+	docs := []models.F1Data{}
+	for i, c := range a.cars {
+		var laps float64
+		for _, m := range c.session {
+			laps += m.Laps
+		}
+		if laps == 0 {
+			continue
+		}
+
+		track, err := a.history.Record(a.session.TrackID, a.session.Formula, raceLaps, c.session)
+		if err != nil {
+			logrus.WithError(err).Error("could not record fuel history")
+		}
+		docs = append(docs, a.strategy(i, c, track))
+	}
+	return docs
+}
+
+func (a *Analyzer) car(i uint8) *car {
+	c, ok := a.cars[i]
+	if !ok {
+		c = &car{}
+		a.cars[i] = c
+	}
+	return c
+}
+
+func (a *Analyzer) carStatus(c *car, st f1packet.CarStatusData) {
+	if c.hasStatus && c.status.FuelMix < 4 {
+		// fuel used since the previous status, with the mix that was selected
+		if used := c.status.FuelInTank - st.FuelInTank; used > 0 {
+			c.lapFuel[c.status.FuelMix] += float64(used)
+		}
+	}
+	c.status = st
+	c.hasStatus = true
+}
+
+func (a *Analyzer) lapData(i uint8, ld f1packet.LapData) models.F1Data {
+	c := a.car(i)
+
+	// 0 = invalid, 1 = inactive
+	if ld.ResultStatus < 2 || ld.CurrentLapNum == 0 {
+		return nil
+	}
+
+	if c.lapNum == 0 {
+		c.lapNum = ld.CurrentLapNum
+		c.totalDistance = ld.TotalDistance
+		return nil
+	}
+
+	if ld.PitStatus != 0 {
+		c.pitted = true
+	}
+
+	if c.hasStatus && c.status.FuelMix < 4 {
+		if travelled := ld.TotalDistance - c.totalDistance; travelled > 0 {
+			c.lapDistance[c.status.FuelMix] += float64(travelled)
+		}
+	}
+	c.totalDistance = ld.TotalDistance
+
+	if ld.CurrentLapNum <= c.lapNum {
+		return nil
+	}
+
+	// lap completed
+	doc := a.lap(i, c, ld)
+
+	c.lapNum = ld.CurrentLapNum
+	c.pitted = ld.PitStatus != 0
+	c.lapFuel = [4]float64{}
+	c.lapDistance = [4]float64{}
+
+	return doc
+}
+
+// lap returns the document of the lap the car just completed, nil if the track length is not known yet
+func (a *Analyzer) lap(i uint8, c *car, ld f1packet.LapData) models.F1Data {
+	if !a.hasSession || a.session.TrackLength == 0 {
+		return nil
+	}
+
+	var lap [4]MixHistory
+	for m := range lap {
+		lap[m] = MixHistory{
+			Laps: c.lapDistance[m] / float64(a.session.TrackLength),
+			Fuel: c.lapFuel[m],
+		}
+		if !c.pitted {
+			c.session[m].Laps += lap[m].Laps
+			c.session[m].Fuel += lap[m].Fuel
+		}
+	}
+
+	doc := &models.FuelLap{
+		Header:            models.NewHeader(a.header),
+		Timestamp:         time.Now().UTC(),
+		Type:              LapDocumentType,
+		CarIndex:          i,
+		LapNum:            c.lapNum,
+		PitLap:            c.pitted,
+		FuelMixes:         mixUsages(lap),
+		FuelInTank:        c.status.FuelInTank,
+		FuelCapacity:      c.status.FuelCapacity,
+		FuelRemainingLaps: c.status.FuelRemainingLaps,
+	}
+	doc.Header.Source = a.source
+	for _, m := range lap {
+		doc.FuelUsed += float32(m.Fuel)
+	}
+
+	perLap := overall(c.session).PerLap()
+	doc.AverageFuelPerLap = float32(perLap)
+
+	// laps completed so far
+	completed := ld.CurrentLapNum - 1
+	if a.session.TotalLaps > completed {
+		doc.RemainingLaps = a.session.TotalLaps - completed
+	}
+	doc.ProjectedFuelNeeded = float32(perLap) * float32(doc.RemainingLaps)
+	doc.ProjectedFuelDelta = doc.FuelInTank - doc.ProjectedFuelNeeded
+
+	return doc
+}
+
+func (a *Analyzer) strategy(i uint8, c *car, track TrackHistory) models.F1Data {
+	doc := &models.FuelStrategy{
+		Header:           models.NewHeader(a.header),
+		Timestamp:        time.Now().UTC(),
+		Type:             StrategyDocumentType,
+		CarIndex:         i,
+		TrackID:          a.session.TrackID,
+		Formula:          a.session.Formula,
+		SessionType:      a.session.SessionType,
+		FuelMixes:        mixUsages(c.session),
+		SessionsRecorded: track.Sessions,
+		RaceLaps:         track.RaceLaps,
+	}
+	doc.Header.Source = a.source
+
+	// the recommendation is based on the standard mix, the overall consumption is used if it was never selected
+	perLap := track.Mixes[1].PerLap()
+	if track.Mixes[1].Laps < 1 {
+		perLap = overall(track.Mixes).PerLap()
+	}
+	doc.FuelPerLap = float32(perLap)
+
+	if doc.RaceLaps == 0 {
+		doc.RaceLaps = a.session.TotalLaps
+	}
+	doc.RecommendedFuelLoad = float32(perLap * (float64(doc.RaceLaps) + SafetyMarginLaps))
+	if capacity := c.status.FuelCapacity; capacity > 0 && doc.RecommendedFuelLoad > capacity {
+		doc.RecommendedFuelLoad = capacity
+	}
+
+	return doc
+}
+
+func mixUsages(mixes [4]MixHistory) []models.FuelMixUsage {
+	usages := []models.FuelMixUsage{}
+	for m, mix := range mixes {
+		if mix.Laps == 0 && mix.Fuel == 0 {
+			continue
+		}
+		usage := models.FuelMixUsage{
+			FuelMix:     uint8(m),
+			FuelMixName: models.FuelMixName(uint8(m)),
+			Laps:        float32(mix.Laps),
+			FuelUsed:    float32(mix.Fuel),
+		}
+		if mix.Laps >= minMixLaps {
+			usage.FuelPerLap = float32(mix.PerLap())
+		}
+		usages = append(usages, usage)
+	}
+	return usages
+}
+
+func overall(mixes [4]MixHistory) MixHistory {
+	total := MixHistory{}
+	for _, m := range mixes {
+		total.Laps += m.Laps
+		total.Fuel += m.Fuel
+	}
+	return total
+}
@@ -0,0 +1,115 @@
+package fuel
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// MixHistory accumulates the fuel used with a fuel mix
+type MixHistory struct {
+	Laps float64 // Laps driven with the mix
+	Fuel float64 // Fuel used with the mix
+}
+
+// PerLap returns the fuel used per lap, 0 if the mix was never used
+func (m MixHistory) PerLap() float64 {
+	if m.Laps <= 0 {
+		return 0
+	}
+	return m.Fuel / m.Laps
+}
+
+// TrackHistory holds the fuel used on a track by a formula
+type TrackHistory struct {
+	TrackID  int8
+	Formula  uint8
+	Sessions int           // Number of sessions recorded
+	RaceLaps uint8         // Number of laps of the latest race recorded
+	Mixes    [4]MixHistory // Fuel used by fuel mix
+}
+
+// History keeps the fuel used on every track across sessions.
+// It is saved as json to a file when a path is given, and kept in memory otherwise.
+type History struct {
+	mu     sync.Mutex
+	path   string
+	Tracks map[string]*TrackHistory
+}
+
+// NewHistory loads the history saved at path, an empty path keeps the history in memory only
+func NewHistory(path string) (*History, error) {
+	h := &History{
+		path:   path,
+		Tracks: map[string]*TrackHistory{},
+	}
+	if path == "" {
+		return h, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return h, nil
+		}
+		return nil, errors.Wrapf(err, "could not read fuel history %s", path)
+	}
+	if err := json.Unmarshal(data, h); err != nil {
+		return nil, errors.Wrapf(err, "could not decode fuel history %s", path)
+	}
+	return h, nil
+}
+
+func trackKey(trackID int8, formula uint8) string {
+	return strconv.Itoa(int(trackID)) + "/" + strconv.Itoa(int(formula))
+}
+
+// Track returns a copy of the history of a track
+func (h *History) Track(trackID int8, formula uint8) (TrackHistory, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	t, ok := h.Tracks[trackKey(trackID, formula)]
+	if !ok {
+		return TrackHistory{TrackID: trackID, Formula: formula}, false
+	}
+	return *t, true
+}
+
+// Record adds the fuel used during a session to the history of the track and saves it
+func (h *History) Record(trackID int8, formula uint8, raceLaps uint8, mixes [4]MixHistory) (TrackHistory, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	key := trackKey(trackID, formula)
+	t, ok := h.Tracks[key]
+	if !ok {
+		t = &TrackHistory{TrackID: trackID, Formula: formula}
+		h.Tracks[key] = t
+	}
+	t.Sessions++
+	if raceLaps > 0 {
+		t.RaceLaps = raceLaps
+	}
+	for i := range mixes {
+		t.Mixes[i].Laps += mixes[i].Laps
+		t.Mixes[i].Fuel += mixes[i].Fuel
+	}
+
+	if h.path == "" {
+		return *t, nil
+	}
+
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return *t, errors.Wrap(err, "could not encode fuel history")
+	}
+	if err := ioutil.WriteFile(h.path, data, 0644); err != nil {
+		return *t, errors.Wrapf(err, "could not write fuel history %s", h.path)
+	}
+	return *t, nil
+}
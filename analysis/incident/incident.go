@@ -0,0 +1,362 @@
+package incident
+
+import (
+	"math"
+	"time"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+const (
+	DocumentType = "incident"
+
+	KindSpin     = "spin"
+	KindOffTrack = "off_track"
+	KindContact  = "contact"
+
+	// SpinAngle is the angle in degrees between the heading and the direction of travel a car is spinning from
+	SpinAngle = 60
+	// DamageJump is the increase of wing damage (percentage) between two status updates considered a contact
+	DamageJump = 5
+
+	// recoverAngle is the angle in degrees under which a spinning car has recovered
+	recoverAngle = 20
+	// minSpeed is the speed in metres per second under which the direction of travel is not reliable, a stopped car is not spinning
+	minSpeed = 5
+	// minOffTrack is the duration in seconds of the shortest excursion off track
+	minOffTrack = 0.2
+	// contactDistance is the distance in metres under which another car is considered involved in a contact
+	contactDistance = 10
+
+	// snippetInterval is the session time in seconds between two samples of a snippet
+	snippetInterval = 0.1
+	// snippetBefore and snippetAfter are the seconds of telemetry kept before and after an incident
+	snippetBefore = 3
+	snippetAfter  = 1
+	// maxSnippet is the largest number of samples of a snippet
+	maxSnippet = 300
+)
+
+// car holds the latest state of a car and its incidents in progress
+type car struct {
+	lapData   f1packet.LapData
+	hasLap    bool
+	telemetry f1packet.CarTelemetryData
+	motion    f1packet.CarMotionData
+	hasMotion bool
+	status    f1packet.CarStatusData
+	hasStatus bool
+
+	motionTime float32 // Session time of the latest motion received
+	yawRate    float32 // Yaw rate in radians per second
+	slipAngle  float32 // Angle between the heading and the direction of travel in degrees
+
+	samples    []models.IncidentSample // Latest samples, up to snippetBefore seconds
+	sampleTime float32                 // Session time of the latest sample
+
+	spin       *models.Incident
+	offTrack   *models.Incident
+	contact    *models.Incident
+	contactEnd float32 // Session time the snippet of the contact ends at
+}
+
+// Detector follows every car and emits an Incident document for every spin, excursion off track and likely contact.
+type Detector struct {
+	source models.Source
+	cars   [22]car
+}
+
+// New creates an incident detector, it satisfies analysis.Factory
+func New(source models.Source) analysis.Analyzer {
+	return &Detector{
+		source: source,
+	}
+}
+
+// Analyze ...
+func (d *Detector) Analyze(packet interface{}) []models.F1Data {
+	docs := []models.F1Data{}
+	switch p := packet.(type) {
+	case *f1packet.PacketLapData:
+		for i := range p.LapData {
+			d.cars[i].lapData = p.LapData[i]
+			d.cars[i].hasLap = true
+		}
+
+	case *f1packet.PacketMotionData:
+		for i := range p.CarMotionData {
+			if doc := d.motion(p.Header, i, p.CarMotionData[i]); doc != nil {
+				docs = append(docs, doc)
+			}
+		}
+
+	case *f1packet.PacketCarStatusData:
+		for i := range p.CarStatusData {
+			d.status(p.Header, i, p.CarStatusData[i])
+		}
+
+	case *f1packet.PacketCarTelemetryData:
+		for i := range p.CarTelemetryData {
+			docs = append(docs, d.telemetry(p.Header, i, p.CarTelemetryData[i])...)
+		}
+	}
+	return docs
+}
+
+// Flush drops the incidents in progress
+func (d *Detector) Flush() []models.F1Data {
+	return nil
+}
+
+// active returns whether a car is racing on track
+func (c *car) active() bool {
+	// 2 = active, 0 = none
+	return c.hasLap && c.lapData.ResultStatus == 2 && c.lapData.PitStatus == 0
+}
+
+// motion follows the heading of a car, it returns a spin once the car recovered or stopped
+func (d *Detector) motion(header f1packet.PacketHeader, i int, m f1packet.CarMotionData) models.F1Data {
+	c := &d.cars[i]
+	if c.hasMotion {
+		if dt := header.SessionTime - c.motionTime; dt > 0 {
+			yaw := float64(m.Yaw - c.motion.Yaw)
+			// the yaw goes from -pi to pi
+			if yaw > math.Pi {
+				yaw -= 2 * math.Pi
+			} else if yaw < -math.Pi {
+				yaw += 2 * math.Pi
+			}
+			c.yawRate = float32(yaw) / dt
+		}
+	}
+	c.motion = m
+	c.motionTime = header.SessionTime
+	c.hasMotion = true
+
+	speed := math.Hypot(float64(m.WorldVelocityX), float64(m.WorldVelocityZ))
+	c.slipAngle = 0
+	if speed >= minSpeed {
+		// the direction vectors are normalised to 32767
+		fx, fz := float64(m.WorldForwardDirX)/32767, float64(m.WorldForwardDirZ)/32767
+		if norm := math.Hypot(fx, fz); norm > 0 {
+			cos := (fx*float64(m.WorldVelocityX) + fz*float64(m.WorldVelocityZ)) / (norm * speed)
+			c.slipAngle = float32(math.Acos(math.Max(-1, math.Min(1, cos))) * 180 / math.Pi)
+		}
+	}
+
+	if c.spin != nil {
+		if c.slipAngle > c.spin.MaxSlipAngle {
+			c.spin.MaxSlipAngle = c.slipAngle
+		}
+		if rate := float32(math.Abs(float64(c.yawRate))); rate > c.spin.MaxYawRate {
+			c.spin.MaxYawRate = rate
+		}
+		if speed < minSpeed || c.slipAngle < recoverAngle {
+			spin := c.spin
+			c.spin = nil
+			return d.finish(spin, header.SessionTime)
+		}
+		return nil
+	}
+
+	if c.active() && c.slipAngle >= SpinAngle {
+		c.spin = d.start(header, i, KindSpin)
+		c.spin.MaxSlipAngle = c.slipAngle
+		c.spin.MaxYawRate = float32(math.Abs(float64(c.yawRate)))
+	}
+	return nil
+}
+
+// status detects sudden jumps of the wing damage of a car
+func (d *Detector) status(header f1packet.PacketHeader, i int, s f1packet.CarStatusData) {
+	c := &d.cars[i]
+	previous, hadStatus := c.status, c.hasStatus
+	c.status = s
+	c.hasStatus = true
+	if !hadStatus || !c.active() {
+		return
+	}
+
+	increase := jump(previous.FrontLeftWingDamage, s.FrontLeftWingDamage)
+	if n := jump(previous.FrontRightWingDamage, s.FrontRightWingDamage); n > increase {
+		increase = n
+	}
+	if n := jump(previous.RearWingDamage, s.RearWingDamage); n > increase {
+		increase = n
+	}
+	if increase < DamageJump {
+		return
+	}
+
+	if c.contact == nil {
+		c.contact = d.start(header, i, KindContact)
+		c.contact.OtherCarIndex = d.closest(i)
+	}
+	c.contact.FrontLeftWingDamage = s.FrontLeftWingDamage
+	c.contact.FrontRightWingDamage = s.FrontRightWingDamage
+	c.contact.RearWingDamage = s.RearWingDamage
+	if increase > c.contact.DamageIncrease {
+		c.contact.DamageIncrease = increase
+	}
+	c.contactEnd = header.SessionTime + snippetAfter
+}
+
+// telemetry samples a car and follows its excursions off track, it returns the incidents that ended
+func (d *Detector) telemetry(header f1packet.PacketHeader, i int, t f1packet.CarTelemetryData) []models.F1Data {
+	c := &d.cars[i]
+	c.telemetry = t
+	if header.SessionTime-c.sampleTime >= snippetInterval || header.SessionTime < c.sampleTime {
+		c.sampleTime = header.SessionTime
+		d.sample(c)
+	}
+
+	docs := []models.F1Data{}
+	if c.contact != nil && header.SessionTime >= c.contactEnd {
+		contact := c.contact
+		c.contact = nil
+		// the duration of a contact is the time the damage kept increasing
+		docs = append(docs, d.finish(contact, c.contactEnd-snippetAfter))
+	}
+
+	off := offTrack(t.SurfaceType)
+	if c.offTrack != nil {
+		for _, surface := range t.SurfaceType {
+			if name := models.SurfaceTypeName(surface); isOff(surface) && !contains(c.offTrack.Surfaces, name) {
+				c.offTrack.Surfaces = append(c.offTrack.Surfaces, name)
+			}
+		}
+		if !off || !c.active() {
+			excursion := c.offTrack
+			c.offTrack = nil
+			if header.SessionTime-excursion.SessionTime >= minOffTrack {
+				docs = append(docs, d.finish(excursion, header.SessionTime))
+			}
+		}
+		return docs
+	}
+
+	if off && c.active() {
+		c.offTrack = d.start(header, i, KindOffTrack)
+		for _, surface := range t.SurfaceType {
+			if name := models.SurfaceTypeName(surface); !contains(c.offTrack.Surfaces, name) {
+				c.offTrack.Surfaces = append(c.offTrack.Surfaces, name)
+			}
+		}
+	}
+	return docs
+}
+
+// sample records the state of a car in its recent samples and in the snippets of its incidents in progress
+func (d *Detector) sample(c *car) {
+	s := models.IncidentSample{
+		SessionTime:        c.sampleTime,
+		LapDistance:        c.lapData.LapDistance,
+		Speed:              float32(c.telemetry.Speed),
+		Throttle:           c.telemetry.Throttle,
+		Brake:              c.telemetry.Brake,
+		Steer:              c.telemetry.Steer,
+		Gear:               c.telemetry.Gear,
+		Yaw:                c.motion.Yaw,
+		SlipAngle:          c.slipAngle,
+		GForceLateral:      c.motion.GForceLateral,
+		GForceLongitudinal: c.motion.GForceLongitudinal,
+		WorldPositionX:     c.motion.WorldPositionX,
+		WorldPositionZ:     c.motion.WorldPositionZ,
+		Surfaces:           c.telemetry.SurfaceType,
+	}
+
+	c.samples = append(c.samples, s)
+	from := 0
+	for from < len(c.samples) && c.samples[from].SessionTime < s.SessionTime-snippetBefore {
+		from++
+	}
+	c.samples = c.samples[from:]
+
+	for _, incident := range []*models.Incident{c.spin, c.offTrack, c.contact} {
+		if incident != nil && len(incident.Snippet) < maxSnippet {
+			incident.Snippet = append(incident.Snippet, s)
+		}
+	}
+}
+
+// start creates an incident of a car, its snippet starts with the recent samples of the car
+func (d *Detector) start(header f1packet.PacketHeader, i int, kind string) *models.Incident {
+	c := &d.cars[i]
+	incident := &models.Incident{
+		Header:        models.NewHeader(header),
+		Timestamp:     time.Now().UTC(),
+		Type:          DocumentType,
+		Kind:          kind,
+		CarIndex:      uint8(i),
+		OtherCarIndex: -1,
+		LapNum:        c.lapData.CurrentLapNum,
+		LapDistance:   c.lapData.LapDistance,
+		SessionTime:   header.SessionTime,
+		Speed:         float32(c.telemetry.Speed),
+		Surfaces:      []string{},
+		Snippet:       make([]models.IncidentSample, len(c.samples)),
+	}
+	incident.Header.Source = d.source
+	copy(incident.Snippet, c.samples)
+	return incident
+}
+
+func (d *Detector) finish(incident *models.Incident, end float32) models.F1Data {
+	incident.Duration = end - incident.SessionTime
+	if incident.Duration < 0 {
+		incident.Duration = 0
+	}
+	return incident
+}
+
+// closest returns the index of the active car closest to a car, -1 if none is within contactDistance
+func (d *Detector) closest(i int) int {
+	closest, best := -1, float64(contactDistance)
+	me := d.cars[i].motion
+	for j := range d.cars {
+		other := &d.cars[j]
+		if j == i || !other.hasMotion || !other.hasLap || other.lapData.ResultStatus != 2 {
+			continue
+		}
+		dx := float64(other.motion.WorldPositionX - me.WorldPositionX)
+		dy := float64(other.motion.WorldPositionY - me.WorldPositionY)
+		dz := float64(other.motion.WorldPositionZ - me.WorldPositionZ)
+		if distance := math.Sqrt(dx*dx + dy*dy + dz*dz); distance <= best {
+			closest, best = j, distance
+		}
+	}
+	return closest
+}
+
+// offTrack returns whether the four wheels of a car are off the track
+func offTrack(surfaces [4]uint8) bool {
+	for _, s := range surfaces {
+		if !isOff(s) {
+			return false
+		}
+	}
+	return true
+}
+
+// isOff returns whether a surface is off the track: rock, gravel, mud, sand, grass or water
+func isOff(surface uint8) bool {
+	return surface >= 3 && surface <= 8
+}
+
+func jump(before, after uint8) uint8 {
+	if after <= before {
+		return 0
+	}
+	return after - before
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
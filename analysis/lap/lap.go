@@ -0,0 +1,126 @@
+package lap
+
+import (
+	"time"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+const DocumentType = "lap"
+
+// car follows the lap in progress of a car
+type car struct {
+	lapData f1packet.LapData // Latest lap data received
+	seen    bool
+	invalid bool // Whether the lap in progress was invalidated
+	pit     bool // Whether the car went through the pit lane during the lap in progress
+
+	status    f1packet.CarStatusData // Latest status received
+	hasStatus bool
+}
+
+// Tracker emits a Lap document every time a car completes a lap
+type Tracker struct {
+	source models.Source
+	cars   [22]car
+}
+
+// New creates a lap tracker, it satisfies analysis.Factory
+func New(source models.Source) analysis.Analyzer {
+	return &Tracker{
+		source: source,
+	}
+}
+
+// Analyze ...
+func (t *Tracker) Analyze(packet interface{}) []models.F1Data {
+	switch p := packet.(type) {
+	case *f1packet.PacketLapData:
+		docs := []models.F1Data{}
+		for i := range p.LapData {
+			if doc := t.lapData(p.Header, i, p.LapData[i]); doc != nil {
+				docs = append(docs, doc)
+			}
+		}
+		return docs
+
+	case *f1packet.PacketCarStatusData:
+		for i := range p.CarStatusData {
+			t.cars[i].status = p.CarStatusData[i]
+			t.cars[i].hasStatus = true
+		}
+	}
+	return nil
+}
+
+// Flush ...
+func (t *Tracker) Flush() []models.F1Data {
+	return nil
+}
+
+// lapData follows the lap of a car, it returns a Lap document when the car completed it
+func (t *Tracker) lapData(header f1packet.PacketHeader, i int, ld f1packet.LapData) models.F1Data {
+	c := &t.cars[i]
+	previous, seen := c.lapData, c.seen
+	c.lapData = ld
+	c.seen = true
+	// 0 = invalid, 1 = inactive
+	if !seen || ld.ResultStatus < 2 {
+		return nil
+	}
+
+	completed := ld.CurrentLapNum > previous.CurrentLapNum ||
+		// 3 = finished, the lap number does not change when crossing the line for the last time
+		(ld.ResultStatus == 3 && previous.ResultStatus != 3)
+	if !completed {
+		if ld.CurrentLapNum < previous.CurrentLapNum {
+			// restarted, ie: in time trial
+			c.invalid, c.pit = false, false
+		}
+		// 1 = invalid, pit status 0 = none
+		c.invalid = c.invalid || ld.CurrentLapInvalid == 1
+		c.pit = c.pit || ld.PitStatus != 0
+		return nil
+	}
+
+	invalid := c.invalid || previous.CurrentLapInvalid == 1
+	pit := c.pit || previous.PitStatus != 0
+	c.invalid, c.pit = false, ld.PitStatus != 0
+	// the car has to be recorded since the start of the lap
+	if previous.CurrentLapNum == 0 || previous.ResultStatus < 2 || ld.LastLapTime <= 0 {
+		return nil
+	}
+
+	doc := &models.Lap{
+		Header:    models.NewHeader(header),
+		Timestamp: time.Now().UTC(),
+		Type:      DocumentType,
+
+		CarIndex: uint8(i),
+		LapNum:   previous.CurrentLapNum,
+		LapTime:  ld.LastLapTime,
+		Valid:    !invalid,
+		PitLap:   pit,
+		Position: ld.CarPosition,
+	}
+	doc.Header.Source = t.source
+
+	sector1 := float32(previous.Sector1TimeInMS) / 1000
+	sector2 := float32(previous.Sector2TimeInMS) / 1000
+	if sector1 > 0 && sector2 > 0 && doc.LapTime > sector1+sector2 {
+		doc.Sector1Time = sector1
+		doc.Sector2Time = sector2
+		doc.Sector3Time = doc.LapTime - sector1 - sector2
+	}
+
+	if c.hasStatus {
+		doc.ActualTyreCompound = c.status.ActualTyreCompound
+		doc.ActualTyreCompoundName = models.TyreCompoundName(c.status.ActualTyreCompound)
+		doc.VisualTyreCompound = c.status.VisualTyreCompound
+		doc.VisualTyreCompoundName = models.VisualTyreCompoundName(c.status.VisualTyreCompound)
+		doc.TyresAgeLaps = c.status.TyresAgeLaps
+	}
+	return doc
+}
@@ -0,0 +1,42 @@
+package analysis
+
+// Slope returns the slope of the least squares line fitting the points (xs[i], ys[i]),
+// 0 if there is less than two points or if all the xs are equal.
+func Slope(xs, ys []float64) float64 {
+	n := len(xs)
+	if len(ys) < n {
+		n = len(ys)
+	}
+	if n < 2 {
+		return 0
+	}
+
+	var sumX, sumY float64
+	for i := 0; i < n; i++ {
+		sumX += xs[i]
+		sumY += ys[i]
+	}
+	meanX, meanY := sumX/float64(n), sumY/float64(n)
+
+	var num, den float64
+	for i := 0; i < n; i++ {
+		num += (xs[i] - meanX) * (ys[i] - meanY)
+		den += (xs[i] - meanX) * (xs[i] - meanX)
+	}
+	if den == 0 {
+		return 0
+	}
+	return num / den
+}
+
+// Mean returns the average of the values, 0 if there is none
+func Mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
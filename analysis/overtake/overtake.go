@@ -0,0 +1,221 @@
+package overtake
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/corner"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+const (
+	DocumentType = "position_change"
+
+	KindOvertake   = "overtake"
+	KindPitCycle   = "pit_cycle"
+	KindPenalty    = "penalty"
+	KindRetirement = "retirement"
+	KindOther      = "other"
+
+	// ConfirmTime is the time in seconds a position change has to hold to be emitted,
+	// cars side by side swap positions back and forth
+	ConfirmTime = 1
+
+	// penaltyWindow is the time in seconds a time penalty explains a position change for
+	penaltyWindow = 5
+	// cornersRetry is the session time in seconds waited before looking up the corners again when the track has none yet
+	cornersRetry = 10
+)
+
+// car holds the latest state of a car
+type car struct {
+	seen bool
+
+	lapData     f1packet.LapData
+	drs         bool
+	motion      f1packet.CarMotionData
+	penaltyTime float32 // Session time the penalties of the car increased at, 0 if they never did
+}
+
+// Detector follows the race positions of every car and emits a PositionChange document for every position taken.
+type Detector struct {
+	source  models.Source
+	corners *corner.Store
+
+	session    f1packet.PacketSessionData
+	hasSession bool
+	track      []models.Corner // Corners of the track, nil until detected
+	nextLookup float32         // Session time the corners are looked up again at
+
+	cars    [22]car
+	pending map[[2]uint8]*models.PositionChange // Changes waiting for confirmation by car and other car
+}
+
+// NewFactory returns an analysis.Factory creating detectors reading the corners from store
+func NewFactory(corners *corner.Store) analysis.Factory {
+	return func(source models.Source) analysis.Analyzer {
+		return &Detector{
+			source:  source,
+			corners: corners,
+			pending: map[[2]uint8]*models.PositionChange{},
+		}
+	}
+}
+
+// Analyze ...
+func (d *Detector) Analyze(packet interface{}) []models.F1Data {
+	switch p := packet.(type) {
+	case *f1packet.PacketSessionData:
+		d.session = *p
+		d.hasSession = true
+
+	case *f1packet.PacketCarTelemetryData:
+		for i := range p.CarTelemetryData {
+			d.cars[i].drs = p.CarTelemetryData[i].Drs == 1
+		}
+
+	case *f1packet.PacketMotionData:
+		for i := range p.CarMotionData {
+			d.cars[i].motion = p.CarMotionData[i]
+		}
+
+	case *f1packet.PacketLapData:
+		if !d.race() {
+			return nil
+		}
+		d.loadCorners(p.Header.SessionTime)
+		d.lapData(p)
+		return d.confirm(p.Header)
+	}
+	return nil
+}
+
+// Flush drops the changes waiting for confirmation
+func (d *Detector) Flush() []models.F1Data {
+	return nil
+}
+
+// race returns whether positions are fought for on track, the race is assumed until the session is known
+func (d *Detector) race() bool {
+	// 10 = R, 11 = R2
+	return !d.hasSession || d.session.SessionType == 10 || d.session.SessionType == 11
+}
+
+// loadCorners looks up the corners of the track until they are detected, every cornersRetry seconds
+func (d *Detector) loadCorners(sessionTime float32) {
+	if d.track != nil || !d.hasSession || d.session.TrackID < 0 {
+		return
+	}
+	// a flashback rewinds the session time
+	if sessionTime < d.nextLookup && d.nextLookup-sessionTime <= cornersRetry {
+		return
+	}
+	d.nextLookup = sessionTime + cornersRetry
+	corners, err := d.corners.Get(d.session.TrackID)
+	if err != nil {
+		logrus.WithError(err).Errorf("could not load corners of track %d", d.session.TrackID)
+	}
+	d.track = corners
+}
+
+// lapData detects the cars that swapped positions since the previous lap data
+func (d *Detector) lapData(p *f1packet.PacketLapData) {
+	var previous [22]car
+	copy(previous[:], d.cars[:])
+
+	for i, ld := range p.LapData {
+		c := &d.cars[i]
+		if c.seen && ld.Penalties > c.lapData.Penalties {
+			c.penaltyTime = p.Header.SessionTime
+		}
+		c.lapData = ld
+		c.seen = c.seen || ld.ResultStatus >= 2
+	}
+
+	for a := range d.cars {
+		for b := range d.cars {
+			if a == b || !previous[a].seen || !previous[b].seen {
+				continue
+			}
+			before, after := previous[a].lapData, d.cars[a].lapData
+			other, otherAfter := previous[b].lapData, d.cars[b].lapData
+			if before.CarPosition == 0 || other.CarPosition == 0 || after.CarPosition == 0 || otherAfter.CarPosition == 0 {
+				continue
+			}
+			if before.CarPosition < other.CarPosition || after.CarPosition > otherAfter.CarPosition {
+				continue
+			}
+
+			// a swap back before the change was confirmed cancels it
+			if _, ok := d.pending[[2]uint8{uint8(b), uint8(a)}]; ok {
+				delete(d.pending, [2]uint8{uint8(b), uint8(a)})
+				continue
+			}
+			d.pending[[2]uint8{uint8(a), uint8(b)}] = d.change(p.Header, a, b, before.CarPosition)
+		}
+	}
+}
+
+// confirm returns the changes that held for ConfirmTime
+func (d *Detector) confirm(header f1packet.PacketHeader) []models.F1Data {
+	docs := []models.F1Data{}
+	for k, change := range d.pending {
+		a, b := d.cars[k[0]].lapData, d.cars[k[1]].lapData
+		if a.CarPosition > b.CarPosition {
+			delete(d.pending, k)
+			continue
+		}
+		if header.SessionTime-change.SessionTime < ConfirmTime && header.SessionTime >= change.SessionTime {
+			continue
+		}
+		delete(d.pending, k)
+		change.Position = a.CarPosition
+		change.OtherPosition = b.CarPosition
+		docs = append(docs, change)
+	}
+	return docs
+}
+
+func (d *Detector) change(header f1packet.PacketHeader, a, b int, previousPosition uint8) *models.PositionChange {
+	ca, cb := d.cars[a], d.cars[b]
+	change := &models.PositionChange{
+		Header:           models.NewHeader(header),
+		Timestamp:        time.Now().UTC(),
+		Type:             DocumentType,
+		CarIndex:         uint8(a),
+		OtherCarIndex:    uint8(b),
+		Position:         ca.lapData.CarPosition,
+		PreviousPosition: previousPosition,
+		OtherPosition:    cb.lapData.CarPosition,
+		SessionTime:      header.SessionTime,
+		LapNum:           ca.lapData.CurrentLapNum,
+		LapDistance:      ca.lapData.LapDistance,
+		Corner:           corner.At(d.track, ca.lapData.LapDistance),
+		WorldPositionX:   ca.motion.WorldPositionX,
+		WorldPositionZ:   ca.motion.WorldPositionZ,
+		Drs:              ca.drs,
+		OtherDrs:         cb.drs,
+		InPit:            ca.lapData.PitStatus != 0,
+		OtherInPit:       cb.lapData.PitStatus != 0,
+		OtherPenalties:   cb.lapData.Penalties,
+	}
+	change.Header.Source = d.source
+
+	switch {
+	// 4 = disqualified, 5 = not classified, 6 = retired
+	case cb.lapData.ResultStatus >= 4:
+		change.Kind = KindRetirement
+	case change.InPit || change.OtherInPit:
+		change.Kind = KindPitCycle
+	case cb.penaltyTime > 0 && header.SessionTime-cb.penaltyTime <= penaltyWindow:
+		change.Kind = KindPenalty
+	case ca.lapData.TotalDistance > cb.lapData.TotalDistance:
+		change.Kind = KindOvertake
+	default:
+		change.Kind = KindOther
+	}
+	return change
+}
@@ -0,0 +1,148 @@
+package pitstop
+
+import (
+	"time"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+const DocumentType = "pit_stop"
+
+// stationarySpeed is the speed, in kilometres per hour, under which a car is considered stationary
+const stationarySpeed = 1
+
+// car holds the pit stop state of a single car
+type car struct {
+	seen      bool  // Whether lap data was received for the car
+	pitStatus uint8 // 0 = none, 1 = pitting, 2 = in pit area
+
+	status    f1packet.CarStatusData // Latest status received
+	hasStatus bool
+
+	stop          *models.PitStop // Stop in progress, nil if the car is on track
+	telemetryTime float32         // Session time of the latest telemetry received during the stop
+	stationary    bool            // Whether the car was stationary on the latest telemetry
+}
+
+// Detector follows the pit status of every car and emits a PitStop document when a car leaves the pit lane.
+type Detector struct {
+	source models.Source
+	cars   [22]car
+}
+
+// New creates a pit stop detector, it satisfies analysis.Factory
+func New(source models.Source) analysis.Analyzer {
+	return &Detector{
+		source: source,
+	}
+}
+
+// Analyze ...
+func (d *Detector) Analyze(packet interface{}) []models.F1Data {
+	switch p := packet.(type) {
+	case *f1packet.PacketLapData:
+		docs := []models.F1Data{}
+		for i := range p.LapData {
+			if doc := d.lapData(p.Header, i, p.LapData[i]); doc != nil {
+				docs = append(docs, doc)
+			}
+		}
+		return docs
+
+	case *f1packet.PacketCarStatusData:
+		for i := range p.CarStatusData {
+			d.cars[i].status = p.CarStatusData[i]
+			d.cars[i].hasStatus = true
+		}
+
+	case *f1packet.PacketCarTelemetryData:
+		for i := range p.CarTelemetryData {
+			d.telemetry(p.Header, i, p.CarTelemetryData[i])
+		}
+	}
+	return nil
+}
+
+// Flush drops the stops in progress, a stop is only stored once the car left the pit lane
+func (d *Detector) Flush() []models.F1Data {
+	return nil
+}
+
+func (d *Detector) lapData(header f1packet.PacketHeader, i int, ld f1packet.LapData) models.F1Data {
+	c := &d.cars[i]
+	previous := c.pitStatus
+	c.pitStatus = ld.PitStatus
+
+	// a car starting the session in the pits or the garage did not stop
+	if !c.seen {
+		c.seen = true
+		return nil
+	}
+
+	switch {
+	// 0 = in garage
+	case previous == 0 && ld.PitStatus != 0 && ld.DriverStatus != 0:
+		// pit lane entry
+		c.stop = &models.PitStop{
+			Type:                    DocumentType,
+			CarIndex:                uint8(i),
+			LapNum:                  ld.CurrentLapNum,
+			PitLaneEntryTime:        header.SessionTime,
+			PitLaneEntryLapDistance: ld.LapDistance,
+			PositionBefore:          ld.CarPosition,
+		}
+		if c.hasStatus {
+			c.stop.TyresBefore = tyres(c.status)
+		}
+		c.telemetryTime = 0
+		c.stationary = false
+
+	case previous != 0 && ld.PitStatus == 0 && c.stop != nil:
+		// pit lane exit
+		s := c.stop
+		c.stop = nil
+
+		s.Header = models.NewHeader(header)
+		s.Header.Source = d.source
+		s.Timestamp = time.Now().UTC()
+		s.PitLaneExitTime = header.SessionTime
+		s.PitLaneExitLapDistance = ld.LapDistance
+		s.PitLaneTime = s.PitLaneExitTime - s.PitLaneEntryTime
+		s.PositionAfter = ld.CarPosition
+		s.PositionsGained = int(s.PositionBefore) - int(s.PositionAfter)
+		if c.hasStatus {
+			s.TyresAfter = tyres(c.status)
+			s.TyresChanged = s.TyresAfter.ActualTyreCompound != s.TyresBefore.ActualTyreCompound ||
+				s.TyresAfter.VisualTyreCompound != s.TyresBefore.VisualTyreCompound ||
+				s.TyresAfter.TyresAgeLaps < s.TyresBefore.TyresAgeLaps
+		}
+		return s
+	}
+	return nil
+}
+
+// telemetry accumulates the time the car spends stationary during a stop
+func (d *Detector) telemetry(header f1packet.PacketHeader, i int, t f1packet.CarTelemetryData) {
+	c := &d.cars[i]
+	if c.stop == nil {
+		return
+	}
+
+	if c.stationary && c.telemetryTime > 0 {
+		c.stop.StationaryTime += header.SessionTime - c.telemetryTime
+	}
+	c.telemetryTime = header.SessionTime
+	c.stationary = t.Speed < stationarySpeed
+}
+
+func tyres(st f1packet.CarStatusData) models.PitStopTyres {
+	return models.PitStopTyres{
+		ActualTyreCompound:     st.ActualTyreCompound,
+		ActualTyreCompoundName: models.TyreCompoundName(st.ActualTyreCompound),
+		VisualTyreCompound:     st.VisualTyreCompound,
+		VisualTyreCompoundName: models.VisualTyreCompoundName(st.VisualTyreCompound),
+		TyresAgeLaps:           st.TyresAgeLaps,
+	}
+}
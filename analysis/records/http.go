@@ -0,0 +1,67 @@
+package records
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/api"
+)
+
+// LeaderboardHandler returns the personal bests set on a track, the fastest first, given by the query parameters:
+// track, formula ( default 0 ), session_type ( default 12, time trial ) and limit ( default every driver )
+func LeaderboardHandler(store *Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			api.Error(w, http.StatusMethodNotAllowed, "method %s not allowed", req.Method)
+			return
+		}
+
+		query := req.URL.Query()
+		track, err := strconv.ParseInt(query.Get("track"), 10, 8)
+		if err != nil {
+			api.Error(w, http.StatusBadRequest, "invalid track %q", query.Get("track"))
+			return
+		}
+		formula, err := uintParam(query.Get("formula"), 0)
+		if err != nil {
+			api.Error(w, http.StatusBadRequest, "invalid formula %q", query.Get("formula"))
+			return
+		}
+		sessionType, err := uintParam(query.Get("session_type"), 12)
+		if err != nil {
+			api.Error(w, http.StatusBadRequest, "invalid session_type %q", query.Get("session_type"))
+			return
+		}
+		limit, err := strconv.Atoi(query.Get("limit"))
+		if err != nil && query.Get("limit") != "" || limit < 0 {
+			api.Error(w, http.StatusBadRequest, "invalid limit %q", query.Get("limit"))
+			return
+		}
+
+		api.JSON(w, http.StatusOK, store.Leaderboard(int8(track), uint8(formula), uint8(sessionType), limit))
+	})
+}
+
+// DriverHandler returns the personal bests of the driver given by the driver query parameter
+func DriverHandler(store *Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			api.Error(w, http.StatusMethodNotAllowed, "method %s not allowed", req.Method)
+			return
+		}
+
+		driver := req.URL.Query().Get("driver")
+		if driver == "" {
+			api.Error(w, http.StatusBadRequest, "a driver is required")
+			return
+		}
+		api.JSON(w, http.StatusOK, store.Driver(driver))
+	})
+}
+
+func uintParam(value string, fallback uint8) (uint64, error) {
+	if value == "" {
+		return uint64(fallback), nil
+	}
+	return strconv.ParseUint(value, 10, 8)
+}
@@ -0,0 +1,211 @@
+package records
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+const DocumentType = "personal_best"
+
+// car follows the lap in progress of a car
+type car struct {
+	lapData f1packet.LapData // Latest lap data received
+	seen    bool
+	invalid bool // Whether the lap in progress was invalidated
+}
+
+// Tracker submits the valid laps of the human drivers to the records store,
+// and emits a PersonalBest document every time a personal best is improved.
+type Tracker struct {
+	source models.Source
+	store  *Store
+
+	session    f1packet.PacketSessionData
+	hasSession bool
+
+	participants    f1packet.PacketParticipantsData
+	hasParticipants bool
+
+	playerSession uint64 // Session UID the player cars were added to the store for
+	hasPlayers    bool
+
+	cars [22]car
+}
+
+// NewFactory returns an analysis.Factory creating trackers sharing the same store
+func NewFactory(store *Store) analysis.Factory {
+	return func(source models.Source) analysis.Analyzer {
+		return &Tracker{
+			source: source,
+			store:  store,
+		}
+	}
+}
+
+// Analyze ...
+func (t *Tracker) Analyze(packet interface{}) []models.F1Data {
+	switch p := packet.(type) {
+	case *f1packet.PacketSessionData:
+		t.session = *p
+		t.hasSession = true
+
+	case *f1packet.PacketParticipantsData:
+		t.participants = *p
+		t.hasParticipants = true
+
+	case *f1packet.PacketLapData:
+		if !t.hasPlayers || t.playerSession != p.Header.SessionUID {
+			for _, i := range analysis.PlayerCars(p.Header) {
+				t.store.AddPlayer(strconv.FormatUint(p.Header.SessionUID, 10), int(i))
+			}
+			t.playerSession = p.Header.SessionUID
+			t.hasPlayers = true
+		}
+		docs := []models.F1Data{}
+		for i := range p.LapData {
+			if doc := t.lapData(p.Header, i, p.LapData[i]); doc != nil {
+				docs = append(docs, doc)
+			}
+		}
+		return docs
+	}
+	return nil
+}
+
+// Flush ...
+func (t *Tracker) Flush() []models.F1Data {
+	return nil
+}
+
+// lapData follows the lap of a car, it returns a PersonalBest document when the car completed a lap improving a personal best
+func (t *Tracker) lapData(header f1packet.PacketHeader, i int, ld f1packet.LapData) models.F1Data {
+	c := &t.cars[i]
+	previous, seen := c.lapData, c.seen
+	c.lapData = ld
+	c.seen = true
+	if !seen {
+		return nil
+	}
+
+	completed := ld.CurrentLapNum > previous.CurrentLapNum ||
+		// 3 = finished, the lap number does not change when crossing the line for the last time
+		(ld.ResultStatus == 3 && previous.ResultStatus != 3)
+	if ld.CurrentLapNum < previous.CurrentLapNum {
+		// restarted, ie: in time trial
+		c.invalid = false
+		return nil
+	}
+	if !completed {
+		// 1 = invalid
+		if ld.CurrentLapInvalid == 1 {
+			c.invalid = true
+		}
+		return nil
+	}
+
+	invalid := c.invalid || previous.CurrentLapInvalid == 1
+	c.invalid = false
+	// the car has to be recorded since the start of the lap, and 0 = invalid, 1 = inactive
+	if invalid || previous.CurrentLapNum == 0 || ld.LastLapTime <= 0 || previous.ResultStatus < 2 || !t.hasSession {
+		return nil
+	}
+
+	sessionUID := strconv.FormatUint(header.SessionUID, 10)
+	player := false
+	for _, p := range analysis.PlayerCars(header) {
+		if int(p) == i {
+			player = true
+		}
+	}
+	driver, ok := t.driver(i, player)
+	if !ok {
+		return nil
+	}
+	// the player car of another rig is recorded by that rig under the driver configured for it,
+	// the other cars are seen by every rig
+	if !player && (t.store.IsPlayer(sessionUID, i) || !t.store.Claim(sessionUID, i, previous.CurrentLapNum)) {
+		return nil
+	}
+
+	lap := Lap{
+		Driver:      driver,
+		TrackID:     t.session.TrackID,
+		Formula:     t.session.Formula,
+		SessionType: t.session.SessionType,
+		SessionUID:  sessionUID,
+		LapTime:     ld.LastLapTime,
+	}
+	lap.Sectors[0] = float32(previous.Sector1TimeInMS) / 1000
+	lap.Sectors[1] = float32(previous.Sector2TimeInMS) / 1000
+	if lap.Sectors[0] > 0 && lap.Sectors[1] > 0 && lap.LapTime > lap.Sectors[0]+lap.Sectors[1] {
+		lap.Sectors[2] = lap.LapTime - lap.Sectors[0] - lap.Sectors[1]
+	} else {
+		lap.Sectors = [3]float32{}
+	}
+
+	record, improvement, err := t.store.Submit(lap)
+	if err != nil {
+		logrus.WithError(err).Errorf("could not save the records of %s", driver)
+	}
+	if !improvement.Improved() {
+		return nil
+	}
+
+	doc := &models.PersonalBest{
+		Header:              models.NewHeader(header),
+		Timestamp:           time.Now().UTC(),
+		Type:                DocumentType,
+		Driver:              driver,
+		CarIndex:            uint8(i),
+		LapNum:              previous.CurrentLapNum,
+		TrackID:             lap.TrackID,
+		TrackName:           models.TrackName(lap.TrackID),
+		Formula:             lap.Formula,
+		SessionType:         lap.SessionType,
+		SessionTypeName:     models.SessionTypeName(lap.SessionType),
+		LapTime:             lap.LapTime,
+		Sectors:             lap.Sectors[:],
+		LapImproved:         improvement.Lap,
+		SectorsImproved:     improvement.Sectors[:],
+		PreviousLapTime:     improvement.PreviousLapTime,
+		PersonalBestLapTime: record.LapTime,
+		BestSectors:         record.BestSectors[:],
+		TheoreticalBest:     record.TheoreticalBest(),
+	}
+	doc.Header.Source = t.source
+	if improvement.Lap && improvement.PreviousLapTime > 0 {
+		doc.Improvement = improvement.PreviousLapTime - lap.LapTime
+	}
+	for n, r := range t.store.Leaderboard(lap.TrackID, lap.Formula, lap.SessionType, 0) {
+		if r.Driver == driver {
+			doc.LeaderboardPosition = n + 1
+			break
+		}
+	}
+	return doc
+}
+
+// driver returns the name of the human driver of a car.
+// The driver configured for the rig names the player cars, the participants name the other cars.
+func (t *Tracker) driver(i int, player bool) (string, bool) {
+	if player && t.source.Driver != "" {
+		return t.source.Driver, true
+	}
+	if !t.hasParticipants {
+		return "", false
+	}
+
+	p := t.participants.Participants[i]
+	// 1 = AI
+	if p.AiControlled == 1 {
+		return "", false
+	}
+	name := models.ParticipantName(p.Name)
+	return name, name != ""
+}
@@ -0,0 +1,264 @@
+package records
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Record holds the personal bests of a driver on a track, for a formula and a session type
+type Record struct {
+	Driver      string
+	TrackID     int8
+	Formula     uint8
+	SessionType uint8
+
+	Laps int // Number of valid laps recorded
+
+	LapTime     float32    // Personal best lap time in seconds
+	LapSectors  [3]float32 // Sector times of the personal best lap in seconds, 0 when not known
+	LapSetAt    time.Time
+	LapSession  string     // Session UID the personal best lap was set in
+	BestSectors [3]float32 // Personal best time in every sector in seconds, 0 when not known
+}
+
+// TheoreticalBest returns the sum of the best sectors, 0 when a sector is not known
+func (r Record) TheoreticalBest() float32 {
+	sum := float32(0)
+	for _, s := range r.BestSectors {
+		if s <= 0 {
+			return 0
+		}
+		sum += s
+	}
+	return sum
+}
+
+// Lap is a valid lap submitted to the store
+type Lap struct {
+	Driver      string
+	TrackID     int8
+	Formula     uint8
+	SessionType uint8
+	SessionUID  string
+
+	LapTime float32    // Lap time in seconds
+	Sectors [3]float32 // Sector times in seconds, 0 when not known
+}
+
+// Improvement tells which personal bests a lap improved
+type Improvement struct {
+	Lap             bool
+	Sectors         [3]bool
+	PreviousLapTime float32    // Personal best lap time before the lap, 0 if none
+	PreviousSectors [3]float32 // Personal best sector times before the lap, 0 if none
+}
+
+// Improved returns whether the lap improved any personal best
+func (i Improvement) Improved() bool {
+	return i.Lap || i.Sectors[0] || i.Sectors[1] || i.Sectors[2]
+}
+
+// maxSessions is the number of recent sessions whose player cars and claimed laps are kept
+const maxSessions = 16
+
+// session holds the player cars and the laps claimed in a session.
+// With several rigs every tracker follows every car, a player car is recorded from its own rig
+// and the laps of the other cars are claimed by the first rig submitting them.
+type session struct {
+	players [22]bool
+	laps    map[string]bool
+}
+
+// Store keeps the personal bests of every driver across sessions.
+// It is saved as json to a file when a path is given, and kept in memory otherwise.
+type Store struct {
+	mu      sync.Mutex
+	path    string
+	Records map[string]*Record
+
+	sessions map[string]*session
+	order    []string // Session UIDs from the oldest to the latest
+}
+
+// NewStore loads the records saved at path, an empty path keeps the records in memory only
+func NewStore(path string) (*Store, error) {
+	s := &Store{
+		path:     path,
+		Records:  map[string]*Record{},
+		sessions: map[string]*session{},
+	}
+	if path == "" {
+		return s, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, errors.Wrapf(err, "could not read records %s", path)
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, errors.Wrapf(err, "could not decode records %s", path)
+	}
+	return s, nil
+}
+
+func recordKey(driver string, trackID int8, formula, sessionType uint8) string {
+	return strings.Join([]string{
+		driver,
+		strconv.Itoa(int(trackID)),
+		strconv.Itoa(int(formula)),
+		strconv.Itoa(int(sessionType)),
+	}, "/")
+}
+
+// session returns the session of a session UID, s.mu has to be held
+func (s *Store) session(uid string) *session {
+	ss, ok := s.sessions[uid]
+	if !ok {
+		ss = &session{laps: map[string]bool{}}
+		s.sessions[uid] = ss
+		s.order = append(s.order, uid)
+		if len(s.order) > maxSessions {
+			delete(s.sessions, s.order[0])
+			s.order = s.order[1:]
+		}
+	}
+	return ss
+}
+
+// AddPlayer marks a car as the player car of a rig in a session
+func (s *Store) AddPlayer(sessionUID string, carIndex int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.session(sessionUID).players[carIndex] = true
+}
+
+// IsPlayer returns whether a car is the player car of a rig in a session
+func (s *Store) IsPlayer(sessionUID string, carIndex int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ss, ok := s.sessions[sessionUID]
+	return ok && ss.players[carIndex]
+}
+
+// Claim returns whether a lap of a car was not claimed yet in a session, and claims it
+func (s *Store) Claim(sessionUID string, carIndex int, lapNum uint8) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ss := s.session(sessionUID)
+	key := strconv.Itoa(carIndex) + "/" + strconv.Itoa(int(lapNum))
+	if ss.laps[key] {
+		return false
+	}
+	ss.laps[key] = true
+	return true
+}
+
+// Submit records a valid lap, and saves the records when a personal best was improved
+func (s *Store) Submit(lap Lap) (Record, Improvement, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := recordKey(lap.Driver, lap.TrackID, lap.Formula, lap.SessionType)
+	r, ok := s.Records[key]
+	if !ok {
+		r = &Record{
+			Driver:      lap.Driver,
+			TrackID:     lap.TrackID,
+			Formula:     lap.Formula,
+			SessionType: lap.SessionType,
+		}
+		s.Records[key] = r
+	}
+	r.Laps++
+
+	improvement := Improvement{
+		PreviousLapTime: r.LapTime,
+		PreviousSectors: r.BestSectors,
+	}
+	if lap.LapTime > 0 && (r.LapTime == 0 || lap.LapTime < r.LapTime) {
+		improvement.Lap = true
+		r.LapTime = lap.LapTime
+		r.LapSectors = lap.Sectors
+		r.LapSetAt = time.Now().UTC()
+		r.LapSession = lap.SessionUID
+	}
+	for i, t := range lap.Sectors {
+		if t > 0 && (r.BestSectors[i] == 0 || t < r.BestSectors[i]) {
+			improvement.Sectors[i] = true
+			r.BestSectors[i] = t
+		}
+	}
+
+	if s.path == "" || !improvement.Improved() {
+		return *r, improvement, nil
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return *r, improvement, errors.Wrap(err, "could not encode records")
+	}
+	if err := ioutil.WriteFile(s.path, data, 0644); err != nil {
+		return *r, improvement, errors.Wrapf(err, "could not write records %s", s.path)
+	}
+	return *r, improvement, nil
+}
+
+// Leaderboard returns the personal bests set on a track for a formula and a session type, the fastest first.
+// A limit of 0 returns every driver.
+func (s *Store) Leaderboard(trackID int8, formula, sessionType uint8, limit int) []Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records := []Record{}
+	for _, r := range s.Records {
+		if r.TrackID == trackID && r.Formula == formula && r.SessionType == sessionType && r.LapTime > 0 {
+			records = append(records, *r)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].LapTime != records[j].LapTime {
+			return records[i].LapTime < records[j].LapTime
+		}
+		// the first to set a time keeps the position
+		return records[i].LapSetAt.Before(records[j].LapSetAt)
+	})
+	if limit > 0 && len(records) > limit {
+		records = records[:limit]
+	}
+	return records
+}
+
+// Driver returns the personal bests of a driver, ordered by track, formula and session type
+func (s *Store) Driver(driver string) []Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records := []Record{}
+	for _, r := range s.Records {
+		if r.Driver == driver {
+			records = append(records, *r)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		a, b := records[i], records[j]
+		if a.TrackID != b.TrackID {
+			return a.TrackID < b.TrackID
+		}
+		if a.Formula != b.Formula {
+			return a.Formula < b.Formula
+		}
+		return a.SessionType < b.SessionType
+	})
+	return records
+}
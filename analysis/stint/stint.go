@@ -0,0 +1,220 @@
+package stint
+
+import (
+	"time"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+const DocumentType = "stint"
+
+// car holds the stint state of a single car
+type car struct {
+	lapNum uint8 // Lap the car is currently on
+	pitted bool  // Whether the car went through the pit lane during the current lap
+
+	// a set of the same compound as old as the one removed is only noticed when the car leaves the pit lane
+	boxed   bool // Whether the car stopped in the pit area since entering the pit lane
+	fitted  bool // Whether a new set was noticed during the pit stop
+	pitStop bool // Whether the car left the pit lane after a stop where no new set was noticed
+
+	status    f1packet.CarStatusData // Latest status received
+	hasStatus bool
+
+	stint     *models.Stint // Stint in progress, nil if none
+	stints    int           // Number of stints started
+	lastAge   uint8         // Latest tyres age of the stint in progress
+	startWear float32       // Average tyre wear when the stint started
+}
+
+// Tracker follows the tyres of every car and emits a Stint document every time a set of tyres is removed.
+type Tracker struct {
+	source models.Source
+	header f1packet.PacketHeader // Header of the latest packet
+	cars   [22]car
+}
+
+// New creates a stint tracker, it satisfies analysis.Factory
+func New(source models.Source) analysis.Analyzer {
+	return &Tracker{
+		source: source,
+	}
+}
+
+// Analyze ...
+func (t *Tracker) Analyze(packet interface{}) []models.F1Data {
+	switch p := packet.(type) {
+	case *f1packet.PacketLapData:
+		t.header = p.Header
+		for i := range p.LapData {
+			t.lapData(i, p.LapData[i])
+		}
+
+	case *f1packet.PacketCarStatusData:
+		t.header = p.Header
+		docs := []models.F1Data{}
+		for i := range p.CarStatusData {
+			if doc := t.carStatus(i, p.CarStatusData[i]); doc != nil {
+				docs = append(docs, doc)
+			}
+		}
+		return docs
+
+	case *f1packet.PacketFinalClassificationData:
+		// the race is over, every stint ends with it
+		t.header = p.Header
+		return t.Flush()
+	}
+	return nil
+}
+
+// Flush ends the stints in progress
+func (t *Tracker) Flush() []models.F1Data {
+	docs := []models.F1Data{}
+	for i := range t.cars {
+		if doc := t.end(i); doc != nil {
+			docs = append(docs, doc)
+		}
+	}
+	return docs
+}
+
+func (t *Tracker) lapData(i int, ld f1packet.LapData) {
+	c := &t.cars[i]
+
+	// 0 = invalid, 1 = inactive
+	if ld.ResultStatus < 2 || ld.CurrentLapNum == 0 {
+		return
+	}
+
+	if c.lapNum == 0 {
+		c.lapNum = ld.CurrentLapNum
+	}
+
+	if ld.PitStatus != 0 {
+		c.pitted = true
+	}
+	// 2 = in pit area
+	if ld.PitStatus == 2 {
+		c.boxed = true
+	} else if ld.PitStatus == 0 && c.boxed {
+		c.pitStop = !c.fitted
+		c.boxed = false
+		c.fitted = false
+	}
+
+	if ld.CurrentLapNum <= c.lapNum {
+		return
+	}
+
+	// lap completed
+	if c.stint != nil {
+		lap := models.StintLap{
+			LapNum:  c.lapNum,
+			LapTime: ld.LastLapTime,
+			PitLap:  c.pitted,
+		}
+		if c.hasStatus {
+			lap.RearLeftTyresWear = c.status.TyresWear[0]
+			lap.RearRightTyresWear = c.status.TyresWear[1]
+			lap.FrontLeftTyresWear = c.status.TyresWear[2]
+			lap.FrontRightTyresWear = c.status.TyresWear[3]
+			lap.AverageTyresWear = averageWear(c.status.TyresWear)
+		}
+		c.stint.Laps = append(c.stint.Laps, lap)
+	}
+
+	c.lapNum = ld.CurrentLapNum
+	c.pitted = ld.PitStatus != 0
+}
+
+// carStatus starts a new stint when the car is seen with a different set of tyres,
+// and returns the stint that ended if any.
+func (t *Tracker) carStatus(i int, st f1packet.CarStatusData) models.F1Data {
+	c := &t.cars[i]
+	c.status = st
+	c.hasStatus = true
+
+	// the car is not on track yet
+	if c.lapNum == 0 {
+		return nil
+	}
+
+	if c.stint != nil {
+		changed := st.ActualTyreCompound != c.stint.ActualTyreCompound ||
+			st.VisualTyreCompound != c.stint.VisualTyreCompound ||
+			st.TyresAgeLaps < c.lastAge ||
+			c.pitStop
+		c.lastAge = st.TyresAgeLaps
+		if !changed {
+			return nil
+		}
+	}
+
+	doc := t.end(i)
+	c.pitStop = false
+	c.fitted = c.boxed
+
+	c.stints++
+	c.lastAge = st.TyresAgeLaps
+	c.startWear = averageWear(st.TyresWear)
+	c.stint = &models.Stint{
+		Type:                   DocumentType,
+		CarIndex:               uint8(i),
+		StintNumber:            c.stints,
+		StartLap:               c.lapNum,
+		ActualTyreCompound:     st.ActualTyreCompound,
+		ActualTyreCompoundName: models.TyreCompoundName(st.ActualTyreCompound),
+		VisualTyreCompound:     st.VisualTyreCompound,
+		VisualTyreCompoundName: models.VisualTyreCompoundName(st.VisualTyreCompound),
+		TyresAgeAtStart:        st.TyresAgeLaps,
+		Laps:                   []models.StintLap{},
+	}
+
+	return doc
+}
+
+// end closes the stint in progress of a car, it returns nil if there was none or if no lap was completed on it
+func (t *Tracker) end(i int) models.F1Data {
+	c := &t.cars[i]
+	s := c.stint
+	c.stint = nil
+	if s == nil || len(s.Laps) == 0 {
+		return nil
+	}
+
+	s.Header = models.NewHeader(t.header)
+	s.Header.Source = t.source
+	s.Timestamp = time.Now().UTC()
+	s.EndLap = s.Laps[len(s.Laps)-1].LapNum
+	s.NumLaps = len(s.Laps)
+
+	// the wear progression starts with the wear the tyres were fitted with
+	laps, wears := []float64{0}, []float64{float64(c.startWear)}
+	timedLaps, times := []float64{}, []float64{}
+	for n, lap := range s.Laps {
+		laps = append(laps, float64(n+1))
+		wears = append(wears, float64(lap.AverageTyresWear))
+
+		if lap.PitLap || lap.LapTime <= 0 {
+			continue
+		}
+		timedLaps = append(timedLaps, float64(lap.LapNum))
+		times = append(times, float64(lap.LapTime))
+		if s.BestLapTime == 0 || lap.LapTime < s.BestLapTime {
+			s.BestLapTime = lap.LapTime
+		}
+	}
+
+	s.DegradationRate = float32(analysis.Slope(laps, wears))
+	s.LapTimeTrend = float32(analysis.Slope(timedLaps, times))
+	s.AverageLapTime = float32(analysis.Mean(times))
+
+	return s
+}
+
+func averageWear(wear [4]uint8) float32 {
+	return float32(int(wear[0])+int(wear[1])+int(wear[2])+int(wear[3])) / 4
+}
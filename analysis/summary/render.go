@@ -0,0 +1,122 @@
+package summary
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+var funcs = map[string]interface{}{
+	"laptime": lapTime,
+	"clock":   clock,
+	"join":    strings.Join,
+	"pits":    pits,
+	"cell":    cell,
+}
+
+var markdown = texttemplate.Must(texttemplate.New("markdown").Funcs(funcs).Parse(`# {{ .TrackName }} - {{ .SessionTypeName }}
+{{ if .TotalLaps }}
+{{ .TotalLaps }} laps
+{{ end }}
+## Results
+
+| Pos | Driver | Team | Grid | Laps | Time | Best lap | Pit stops | Tyres | Penalties | Status | Points |
+| --- | --- | --- | --- | --- | --- | --- | --- | --- | --- | --- | --- |
+{{ range .Drivers }}| {{ .Position }} | {{ cell .Name }} | {{ cell .Team }} | {{ .GridPosition }} | {{ .Laps }} | {{ laptime .TotalRaceTime }} | {{ laptime .BestLapTime }}{{ if .BestLapNum }} ( lap {{ .BestLapNum }} ){{ end }} | {{ pits . }} | {{ join .TyreStints " > " }} | {{ if .PenaltiesTime }}{{ .PenaltiesTime }}s{{ end }} | {{ .Status }} | {{ .Points }} |
+{{ end }}
+## Weather
+
+| Session time | Weather | Track | Air |
+| --- | --- | --- | --- |
+{{ range .Weather }}| {{ clock .SessionTime }} | {{ .WeatherName }} | {{ .TrackTemperature }}°C | {{ .AirTemperature }}°C |
+{{ end }}`))
+
+var html = htmltemplate.Must(htmltemplate.New("html").Funcs(funcs).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{ .TrackName }} - {{ .SessionTypeName }}</title>
+<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }
+</style>
+</head>
+<body>
+<h1>{{ .TrackName }} - {{ .SessionTypeName }}</h1>
+{{ if .TotalLaps }}<p>{{ .TotalLaps }} laps</p>{{ end }}
+<h2>Results</h2>
+<table>
+<tr><th>Pos</th><th>Driver</th><th>Team</th><th>Grid</th><th>Laps</th><th>Time</th><th>Best lap</th><th>Pit stops</th><th>Tyres</th><th>Penalties</th><th>Status</th><th>Points</th></tr>
+{{ range .Drivers }}<tr><td>{{ .Position }}</td><td>{{ .Name }}</td><td>{{ .Team }}</td><td>{{ .GridPosition }}</td><td>{{ .Laps }}</td><td>{{ laptime .TotalRaceTime }}</td><td>{{ laptime .BestLapTime }}{{ if .BestLapNum }} ( lap {{ .BestLapNum }} ){{ end }}</td><td>{{ pits . }}</td><td>{{ join .TyreStints " > " }}</td><td>{{ if .PenaltiesTime }}{{ .PenaltiesTime }}s{{ end }}</td><td>{{ .Status }}</td><td>{{ .Points }}</td></tr>
+{{ end }}</table>
+<h2>Weather</h2>
+<table>
+<tr><th>Session time</th><th>Weather</th><th>Track</th><th>Air</th></tr>
+{{ range .Weather }}<tr><td>{{ clock .SessionTime }}</td><td>{{ .WeatherName }}</td><td>{{ .TrackTemperature }}°C</td><td>{{ .AirTemperature }}°C</td></tr>
+{{ end }}</table>
+</body>
+</html>
+`))
+
+// Markdown renders a summary as a Markdown report
+func Markdown(w io.Writer, summary *models.SessionSummary) error {
+	return markdown.Execute(w, summary)
+}
+
+// HTML renders a summary as an HTML page
+func HTML(w io.Writer, summary *models.SessionSummary) error {
+	return html.Execute(w, summary)
+}
+
+// lapTime formats a time in seconds as h:mm:ss.sss or m:ss.sss, empty for 0
+func lapTime(seconds float32) string {
+	if seconds <= 0 {
+		return ""
+	}
+	return clock(seconds)
+}
+
+// clock formats a time in seconds as h:mm:ss.sss or m:ss.sss
+func clock(seconds float32) string {
+	if seconds < 0 {
+		seconds = 0
+	}
+	ms := int64(seconds*1000 + 0.5)
+	h, m, s, ms := ms/3600000, ms/60000%60, ms/1000%60, ms%1000
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d.%03d", h, m, s, ms)
+	}
+	return fmt.Sprintf("%d:%02d.%03d", m, s, ms)
+}
+
+// cellEscaper escapes the characters of a name breaking a Markdown table or formatting it
+var cellEscaper = strings.NewReplacer("\\", "\\\\", "|", "\\|", "*", "\\*", "_", "\\_", "`", "\\`", "\n", " ", "\r", " ")
+
+// cell escapes a name, ie: of an online player, to be written in a Markdown table cell
+func cell(name string) string {
+	name = cellEscaper.Replace(name)
+	if strings.HasPrefix(name, "#") {
+		name = "\\" + name
+	}
+	return name
+}
+
+// pits formats the pit stops of a driver with the laps they were made on when known
+func pits(driver models.DriverResult) string {
+	if driver.PitStops == 0 {
+		return "0"
+	}
+	if len(driver.PitStopLaps) == 0 {
+		return fmt.Sprint(driver.PitStops)
+	}
+	laps := make([]string, len(driver.PitStopLaps))
+	for i, lap := range driver.PitStopLaps {
+		laps[i] = fmt.Sprint(lap)
+	}
+	return fmt.Sprintf("%d ( lap %s )", driver.PitStops, strings.Join(laps, ", "))
+}
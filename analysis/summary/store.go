@@ -0,0 +1,64 @@
+package summary
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+// Store saves the summary of every session as a json file in a directory, nothing is saved without directory
+type Store struct {
+	dir string
+}
+
+// NewStore creates a store saving the summaries to dir, an empty dir does not save them
+func NewStore(dir string) (*Store, error) {
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, errors.Wrapf(err, "could not create summary directory %s", dir)
+		}
+	}
+	return &Store{
+		dir: dir,
+	}, nil
+}
+
+// Path returns the file the summary of a session is saved to
+func Path(dir string, sessionUID string) string {
+	return filepath.Join(dir, fmt.Sprintf("summary-%s.json", sessionUID))
+}
+
+// Load reads a summary saved to a file
+func Load(path string) (*models.SessionSummary, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	summary := &models.SessionSummary{}
+	if err := json.Unmarshal(data, summary); err != nil {
+		return nil, errors.Wrapf(err, "could not decode summary %s", path)
+	}
+	return summary, nil
+}
+
+// Save writes the summary of a session, replacing the previous one
+func (s *Store) Save(summary *models.SessionSummary) error {
+	if s.dir == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not encode summary")
+	}
+	if err := ioutil.WriteFile(Path(s.dir, summary.Header.SessionUID), data, 0644); err != nil {
+		return errors.Wrapf(err, "could not write summary of session %s", summary.Header.SessionUID)
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package summary
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+const DocumentType = "session_summary"
+
+// car holds what is known of a car during the session
+type car struct {
+	lapData f1packet.LapData // Latest lap data received
+	seen    bool
+	pitLaps []uint8 // Laps the car entered the pit lane on
+
+	// Compounds of every tyre stint, from the car status
+	actual []uint8
+	visual []uint8
+}
+
+// Builder follows a session and sums it up once the final classification is received or the session ends.
+type Builder struct {
+	source models.Source
+	store  *Store
+
+	header models.Header // Header of the latest packet
+
+	session    f1packet.PacketSessionData
+	hasSession bool
+	weather    []models.WeatherSample
+
+	participants    f1packet.PacketParticipantsData
+	hasParticipants bool
+
+	cars [22]car
+	done bool // Whether the summary was built from the final classification
+}
+
+// NewFactory returns an analysis.Factory creating summary builders saving the summaries to store
+func NewFactory(store *Store) analysis.Factory {
+	return func(source models.Source) analysis.Analyzer {
+		return &Builder{
+			source: source,
+			store:  store,
+		}
+	}
+}
+
+// Analyze ...
+func (b *Builder) Analyze(packet interface{}) []models.F1Data {
+	switch p := packet.(type) {
+	case *f1packet.PacketSessionData:
+		b.setHeader(p.Header)
+		b.session = *p
+		b.hasSession = true
+		if n := len(b.weather); n == 0 || b.weather[n-1].Weather != p.Weather ||
+			b.weather[n-1].TrackTemperature != p.TrackTemperature || b.weather[n-1].AirTemperature != p.AirTemperature {
+			b.weather = append(b.weather, models.WeatherSample{
+				SessionTime:      p.Header.SessionTime,
+				Weather:          p.Weather,
+				WeatherName:      models.WeatherName(p.Weather),
+				TrackTemperature: p.TrackTemperature,
+				AirTemperature:   p.AirTemperature,
+			})
+		}
+
+	case *f1packet.PacketParticipantsData:
+		b.participants = *p
+		b.hasParticipants = true
+
+	case *f1packet.PacketLapData:
+		b.setHeader(p.Header)
+		for i, ld := range p.LapData {
+			c := &b.cars[i]
+			// 1 = pitting, the car entered the pit lane
+			if c.seen && c.lapData.PitStatus == 0 && ld.PitStatus == 1 {
+				c.pitLaps = append(c.pitLaps, ld.CurrentLapNum)
+			}
+			c.lapData = ld
+			// 0 = invalid, 1 = inactive
+			c.seen = c.seen || ld.ResultStatus >= 2
+		}
+
+	case *f1packet.PacketCarStatusData:
+		for i, st := range p.CarStatusData {
+			c := &b.cars[i]
+			if n := len(c.actual); n == 0 || c.actual[n-1] != st.ActualTyreCompound || c.visual[n-1] != st.VisualTyreCompound {
+				c.actual = append(c.actual, st.ActualTyreCompound)
+				c.visual = append(c.visual, st.VisualTyreCompound)
+			}
+		}
+
+	case *f1packet.PacketFinalClassificationData:
+		if b.done {
+			return nil
+		}
+		b.setHeader(p.Header)
+		b.done = true
+		return []models.F1Data{b.save(b.build(p))}
+	}
+	return nil
+}
+
+// Flush sums up the session when no final classification was received
+func (b *Builder) Flush() []models.F1Data {
+	if b.done {
+		return nil
+	}
+	for _, c := range b.cars {
+		if c.seen {
+			b.done = true
+			return []models.F1Data{b.save(b.build(nil))}
+		}
+	}
+	return nil
+}
+
+func (b *Builder) setHeader(header f1packet.PacketHeader) {
+	b.header = models.NewHeader(header)
+	b.header.Source = b.source
+}
+
+func (b *Builder) save(summary *models.SessionSummary) *models.SessionSummary {
+	if err := b.store.Save(summary); err != nil {
+		logrus.WithError(err).Errorf("could not save summary of session %s", summary.Header.SessionUID)
+	}
+	return summary
+}
+
+// build sums up the session, from the final classification when given
+func (b *Builder) build(final *f1packet.PacketFinalClassificationData) *models.SessionSummary {
+	summary := &models.SessionSummary{
+		Header:          b.header,
+		Timestamp:       time.Now().UTC(),
+		Type:            DocumentType,
+		TrackID:         -1,
+		TrackName:       models.TrackName(-1),
+		SessionTypeName: models.SessionTypeName(0),
+		Final:           final != nil,
+		Weather:         b.weather,
+		Drivers:         []models.DriverResult{},
+	}
+	if summary.Weather == nil {
+		summary.Weather = []models.WeatherSample{}
+	}
+	if b.hasSession {
+		summary.TrackID = b.session.TrackID
+		summary.TrackName = models.TrackName(b.session.TrackID)
+		summary.SessionType = b.session.SessionType
+		summary.SessionTypeName = models.SessionTypeName(b.session.SessionType)
+		summary.Formula = b.session.Formula
+		summary.TotalLaps = b.session.TotalLaps
+	}
+
+	for i := range b.cars {
+		c := &b.cars[i]
+		driver := models.DriverResult{
+			CarIndex:         uint8(i),
+			Name:             fmt.Sprintf("car %d", i),
+			PitStopLaps:      c.pitLaps,
+			TyreStints:       []string{},
+			TyreStintsActual: []string{},
+		}
+		if b.hasParticipants {
+			p := b.participants.Participants[i]
+			driver.Name = models.ParticipantName(p.Name)
+			driver.Team = models.TeamName(p.TeamID)
+			driver.RaceNumber = p.RaceNumber
+			driver.AiControlled = p.AiControlled == 1
+		}
+		if driver.PitStopLaps == nil {
+			driver.PitStopLaps = []uint8{}
+		}
+
+		if final != nil {
+			fc := final.ClassificationData[i]
+			if fc.Position == 0 || fc.ResultStatus < 2 {
+				continue
+			}
+			driver.Position = fc.Position
+			driver.GridPosition = fc.GridPosition
+			driver.Laps = fc.NumLaps
+			driver.Status = models.ResultStatusName(fc.ResultStatus)
+			driver.Points = fc.Points
+			driver.TotalRaceTime = fc.TotalRaceTime
+			driver.BestLapTime = fc.BestLapTime
+			driver.PitStops = int(fc.NumPitStops)
+			driver.Penalties = fc.NumPenalties
+			driver.PenaltiesTime = fc.PenaltiesTime
+			for s := 0; s < int(fc.NumTyreStints) && s < len(fc.TyreStintsActual); s++ {
+				driver.TyreStintsActual = append(driver.TyreStintsActual, models.TyreCompoundName(fc.TyreStintsActual[s]))
+				driver.TyreStints = append(driver.TyreStints, models.VisualTyreCompoundName(fc.TyreStintsVisual[s]))
+			}
+		} else {
+			ld := c.lapData
+			if !c.seen || ld.CarPosition == 0 {
+				continue
+			}
+			driver.Position = ld.CarPosition
+			driver.GridPosition = ld.GridPosition
+			driver.Laps = ld.CurrentLapNum
+			// 2 = active, the current lap is not completed
+			if ld.ResultStatus == 2 && driver.Laps > 0 {
+				driver.Laps--
+			}
+			driver.Status = models.ResultStatusName(ld.ResultStatus)
+			driver.BestLapTime = ld.BestLapTime
+			driver.PitStops = len(c.pitLaps)
+			driver.PenaltiesTime = ld.Penalties
+			for s := range c.actual {
+				driver.TyreStintsActual = append(driver.TyreStintsActual, models.TyreCompoundName(c.actual[s]))
+				driver.TyreStints = append(driver.TyreStints, models.VisualTyreCompoundName(c.visual[s]))
+			}
+		}
+		if c.seen {
+			driver.BestLapNum = c.lapData.BestLapNum
+		}
+		summary.Drivers = append(summary.Drivers, driver)
+	}
+
+	sort.Slice(summary.Drivers, func(i, j int) bool {
+		return summary.Drivers[i].Position < summary.Drivers[j].Position
+	})
+	return summary
+}
@@ -0,0 +1,237 @@
+package timing
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+const (
+	DocumentType = "timing_tower"
+
+	// StoreInterval is the session time in seconds between two stored TimingTower documents,
+	// the tower is published live on every lap data update.
+	StoreInterval = 5
+
+	// historyStep is the distance in metres between two points of the history of a car
+	historyStep = 10
+)
+
+// history is the session time a car passed every point of the distance it covered
+type history struct {
+	distances []float32
+	times     []float32
+}
+
+// add records the session time the car is at a distance.
+// A flashback moves the car back, the history is cut back to the distance it is at.
+func (h *history) add(distance, sessionTime float32) {
+	n := len(h.distances)
+	if n > 0 && distance < h.distances[n-1] {
+		i := sort.Search(n, func(i int) bool { return h.distances[i] >= distance })
+		h.distances, h.times = h.distances[:i], h.times[:i]
+	} else if n > 0 && distance < h.distances[n-1]+historyStep {
+		return
+	}
+	h.distances = append(h.distances, distance)
+	h.times = append(h.times, sessionTime)
+}
+
+// rewind drops the points recorded after a session time
+func (h *history) rewind(sessionTime float32) {
+	i := sort.Search(len(h.times), func(i int) bool { return h.times[i] > sessionTime })
+	h.distances, h.times = h.distances[:i], h.times[:i]
+}
+
+// at returns the session time the car passed a distance, false if it was not recorded there
+func (h *history) at(distance float32) (float32, bool) {
+	n := len(h.distances)
+	if n == 0 || distance < h.distances[0] || distance > h.distances[n-1] {
+		return 0, false
+	}
+	i := sort.Search(n, func(i int) bool { return h.distances[i] >= distance })
+	if i == 0 || h.distances[i] == distance {
+		return h.times[i], true
+	}
+	d0, d1 := h.distances[i-1], h.distances[i]
+	t0, t1 := h.times[i-1], h.times[i]
+	return t0 + (t1-t0)*(distance-d0)/(d1-d0), true
+}
+
+// Tower computes the timing tower of a session on every lap data update
+type Tower struct {
+	source models.Source
+	towers *Towers
+
+	session    f1packet.PacketSessionData
+	hasSession bool
+
+	histories  [22]history
+	latestTime float32 // Session time of the latest lap data
+	storeTime  float32 // Session time the latest TimingTower document was stored at
+}
+
+// NewFactory returns an analysis.Factory creating timing towers publishing to towers
+func NewFactory(towers *Towers) analysis.Factory {
+	return func(source models.Source) analysis.Analyzer {
+		return &Tower{
+			source: source,
+			towers: towers,
+		}
+	}
+}
+
+// Analyze ...
+func (t *Tower) Analyze(packet interface{}) []models.F1Data {
+	switch p := packet.(type) {
+	case *f1packet.PacketSessionData:
+		t.session = *p
+		t.hasSession = true
+
+	case *f1packet.PacketLapData:
+		tower := t.update(p)
+		t.towers.Publish(tower)
+		if p.Header.SessionTime-t.storeTime >= StoreInterval || p.Header.SessionTime < t.storeTime {
+			t.storeTime = p.Header.SessionTime
+			return []models.F1Data{tower}
+		}
+	}
+	return nil
+}
+
+// Flush ...
+func (t *Tower) Flush() []models.F1Data {
+	return nil
+}
+
+// race returns whether the cars are timed against each other on track, the race is assumed until the session is known
+func (t *Tower) race() bool {
+	// 10 = R, 11 = R2
+	return !t.hasSession || t.session.SessionType == 10 || t.session.SessionType == 11
+}
+
+func (t *Tower) update(p *f1packet.PacketLapData) *models.TimingTower {
+	tower := &models.TimingTower{
+		Header:      models.NewHeader(p.Header),
+		Timestamp:   time.Now().UTC(),
+		Type:        DocumentType,
+		TrackID:     t.session.TrackID,
+		SessionType: t.session.SessionType,
+		Race:        t.race(),
+		Cars:        []models.TimingEntry{},
+	}
+	tower.Header.Source = t.source
+	if !t.hasSession {
+		tower.TrackID = -1
+	}
+
+	// a flashback rewinds the session time, every car goes back to where it was
+	if p.Header.SessionTime < t.latestTime {
+		for i := range t.histories {
+			t.histories[i].rewind(p.Header.SessionTime)
+		}
+	}
+	t.latestTime = p.Header.SessionTime
+
+	for i, ld := range p.LapData {
+		// 0 = invalid, 1 = inactive
+		if ld.ResultStatus < 2 || ld.CarPosition == 0 {
+			continue
+		}
+		// the distance does not move anymore once the car is out, only the running cars are followed
+		if ld.ResultStatus == 2 {
+			t.histories[i].add(ld.TotalDistance, p.Header.SessionTime)
+		}
+		tower.Cars = append(tower.Cars, models.TimingEntry{
+			Position:      ld.CarPosition,
+			CarIndex:      uint8(i),
+			LapNum:        ld.CurrentLapNum,
+			LapDistance:   ld.LapDistance,
+			TotalDistance: ld.TotalDistance,
+			// 1 = pitting, 2 = in pit area
+			InPit:        ld.PitStatus != 0,
+			LastLapTime:  ld.LastLapTime,
+			BestLapTime:  ld.BestLapTime,
+			ResultStatus: ld.ResultStatus,
+		})
+	}
+	sort.Slice(tower.Cars, func(i, j int) bool {
+		return tower.Cars[i].Position < tower.Cars[j].Position
+	})
+	if len(tower.Cars) == 0 {
+		return tower
+	}
+
+	if tower.Race {
+		t.raceGaps(tower, p.Header.SessionTime)
+	} else {
+		bestLapGaps(tower)
+	}
+	return tower
+}
+
+// raceGaps times every car against the moment the leader passed the same point of the track.
+// Lapped cars are timed against the leader a lap earlier, the number of laps is given by LapsDown.
+func (t *Tower) raceGaps(tower *models.TimingTower, sessionTime float32) {
+	leader := tower.Cars[0]
+	length := float32(t.session.TrackLength)
+
+	for i := range tower.Cars {
+		car := &tower.Cars[i]
+		if i == 0 {
+			continue
+		}
+		if length > 0 {
+			car.LapsDown = int((leader.TotalDistance - car.TotalDistance) / length)
+			car.IntervalLapsDown = int((tower.Cars[i-1].TotalDistance - car.TotalDistance) / length)
+		}
+
+		// a car out of the race is not timed anymore
+		if car.ResultStatus > 3 {
+			continue
+		}
+		// the gap is unknown until the car reaches the first point the leader was recorded at, ie: on the grid
+		if passed, ok := t.histories[leader.CarIndex].at(car.TotalDistance); ok {
+			car.GapToLeader = sessionTime - passed
+		}
+		// a car that finished its race is timed on the line
+		if car.ResultStatus == 3 {
+			car.GapToLeader = t.finishGap(leader, *car)
+		}
+		if ahead := tower.Cars[i-1]; ahead.ResultStatus <= 3 {
+			car.Interval = car.GapToLeader - ahead.GapToLeader
+		}
+	}
+}
+
+// finishGap returns the time between the leader and a car when they crossed the line for the last time
+func (t *Tower) finishGap(leader, car models.TimingEntry) float32 {
+	h := &t.histories[car.CarIndex]
+	if len(h.times) == 0 {
+		return 0
+	}
+	finish := h.times[len(h.times)-1]
+	passed, ok := t.histories[leader.CarIndex].at(h.distances[len(h.distances)-1])
+	if !ok {
+		return 0
+	}
+	return finish - passed
+}
+
+// bestLapGaps compares the best lap of every car with the one of the leader and of the car ahead
+func bestLapGaps(tower *models.TimingTower) {
+	leader := tower.Cars[0]
+	for i := range tower.Cars {
+		car := &tower.Cars[i]
+		if i == 0 || car.BestLapTime <= 0 || leader.BestLapTime <= 0 {
+			continue
+		}
+		car.GapToLeader = car.BestLapTime - leader.BestLapTime
+		if ahead := tower.Cars[i-1]; ahead.BestLapTime > 0 {
+			car.Interval = car.BestLapTime - ahead.BestLapTime
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package timing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/api"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+// MaxTowers is the number of sessions whose tower is kept in memory
+const MaxTowers = 50
+
+// Towers keeps the latest timing tower of the recent sessions, and publishes them live to its subscribers.
+type Towers struct {
+	mu          sync.Mutex
+	towers      map[string]*models.TimingTower // Towers by session UID
+	subscribers map[chan *models.TimingTower]string
+}
+
+// NewTowers creates an empty set of towers
+func NewTowers() *Towers {
+	return &Towers{
+		towers:      map[string]*models.TimingTower{},
+		subscribers: map[chan *models.TimingTower]string{},
+	}
+}
+
+// Publish replaces the tower of a session and sends it to the subscribers of the session.
+// A subscriber too slow to keep up only gets the latest tower.
+func (t *Towers) Publish(tower *models.TimingTower) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	uid := tower.Header.SessionUID
+	t.towers[uid] = tower
+	if len(t.towers) > MaxTowers {
+		oldest := ""
+		for id, tower := range t.towers {
+			if oldest == "" || tower.Timestamp.Before(t.towers[oldest].Timestamp) {
+				oldest = id
+			}
+		}
+		delete(t.towers, oldest)
+	}
+
+	for ch, session := range t.subscribers {
+		if session != "" && session != uid {
+			continue
+		}
+		select {
+		case ch <- tower:
+		default:
+			// drop the tower the subscriber did not read yet
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- tower
+		}
+	}
+}
+
+// Get returns the tower of a session
+func (t *Towers) Get(sessionUID string) (*models.TimingTower, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tower, ok := t.towers[sessionUID]
+	return tower, ok
+}
+
+// List returns the towers of every session, the latest first
+func (t *Towers) List() []*models.TimingTower {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	towers := make([]*models.TimingTower, 0, len(t.towers))
+	for _, tower := range t.towers {
+		towers = append(towers, tower)
+	}
+	sort.Slice(towers, func(i, j int) bool {
+		return towers[i].Timestamp.After(towers[j].Timestamp)
+	})
+	return towers
+}
+
+// Subscribe returns a channel receiving the towers of a session as they are published,
+// an empty session UID subscribes to every session.
+func (t *Towers) Subscribe(sessionUID string) chan *models.TimingTower {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	ch := make(chan *models.TimingTower, 1)
+	t.subscribers[ch] = sessionUID
+	return ch
+}
+
+// Unsubscribe stops sending towers to a channel returned by Subscribe
+func (t *Towers) Unsubscribe(ch chan *models.TimingTower) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.subscribers, ch)
+}
+
+// ServeHTTP returns the tower of the session given by the session query parameter,
+// or the towers of every session without it.
+func (t *Towers) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		api.Error(w, http.StatusMethodNotAllowed, "method %s not allowed", req.Method)
+		return
+	}
+
+	uid := req.URL.Query().Get("session")
+	if uid == "" {
+		api.JSON(w, http.StatusOK, t.List())
+		return
+	}
+	tower, ok := t.Get(uid)
+	if !ok {
+		api.Error(w, http.StatusNotFound, "no timing tower for session %s", uid)
+		return
+	}
+	api.JSON(w, http.StatusOK, tower)
+}
+
+// StreamHandler streams the towers of the session given by the session query parameter as server-sent events,
+// or the towers of every session without it.
+func StreamHandler(towers *Towers) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			api.Error(w, http.StatusMethodNotAllowed, "method %s not allowed", req.Method)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			api.Error(w, http.StatusInternalServerError, "streaming is not supported")
+			return
+		}
+
+		ch := towers.Subscribe(req.URL.Query().Get("session"))
+		defer towers.Unsubscribe(ch)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		for {
+			select {
+			case <-req.Context().Done():
+				return
+			case tower := <-ch:
+				data, err := json.Marshal(tower)
+				if err != nil {
+					continue
+				}
+				if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", DocumentType, data); err != nil {
+					return
+				}
+				flusher.Flush()
+			}
+		}
+	})
+}
@@ -0,0 +1,210 @@
+package trace
+
+import (
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+const (
+	// startTolerance and endTolerance are the distances in metres from the start line
+	// a lap has to be recorded from and up to, to be complete
+	startTolerance = 50
+	endTolerance   = 100
+)
+
+// car holds the lap being recorded for a car
+type car struct {
+	lapData f1packet.LapData // Latest lap data received
+	hasLap  bool
+
+	motion    f1packet.CarMotionData // Latest motion received
+	hasMotion bool
+
+	status f1packet.CarStatusData // Latest status received
+
+	lap *Lap // Lap in progress, nil until lap data is received
+}
+
+// Recorder samples the laps of every car and hands the completed laps over to its consumers.
+type Recorder struct {
+	consumers []Consumer
+
+	session    f1packet.PacketSessionData
+	hasSession bool
+
+	cars [22]car
+
+	// Extra player car only data
+	wheelSlip  [4]float32
+	wheelSpeed [4]float32
+	hasWheels  bool
+}
+
+// Analyze ...
+func (r *Recorder) Analyze(packet interface{}) []models.F1Data {
+	switch p := packet.(type) {
+	case *f1packet.PacketSessionData:
+		r.session = *p
+		r.hasSession = true
+
+	case *f1packet.PacketMotionData:
+		for i := range p.CarMotionData {
+			r.cars[i].motion = p.CarMotionData[i]
+			r.cars[i].hasMotion = true
+		}
+		r.wheelSlip = p.WheelSlip
+		r.wheelSpeed = p.WheelSpeed
+		r.hasWheels = true
+
+	case *f1packet.PacketCarStatusData:
+		for i := range p.CarStatusData {
+			r.cars[i].status = p.CarStatusData[i]
+		}
+
+	case *f1packet.PacketCarTelemetryData:
+		for i := range p.CarTelemetryData {
+			r.sample(p.Header, i, p.CarTelemetryData[i])
+		}
+
+	case *f1packet.PacketLapData:
+		docs := []models.F1Data{}
+		for i := range p.LapData {
+			if lap := r.lapData(p.Header, i, p.LapData[i]); lap != nil {
+				for _, c := range r.consumers {
+					docs = append(docs, c.Lap(lap)...)
+				}
+			}
+		}
+		return docs
+	}
+	return nil
+}
+
+// Flush ...
+func (r *Recorder) Flush() []models.F1Data {
+	docs := []models.F1Data{}
+	for _, c := range r.consumers {
+		docs = append(docs, c.Flush()...)
+	}
+	return docs
+}
+
+// lapData follows the lap of a car, it returns the lap the car just completed if any
+func (r *Recorder) lapData(header f1packet.PacketHeader, i int, ld f1packet.LapData) *Lap {
+	c := &r.cars[i]
+	previous := c.lapData
+	c.lapData = ld
+	c.hasLap = true
+
+	// 0 = invalid, 1 = inactive
+	if ld.ResultStatus < 2 || ld.CurrentLapNum == 0 {
+		c.lap = nil
+		return nil
+	}
+
+	if c.lap == nil {
+		c.lap = r.newLap(i, ld)
+		return nil
+	}
+
+	var completed *Lap
+	switch {
+	case ld.CurrentLapNum > c.lap.LapNum:
+		completed = r.complete(header, c.lap, previous, ld)
+		c.lap = r.newLap(i, ld)
+
+	// 3 = finished, the lap number does not change when crossing the line for the last time
+	case ld.ResultStatus == 3 && previous.ResultStatus != 3:
+		completed = r.complete(header, c.lap, previous, ld)
+		c.lap = nil
+		return completed
+	}
+
+	// 1 = invalid
+	if ld.CurrentLapInvalid == 1 {
+		c.lap.Valid = false
+	}
+	if ld.PitStatus != 0 {
+		c.lap.PitLap = true
+	}
+	return completed
+}
+
+func (r *Recorder) newLap(i int, ld f1packet.LapData) *Lap {
+	return &Lap{
+		TrackID:     r.session.TrackID,
+		TrackLength: r.session.TrackLength,
+		SessionType: r.session.SessionType,
+		Formula:     r.session.Formula,
+		CarIndex:    uint8(i),
+		LapNum:      ld.CurrentLapNum,
+		Valid:       true,
+		Samples:     []Sample{},
+	}
+}
+
+// complete closes a lap, previous is the last lap data received during the lap and ld the first one after it
+func (r *Recorder) complete(header f1packet.PacketHeader, lap *Lap, previous, ld f1packet.LapData) *Lap {
+	lap.Header = header
+	lap.LapTime = ld.LastLapTime
+	lap.Sector1Time = float32(previous.Sector1TimeInMS) / 1000
+	lap.Sector2Time = float32(previous.Sector2TimeInMS) / 1000
+	if !r.hasSession {
+		return lap
+	}
+	lap.TrackID = r.session.TrackID
+	lap.TrackLength = r.session.TrackLength
+	lap.SessionType = r.session.SessionType
+	lap.Formula = r.session.Formula
+
+	if n := len(lap.Samples); n > 0 {
+		lap.Complete = lap.Samples[0].Distance <= startTolerance &&
+			lap.Samples[n-1].Distance >= float32(lap.TrackLength)-endTolerance
+	}
+	return lap
+}
+
+// sample records the state of a car at the distance it is around the lap
+func (r *Recorder) sample(header f1packet.PacketHeader, i int, t f1packet.CarTelemetryData) {
+	c := &r.cars[i]
+	if c.lap == nil || !c.hasLap {
+		return
+	}
+
+	distance := c.lapData.LapDistance
+	// the line has not been crossed yet, or the car went backward
+	if distance < 0 {
+		return
+	}
+	if n := len(c.lap.Samples); n > 0 && distance < c.lap.Samples[n-1].Distance {
+		return
+	}
+
+	s := Sample{
+		Distance:          distance,
+		SessionTime:       header.SessionTime,
+		Speed:             float32(t.Speed),
+		Throttle:          t.Throttle,
+		Brake:             t.Brake,
+		Steer:             t.Steer,
+		Gear:              t.Gear,
+		EngineRPM:         t.EngineRPM,
+		Drs:               t.Drs,
+		BrakesTemperature: t.BrakesTemperature,
+		SurfaceType:       t.SurfaceType,
+		TractionControl:   c.status.TractionControl,
+	}
+	if c.hasMotion {
+		s.WorldPositionX = c.motion.WorldPositionX
+		s.WorldPositionY = c.motion.WorldPositionY
+		s.WorldPositionZ = c.motion.WorldPositionZ
+		s.GForceLateral = c.motion.GForceLateral
+		s.GForceLongitudinal = c.motion.GForceLongitudinal
+	}
+	if r.hasWheels && uint8(i) == header.PlayerCarIndex {
+		s.HasWheels = true
+		s.WheelSlip = r.wheelSlip
+		s.WheelSpeed = r.wheelSpeed
+	}
+	c.lap.Samples = append(c.lap.Samples, s)
+}
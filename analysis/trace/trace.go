@@ -0,0 +1,186 @@
+package trace
+
+import (
+	"math"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+// Sample is the state of a car at a point of the lap, recorded every time car telemetry is received.
+// Wheel arrays have the following order: RL, RR, FL, FR
+type Sample struct {
+	Distance    float32 // Distance around the lap in metres
+	SessionTime float32 // Session timestamp
+
+	Speed     float32 // Speed of car in kilometres per hour
+	Throttle  float32 // Amount of throttle applied (0.0 to 1.0)
+	Brake     float32 // Amount of brake applied (0.0 to 1.0)
+	Steer     float32 // Steering (-1.0 (full lock left) to 1.0 (full lock right))
+	Gear      int8    // Gear selected (1-8, N=0, R=-1)
+	EngineRPM uint16  // Engine RPM
+	Drs       uint8   // 0 = off, 1 = on
+
+	BrakesTemperature [4]uint16 // Brakes temperature (celsius)
+	SurfaceType       [4]uint8  // Driving surface, see appendices
+
+	TractionControl uint8 // 0 (off) - 2 (high)
+
+	WorldPositionX     float32 // World space X position
+	WorldPositionY     float32 // World space Y position
+	WorldPositionZ     float32 // World space Z position
+	GForceLateral      float32 // Lateral G-Force component
+	GForceLongitudinal float32 // Longitudinal G-Force component
+
+	// Only available for the player cars, see HasWheels
+	HasWheels  bool
+	WheelSlip  [4]float32 // Slip ratio for each wheel
+	WheelSpeed [4]float32 // Speed of each wheel
+}
+
+// Lap is a lap completed by a car, sampled by distance
+type Lap struct {
+	Header f1packet.PacketHeader // Header of the packet the lap was completed on
+
+	// Session the lap was driven in
+	TrackID     int8
+	TrackLength uint16
+	SessionType uint8
+	Formula     uint8
+
+	CarIndex uint8
+	LapNum   uint8
+	LapTime  float32 // Lap time in seconds
+
+	// Sector times in seconds, 0 when not known
+	Sector1Time float32
+	Sector2Time float32
+
+	Complete bool // Whether the lap was recorded from the start line
+	Valid    bool // Whether the lap was not invalidated
+	PitLap   bool // Whether the car went through the pit lane during the lap
+
+	Samples []Sample // Samples ordered by distance
+}
+
+// Usable returns whether the lap can be compared to other laps: complete, valid and without pit stop
+func (l *Lap) Usable() bool {
+	return l.Complete && l.Valid && !l.PitLap && len(l.Samples) > 1
+}
+
+// Player returns whether the lap was driven by a player of the rig
+func (l *Lap) Player() bool {
+	for _, i := range analysis.PlayerCars(l.Header) {
+		if i == l.CarIndex {
+			return true
+		}
+	}
+	return false
+}
+
+// Sector3Time returns the time of the third sector in seconds, 0 when a sector time is not known
+func (l *Lap) Sector3Time() float32 {
+	if l.Sector1Time <= 0 || l.Sector2Time <= 0 {
+		return 0
+	}
+	s3 := l.LapTime - l.Sector1Time - l.Sector2Time
+	if s3 <= 0 {
+		return 0
+	}
+	return s3
+}
+
+// At returns the sample interpolated at a distance of the lap
+func (l *Lap) At(distance float32) Sample {
+	samples := l.Samples
+	if len(samples) == 0 {
+		return Sample{Distance: distance}
+	}
+	if distance <= samples[0].Distance {
+		return samples[0]
+	}
+	if distance >= samples[len(samples)-1].Distance {
+		return samples[len(samples)-1]
+	}
+
+	// samples are ordered by distance
+	lo, hi := 0, len(samples)-1
+	for hi-lo > 1 {
+		mid := (lo + hi) / 2
+		if samples[mid].Distance <= distance {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+
+	a, b := samples[lo], samples[hi]
+	t := float32(0)
+	if b.Distance != a.Distance {
+		t = (distance - a.Distance) / (b.Distance - a.Distance)
+	}
+	s := a
+	if t >= 0.5 {
+		s = b
+	}
+	s.Distance = distance
+	s.SessionTime = a.SessionTime + (b.SessionTime-a.SessionTime)*t
+	s.Speed = a.Speed + (b.Speed-a.Speed)*t
+	s.Throttle = a.Throttle + (b.Throttle-a.Throttle)*t
+	s.Brake = a.Brake + (b.Brake-a.Brake)*t
+	s.Steer = a.Steer + (b.Steer-a.Steer)*t
+	s.WorldPositionX = a.WorldPositionX + (b.WorldPositionX-a.WorldPositionX)*t
+	s.WorldPositionY = a.WorldPositionY + (b.WorldPositionY-a.WorldPositionY)*t
+	s.WorldPositionZ = a.WorldPositionZ + (b.WorldPositionZ-a.WorldPositionZ)*t
+	s.GForceLateral = a.GForceLateral + (b.GForceLateral-a.GForceLateral)*t
+	s.GForceLongitudinal = a.GForceLongitudinal + (b.GForceLongitudinal-a.GForceLongitudinal)*t
+	return s
+}
+
+// Resample returns a copy of the lap sampled every step metres from the start line
+func (l *Lap) Resample(step float32) *Lap {
+	resampled := *l
+	resampled.Samples = []Sample{}
+	if len(l.Samples) == 0 || step <= 0 {
+		return &resampled
+	}
+	first, last := l.Samples[0].Distance, l.Samples[len(l.Samples)-1].Distance
+	for i := math.Ceil(float64(first / step)); float32(i)*step <= last; i++ {
+		resampled.Samples = append(resampled.Samples, l.At(float32(i)*step))
+	}
+	return &resampled
+}
+
+// Elapsed returns the time in seconds since the start of the lap at a distance
+func (l *Lap) Elapsed(distance float32) float32 {
+	if len(l.Samples) == 0 {
+		return 0
+	}
+	return l.At(distance).SessionTime - l.Samples[0].SessionTime
+}
+
+// Consumer derives documents from the laps completed by the cars of a session
+type Consumer interface {
+	// Lap returns the documents derived from a completed lap, if any
+	Lap(lap *Lap) []models.F1Data
+	// Flush is called once the session is over and returns the documents still pending
+	Flush() []models.F1Data
+}
+
+// ConsumerFactory creates a consumer for a session received from a source
+type ConsumerFactory func(source models.Source) Consumer
+
+// NewFactory returns an analysis.Factory recording the laps of every car once
+// and handing them over to consumers.
+func NewFactory(factories ...ConsumerFactory) analysis.Factory {
+	return func(source models.Source) analysis.Analyzer {
+		r := &Recorder{
+			consumers: make([]Consumer, 0, len(factories)),
+		}
+		for _, factory := range factories {
+			r.consumers = append(r.consumers, factory(source))
+		}
+		return r
+	}
+}
@@ -0,0 +1,182 @@
+package trackmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+// DefaultStep is the distance in metres between two points of a map
+const DefaultStep = 5
+
+// Map is the centreline of a track, indexed by the distance from the start line
+type Map struct {
+	TrackID     int8
+	TrackLength uint16
+	Step        float32
+	Points      []models.TrackMapPoint
+}
+
+// Build resamples the positions recorded during one lap every step metres.
+// The samples have to cover the whole lap.
+func Build(trackID int8, trackLength uint16, step float32, samples []models.TrackMapPoint) (*Map, error) {
+	if trackLength == 0 || step <= 0 {
+		return nil, errors.New("track length and step must be positive")
+	}
+
+	sorted := make([]models.TrackMapPoint, 0, len(samples))
+	for _, s := range samples {
+		if s.Distance >= 0 && s.Distance <= float32(trackLength) {
+			sorted = append(sorted, s)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Distance < sorted[j].Distance })
+
+	if len(sorted) < 2 {
+		return nil, errors.New("not enough samples")
+	}
+	if first, last := sorted[0].Distance, sorted[len(sorted)-1].Distance; first > 4*step || last < float32(trackLength)-4*step {
+		return nil, errors.Errorf("samples cover %.0fm to %.0fm of a %dm lap", first, last, trackLength)
+	}
+
+	m := &Map{
+		TrackID:     trackID,
+		TrackLength: trackLength,
+		Step:        step,
+	}
+	j := 0
+	for d := float32(0); d < float32(trackLength); d += step {
+		for j < len(sorted)-2 && sorted[j+1].Distance < d {
+			j++
+		}
+		m.Points = append(m.Points, interpolate(sorted[j], sorted[j+1], d))
+	}
+	return m, nil
+}
+
+// At returns the point of the centreline at a lap distance, the distance wraps around the lap
+func (m *Map) At(distance float32) models.TrackMapPoint {
+	length := float32(len(m.Points)) * m.Step
+	distance = float32(math.Mod(float64(distance), float64(length)))
+	if distance < 0 {
+		distance += length
+	}
+
+	i := int(distance / m.Step)
+	if i >= len(m.Points) {
+		i = len(m.Points) - 1
+	}
+	next := m.Points[(i+1)%len(m.Points)]
+	if i+1 == len(m.Points) {
+		next.Distance = length
+	}
+	return interpolate(m.Points[i], next, distance)
+}
+
+// Bounds returns the smallest and largest X and Z of the centreline, the track is drawn on the X/Z plane
+func (m *Map) Bounds() (minX, minZ, maxX, maxZ float32) {
+	minX, minZ = float32(math.Inf(1)), float32(math.Inf(1))
+	maxX, maxZ = float32(math.Inf(-1)), float32(math.Inf(-1))
+	for _, p := range m.Points {
+		minX, maxX = float32(math.Min(float64(minX), float64(p.X))), float32(math.Max(float64(maxX), float64(p.X)))
+		minZ, maxZ = float32(math.Min(float64(minZ), float64(p.Z))), float32(math.Max(float64(maxZ), float64(p.Z)))
+	}
+	return minX, minZ, maxX, maxZ
+}
+
+// Normalize returns the position of a world point as a fraction (0..1) of the width and height of the map.
+// It matches the coordinates of the SVG export.
+func (m *Map) Normalize(x, z float32) (float32, float32) {
+	minX, minZ, maxX, maxZ := m.Bounds()
+	size := float32(math.Max(float64(maxX-minX), float64(maxZ-minZ)))
+	if size == 0 {
+		return 0, 0
+	}
+	return (x - minX) / size, (z - minZ) / size
+}
+
+// GeoJSON returns the centreline as a GeoJSON LineString feature.
+// Coordinates are the world X and Z positions in metres, not longitudes and latitudes.
+func (m *Map) GeoJSON() ([]byte, error) {
+	coordinates := make([][3]float32, 0, len(m.Points)+1)
+	for _, p := range m.Points {
+		coordinates = append(coordinates, [3]float32{p.X, p.Z, p.Y})
+	}
+	if len(m.Points) > 0 {
+		// close the loop
+		coordinates = append(coordinates, coordinates[0])
+	}
+
+	feature := map[string]interface{}{
+		"type": "Feature",
+		"geometry": map[string]interface{}{
+			"type":        "LineString",
+			"coordinates": coordinates,
+		},
+		"properties": map[string]interface{}{
+			"trackId":     m.TrackID,
+			"trackLength": m.TrackLength,
+			"step":        m.Step,
+		},
+	}
+	return json.Marshal(feature)
+}
+
+// SVG writes the centreline as a SVG path, the start line is marked with a circle.
+// The drawing fits a size x size square.
+func (m *Map) SVG(w io.Writer, size int) error {
+	if len(m.Points) == 0 {
+		return errors.New("empty track map")
+	}
+
+	margin := float32(size) / 20
+	scale := float32(size) - 2*margin
+	point := func(p models.TrackMapPoint) (float32, float32) {
+		x, y := m.Normalize(p.X, p.Z)
+		return margin + x*scale, margin + y*scale
+	}
+
+	_, err := fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n", size, size, size, size)
+	if err != nil {
+		return errors.Wrap(err, "could not write svg")
+	}
+
+	path := ""
+	for i, p := range m.Points {
+		x, y := point(p)
+		cmd := "L"
+		if i == 0 {
+			cmd = "M"
+		}
+		path += fmt.Sprintf("%s%.1f %.1f ", cmd, x, y)
+	}
+	path += "Z"
+
+	startX, startY := point(m.Points[0])
+	_, err = fmt.Fprintf(w, `<path d="%s" fill="none" stroke="black" stroke-width="%.1f"/>`+"\n"+
+		`<circle cx="%.1f" cy="%.1f" r="%.1f" fill="red"/>`+"\n</svg>\n",
+		path, float32(size)/200, startX, startY, float32(size)/100)
+	if err != nil {
+		return errors.Wrap(err, "could not write svg")
+	}
+	return nil
+}
+
+func interpolate(a, b models.TrackMapPoint, distance float32) models.TrackMapPoint {
+	t := float32(0)
+	if b.Distance != a.Distance {
+		t = (distance - a.Distance) / (b.Distance - a.Distance)
+	}
+	return models.TrackMapPoint{
+		Distance: distance,
+		X:        a.X + (b.X-a.X)*t,
+		Y:        a.Y + (b.Y-a.Y)*t,
+		Z:        a.Z + (b.Z-a.Z)*t,
+	}
+}
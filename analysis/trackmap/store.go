@@ -0,0 +1,95 @@
+package trackmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Store keeps a map per track.
+// Maps are saved as json files in a directory when one is given, and kept in memory otherwise.
+type Store struct {
+	mu   sync.Mutex
+	dir  string
+	maps map[int8]*Map
+}
+
+// NewStore creates a store saving the maps to dir, an empty dir keeps the maps in memory only
+func NewStore(dir string) (*Store, error) {
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, errors.Wrapf(err, "could not create track map directory %s", dir)
+		}
+	}
+	return &Store{
+		dir:  dir,
+		maps: map[int8]*Map{},
+	}, nil
+}
+
+// Path returns the file a map is saved to
+func Path(dir string, trackID int8) string {
+	return filepath.Join(dir, fmt.Sprintf("track-%d.json", trackID))
+}
+
+// Load reads a map saved to a file
+func Load(path string) (*Map, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	m := &Map{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, errors.Wrapf(err, "could not decode track map %s", path)
+	}
+	return m, nil
+}
+
+// Get returns the map of a track, nil if none was built yet
+func (s *Store) Get(trackID int8) (*Map, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if m, ok := s.maps[trackID]; ok {
+		return m, nil
+	}
+	if s.dir == "" {
+		return nil, nil
+	}
+
+	m, err := Load(Path(s.dir, trackID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	s.maps[trackID] = m
+	return m, nil
+}
+
+// Save keeps the map of a track, replacing the previous one
+func (s *Store) Save(m *Map) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.maps[m.TrackID] = m
+	if s.dir == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		return errors.Wrap(err, "could not encode track map")
+	}
+	path := Path(s.dir, m.TrackID)
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		return errors.Wrapf(err, "could not write track map %s", path)
+	}
+	return nil
+}
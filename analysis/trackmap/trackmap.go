@@ -0,0 +1,198 @@
+package trackmap
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+const (
+	MapDocumentType       = "track_map"
+	PositionsDocumentType = "car_positions"
+
+	// PositionsInterval is the session time in seconds between two CarPositions documents
+	PositionsInterval = 1
+)
+
+// Builder records the positions of the player car during a clean lap to build the map of a track
+// when none is stored yet, and places every car on the map.
+type Builder struct {
+	source models.Source
+	store  *Store
+
+	session    f1packet.PacketSessionData
+	hasSession bool
+	trackMap   *Map // Map of the track, nil until built or loaded
+
+	lapData   f1packet.PacketLapData // Latest lap data of every car
+	hasLap    bool
+	motion    f1packet.PacketMotionData // Latest motion of every car
+	hasMotion bool
+
+	// Lap of the player being recorded
+	lapNum  uint8
+	clean   bool
+	samples []models.TrackMapPoint
+
+	positionsTime float32 // Session time the latest CarPositions document was emitted at
+}
+
+// NewFactory returns an analysis.Factory creating track map builders sharing the same store
+func NewFactory(store *Store) analysis.Factory {
+	return func(source models.Source) analysis.Analyzer {
+		return &Builder{
+			source: source,
+			store:  store,
+		}
+	}
+}
+
+// Analyze ...
+func (b *Builder) Analyze(packet interface{}) []models.F1Data {
+	switch p := packet.(type) {
+	case *f1packet.PacketSessionData:
+		if b.hasSession && b.session.TrackID == p.TrackID {
+			return nil
+		}
+		b.session = *p
+		b.hasSession = true
+		b.trackMap = nil
+		if p.TrackID < 0 {
+			return nil
+		}
+		m, err := b.store.Get(p.TrackID)
+		if err != nil {
+			logrus.WithError(err).Errorf("could not load track map of track %d", p.TrackID)
+		}
+		b.trackMap = m
+
+	case *f1packet.PacketLapData:
+		b.lapData = *p
+		b.hasLap = true
+		if p.Header.PlayerCarIndex < 22 {
+			if doc := b.record(p.Header, p.LapData[p.Header.PlayerCarIndex]); doc != nil {
+				return []models.F1Data{doc}
+			}
+		}
+
+	case *f1packet.PacketMotionData:
+		b.motion = *p
+		b.hasMotion = true
+		if b.trackMap == nil && b.clean && b.hasLap && p.Header.PlayerCarIndex < 22 {
+			position := p.CarMotionData[p.Header.PlayerCarIndex]
+			b.samples = append(b.samples, models.TrackMapPoint{
+				Distance: b.lapData.LapData[p.Header.PlayerCarIndex].LapDistance,
+				X:        position.WorldPositionX,
+				Y:        position.WorldPositionY,
+				Z:        position.WorldPositionZ,
+			})
+		}
+		if p.Header.SessionTime-b.positionsTime >= PositionsInterval || p.Header.SessionTime < b.positionsTime {
+			b.positionsTime = p.Header.SessionTime
+			if doc := b.positions(p.Header); doc != nil {
+				return []models.F1Data{doc}
+			}
+		}
+	}
+	return nil
+}
+
+// Flush ...
+func (b *Builder) Flush() []models.F1Data {
+	return nil
+}
+
+// record follows the lap of the player, it returns the map of the track once a clean lap was recorded
+func (b *Builder) record(header f1packet.PacketHeader, ld f1packet.LapData) models.F1Data {
+	if ld.CurrentLapNum != b.lapNum {
+		var doc models.F1Data
+		if b.trackMap == nil && b.clean && b.lapNum != 0 && b.hasSession && b.session.TrackID >= 0 {
+			doc = b.build(header)
+		}
+
+		// a lap is only recorded from its start
+		b.clean = b.lapNum != 0
+		b.lapNum = ld.CurrentLapNum
+		b.samples = nil
+		return doc
+	}
+
+	// 1 = invalid
+	if ld.CurrentLapInvalid == 1 || ld.PitStatus != 0 {
+		b.clean = false
+	}
+	return nil
+}
+
+func (b *Builder) build(header f1packet.PacketHeader) models.F1Data {
+	m, err := Build(b.session.TrackID, b.session.TrackLength, DefaultStep, b.samples)
+	if err != nil {
+		logrus.WithError(err).Debugf("could not build track map of track %d", b.session.TrackID)
+		return nil
+	}
+	if err := b.store.Save(m); err != nil {
+		logrus.WithError(err).Errorf("could not save track map of track %d", b.session.TrackID)
+	}
+	b.trackMap = m
+	logrus.Infof("built track map of track %d", m.TrackID)
+
+	doc := &models.TrackMap{
+		Header:      models.NewHeader(header),
+		Timestamp:   time.Now().UTC(),
+		Type:        MapDocumentType,
+		TrackID:     m.TrackID,
+		TrackLength: m.TrackLength,
+		Step:        m.Step,
+		Points:      m.Points,
+	}
+	doc.Header.Source = b.source
+	return doc
+}
+
+// positions places every active car on the map of the track
+func (b *Builder) positions(header f1packet.PacketHeader) models.F1Data {
+	if !b.hasSession || !b.hasLap || !b.hasMotion {
+		return nil
+	}
+
+	doc := &models.CarPositions{
+		Header:    models.NewHeader(header),
+		Timestamp: time.Now().UTC(),
+		Type:      PositionsDocumentType,
+		TrackID:   b.session.TrackID,
+		Cars:      []models.CarOnTrack{},
+	}
+	doc.Header.Source = b.source
+
+	for i, ld := range b.lapData.LapData {
+		// 0 = invalid, 1 = inactive
+		if ld.ResultStatus < 2 {
+			continue
+		}
+		motion := b.motion.CarMotionData[i]
+		car := models.CarOnTrack{
+			CarIndex:       uint8(i),
+			RacePosition:   ld.CarPosition,
+			LapNum:         ld.CurrentLapNum,
+			LapDistance:    ld.LapDistance,
+			PitStatus:      ld.PitStatus,
+			WorldPositionX: motion.WorldPositionX,
+			WorldPositionY: motion.WorldPositionY,
+			WorldPositionZ: motion.WorldPositionZ,
+		}
+		if b.session.TrackLength > 0 {
+			car.LapFraction = ld.LapDistance / float32(b.session.TrackLength)
+		}
+		if b.trackMap != nil {
+			point := b.trackMap.At(ld.LapDistance)
+			car.OnMap = true
+			car.MapX, car.MapY = b.trackMap.Normalize(point.X, point.Z)
+		}
+		doc.Cars = append(doc.Cars, car)
+	}
+	return doc
+}
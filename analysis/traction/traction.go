@@ -0,0 +1,238 @@
+package traction
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/corner"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/trace"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+const (
+	EventDocumentType   = "wheelspin_event"
+	LapDocumentType     = "traction_lap"
+	SettingDocumentType = "traction_setting"
+
+	// SpinSlip is the rear wheel slip ratio the wheels are considered spinning from
+	SpinSlip = 0.1
+	// ThrottleThreshold is the amount of throttle a wheelspin is looked for from
+	ThrottleThreshold = 0.2
+
+	// minDuration is the duration in seconds of the shortest wheelspin event
+	minDuration = 0.1
+)
+
+// setting holds the laps of a car driven with a traction control setting
+type setting struct {
+	laps      int
+	lapTimes  []float64 // Valid lap times
+	events    int
+	spinTime  float32
+	peakSlips []float64
+}
+
+// Analyzer detects the wheelspins of the player cars on throttle application, links them to the corners
+// of the track, and compares the traction control settings used during the session.
+type Analyzer struct {
+	source  models.Source
+	corners *corner.Store
+
+	trackID int8
+	track   []models.Corner // Corners of the track, nil until detected
+
+	settings map[uint8]map[uint8]*setting // Laps of each car by traction control setting
+	header   models.Header                // Header of the latest lap, used by the settings documents
+}
+
+// NewFactory returns a trace.ConsumerFactory creating traction analyzers reading the corners from store
+func NewFactory(corners *corner.Store) trace.ConsumerFactory {
+	return func(source models.Source) trace.Consumer {
+		return &Analyzer{
+			source:   source,
+			corners:  corners,
+			settings: map[uint8]map[uint8]*setting{},
+		}
+	}
+}
+
+// Lap ...
+func (a *Analyzer) Lap(lap *trace.Lap) []models.F1Data {
+	if !lap.Player() || len(lap.Samples) == 0 {
+		return nil
+	}
+
+	if lap.TrackID >= 0 && (a.track == nil || lap.TrackID != a.trackID) {
+		corners, err := a.corners.Get(lap.TrackID)
+		if err != nil {
+			logrus.WithError(err).Errorf("could not load corners of track %d", lap.TrackID)
+		}
+		a.trackID = lap.TrackID
+		a.track = corners
+	}
+
+	events := Events(lap)
+
+	summary := &models.TractionLap{
+		Header:          models.NewHeader(lap.Header),
+		Timestamp:       time.Now().UTC(),
+		Type:            LapDocumentType,
+		CarIndex:        lap.CarIndex,
+		LapNum:          lap.LapNum,
+		LapTime:         lap.LapTime,
+		Valid:           lap.Valid,
+		TractionControl: tractionControl(lap),
+		Events:          len(events),
+	}
+	summary.Header.Source = a.source
+
+	docs := make([]models.F1Data, 0, len(events)+1)
+	peaks := make([]float64, 0, len(events))
+	for _, e := range events {
+		e.Header = models.NewHeader(lap.Header)
+		e.Header.Source = a.source
+		e.Corner = corner.At(a.track, e.StartDistance)
+		docs = append(docs, e)
+
+		summary.TotalSpinTime += e.Duration
+		peaks = append(peaks, float64(e.PeakSlip))
+	}
+	summary.AveragePeakSlip = float32(analysis.Mean(peaks))
+
+	// only full laps are compared, partial laps would lower the averages
+	if lap.Complete && !lap.PitLap {
+		a.header = summary.Header
+		settings, ok := a.settings[lap.CarIndex]
+		if !ok {
+			settings = map[uint8]*setting{}
+			a.settings[lap.CarIndex] = settings
+		}
+		s, ok := settings[summary.TractionControl]
+		if !ok {
+			s = &setting{}
+			settings[summary.TractionControl] = s
+		}
+		s.laps++
+		if lap.Valid {
+			s.lapTimes = append(s.lapTimes, float64(lap.LapTime))
+		}
+		s.events += len(events)
+		s.spinTime += summary.TotalSpinTime
+		s.peakSlips = append(s.peakSlips, peaks...)
+	}
+
+	return append(docs, summary)
+}
+
+// Flush returns a document per car and traction control setting used during the session
+func (a *Analyzer) Flush() []models.F1Data {
+	docs := []models.F1Data{}
+	for car, settings := range a.settings {
+		for tc, s := range settings {
+			doc := &models.TractionSetting{
+				Header:          a.header,
+				Timestamp:       time.Now().UTC(),
+				Type:            SettingDocumentType,
+				CarIndex:        car,
+				TractionControl: tc,
+				Laps:            s.laps,
+				AverageLapTime:  float32(analysis.Mean(s.lapTimes)),
+				EventsPerLap:    float32(s.events) / float32(s.laps),
+				SpinTimePerLap:  s.spinTime / float32(s.laps),
+				AveragePeakSlip: float32(analysis.Mean(s.peakSlips)),
+			}
+			for _, t := range s.lapTimes {
+				if doc.BestLapTime == 0 || float32(t) < doc.BestLapTime {
+					doc.BestLapTime = float32(t)
+				}
+			}
+			docs = append(docs, doc)
+		}
+	}
+	a.settings = map[uint8]map[uint8]*setting{}
+	return docs
+}
+
+// Events returns the wheelspin events of a lap, the headers and corners are left to be set by the caller.
+// Wheel slip is only available for the player cars, other laps have no event.
+func Events(lap *trace.Lap) []*models.WheelspinEvent {
+	events := []*models.WheelspinEvent{}
+
+	var current *models.WheelspinEvent
+	var slips, throttles []float64
+	end := func(s trace.Sample) {
+		e := current
+		current = nil
+		e.EndDistance = s.Distance
+		e.Duration = s.SessionTime - e.StartSessionTime
+		if e.Duration < minDuration {
+			return
+		}
+		e.AverageSlip = float32(analysis.Mean(slips))
+		e.AverageThrottle = float32(analysis.Mean(throttles))
+		e.EventNumber = len(events) + 1
+		events = append(events, e)
+	}
+
+	for _, s := range lap.Samples {
+		slip := rearSlip(s)
+		spinning := s.HasWheels && s.Throttle >= ThrottleThreshold && slip >= SpinSlip
+		if !spinning {
+			if current != nil {
+				end(s)
+			}
+			continue
+		}
+
+		if current == nil {
+			current = &models.WheelspinEvent{
+				Type:             EventDocumentType,
+				CarIndex:         lap.CarIndex,
+				LapNum:           lap.LapNum,
+				StartDistance:    s.Distance,
+				StartSessionTime: s.SessionTime,
+				Speed:            s.Speed,
+				Gear:             s.Gear,
+				TractionControl:  s.TractionControl,
+			}
+			slips, throttles = slips[:0], throttles[:0]
+		}
+		if slip > current.PeakSlip {
+			current.PeakSlip = slip
+		}
+		slips = append(slips, float64(slip))
+		throttles = append(throttles, float64(s.Throttle))
+	}
+	if current != nil {
+		end(lap.Samples[len(lap.Samples)-1])
+	}
+	return events
+}
+
+// rearSlip returns the highest slip ratio of the rear wheels, positive when they spin faster than the car goes
+func rearSlip(s trace.Sample) float32 {
+	// RL, RR, FL, FR
+	if s.WheelSlip[0] > s.WheelSlip[1] {
+		return s.WheelSlip[0]
+	}
+	return s.WheelSlip[1]
+}
+
+// tractionControl returns the traction control setting used for most of the lap
+func tractionControl(lap *trace.Lap) uint8 {
+	counts := [3]int{}
+	for _, s := range lap.Samples {
+		if s.TractionControl < 3 {
+			counts[s.TractionControl]++
+		}
+	}
+	tc := uint8(0)
+	for i, n := range counts {
+		if n > counts[tc] {
+			tc = uint8(i)
+		}
+	}
+	return tc
+}
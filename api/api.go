@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// shutdownTimeout is the time given to the requests in progress to complete when the server stops
+const shutdownTimeout = 5 * time.Second
+
+// Server serves the analysis over HTTP, the analysis register their handlers with Handle
+type Server struct {
+	mux    *http.ServeMux
+	server *http.Server
+}
+
+// NewServer creates a server listening on address, ie: ":8080"
+func NewServer(address string) *Server {
+	mux := http.NewServeMux()
+	return &Server{
+		mux: mux,
+		server: &http.Server{
+			Addr:    address,
+			Handler: mux,
+		},
+	}
+}
+
+// Handle registers the handler for the given pattern, see http.ServeMux
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
+// Start serves the requests until ctx is done
+func (s *Server) Start(ctx context.Context) error {
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := s.server.Shutdown(shutdown); err != nil {
+			logrus.WithError(err).Error("could not shutdown api server")
+		}
+	}()
+
+	logrus.Infof("starting api server on %s", s.server.Addr)
+	if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return errors.Wrapf(err, "could not serve api on %s", s.server.Addr)
+	}
+	return ctx.Err()
+}
+
+// JSON writes v encoded as json with the given status
+func JSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logrus.WithError(err).Error("could not write api response")
+	}
+}
+
+// Error writes an error message as json with the given status
+func Error(w http.ResponseWriter, status int, format string, args ...interface{}) {
+	JSON(w, status, map[string]string{
+		"error": fmt.Sprintf(format, args...),
+	})
+}
@@ -0,0 +1,372 @@
+// Package rpc serves the documents and the sessions stored over gRPC, see telemetry.proto.
+package rpc
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative telemetry.proto
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/sqlite"
+)
+
+const (
+	// shutdownTimeout is the time given to the requests in progress to complete when the server stops
+	shutdownTimeout = 5 * time.Second
+	// subscriberBuffer is the number of documents buffered for a subscriber before they are dropped
+	subscriberBuffer = 1024
+
+	// DefaultSessions is the number of sessions returned when no limit is given
+	DefaultSessions = sqlite.DefaultSessions
+	// MaxSamples is the maximum number of telemetry samples returned at once, below the default 4MB message size
+	MaxSamples = 50000
+)
+
+// documentField returns the field of Document.data holding the documents of a type, nil if there is none
+func documentField(name string) protoreflect.FieldDescriptor {
+	return (&Document{}).ProtoReflect().Descriptor().Oneofs().ByName("data").Fields().ByName(protoreflect.Name(name))
+}
+
+// subscriber receives the documents matching its request
+type subscriber struct {
+	request *SubscribeRequest
+	types   map[string]bool
+	cars    map[int]bool
+	ch      chan *Document
+	dropped int // Number of documents dropped, guarded by the mu of the server
+}
+
+// match returns whether the subscriber wants a document
+func (s *subscriber) match(doc repository.Document) bool {
+	if len(s.types) > 0 && !s.types[doc.Name] {
+		return false
+	}
+	if len(s.cars) > 0 && doc.CarIndex >= 0 && !s.cars[doc.CarIndex] {
+		return false
+	}
+	if s.request.SessionUid != "" && s.request.SessionUid != doc.SessionUID {
+		return false
+	}
+	return s.request.Rig == "" || s.request.Rig == doc.Rig
+}
+
+// Server is a repository streaming the documents it stores to the subscribers,
+// and queries the sessions stored to the sqlite repository.
+type Server struct {
+	UnimplementedTelemetryServer
+
+	address string
+	history *sqlite.SQLite // nil when the sqlite repository is not used
+	done    chan struct{}  // closed when the server stops
+
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+// NewServer creates a server listening on address, ie: ":9090". history may be nil.
+func NewServer(address string, history *sqlite.SQLite) *Server {
+	return &Server{
+		address:     address,
+		history:     history,
+		done:        make(chan struct{}),
+		subscribers: map[*subscriber]struct{}{},
+	}
+}
+
+// Start serves the requests until ctx is done
+func (s *Server) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return errors.Wrapf(err, "could not listen on %s", s.address)
+	}
+
+	server := grpc.NewServer()
+	RegisterTelemetryServer(server, s)
+
+	go func() {
+		<-ctx.Done()
+		// the streams only end with their subscriber, end them first
+		close(s.done)
+		stopped := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(shutdownTimeout):
+			server.Stop()
+		}
+	}()
+
+	logrus.Infof("starting grpc server on %s", s.address)
+	if err := server.Serve(listener); err != nil {
+		return errors.Wrapf(err, "could not serve grpc on %s", s.address)
+	}
+	return ctx.Err()
+}
+
+// Store sends the document to the subscribers wanting it.
+// A subscriber too slow to keep up misses the documents it has no room for.
+func (s *Server) Store(ctx context.Context, body *bytes.Reader) error {
+	s.mu.Lock()
+	subscribed := len(s.subscribers) > 0
+	s.mu.Unlock()
+	if !subscribed {
+		return nil
+	}
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return errors.Wrap(err, "could not read document")
+	}
+	doc, err := repository.Describe(data)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	subscribers := []*subscriber{}
+	for sub := range s.subscribers {
+		if sub.match(doc) {
+			subscribers = append(subscribers, sub)
+		}
+	}
+	s.mu.Unlock()
+	if len(subscribers) == 0 {
+		return nil
+	}
+
+	msg, err := NewDocument(doc, data)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sub := range subscribers {
+		select {
+		case sub.ch <- msg:
+		default:
+			sub.dropped++
+		}
+	}
+	return nil
+}
+
+// NewDocument converts a json document described by doc to its message
+func NewDocument(doc repository.Document, data []byte) (*Document, error) {
+	field := documentField(doc.Name)
+	if field == nil {
+		return nil, errors.Errorf("unknown document type %s", doc.Name)
+	}
+
+	msg := &Document{
+		Type:       doc.Name,
+		SessionUid: doc.SessionUID,
+		CarIndex:   int32(doc.CarIndex),
+		Rig:        doc.Rig,
+		Driver:     doc.Driver,
+	}
+	value := msg.ProtoReflect().NewField(field)
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, value.Message().Interface()); err != nil {
+		return nil, errors.Wrapf(err, "could not convert %s document", doc.Name)
+	}
+	msg.ProtoReflect().Set(field, value)
+	return msg, nil
+}
+
+// Subscribe streams the documents matching the request until the client or the server stops
+func (s *Server) Subscribe(req *SubscribeRequest, stream Telemetry_SubscribeServer) error {
+	sub := &subscriber{
+		request: req,
+		types:   map[string]bool{},
+		cars:    map[int]bool{},
+		ch:      make(chan *Document, subscriberBuffer),
+	}
+	for _, t := range req.Types {
+		if documentField(t) == nil {
+			return status.Errorf(codes.InvalidArgument, "unknown document type %q", t)
+		}
+		sub.types[t] = true
+	}
+	for _, car := range req.Cars {
+		if car > 21 {
+			return status.Errorf(codes.InvalidArgument, "invalid car %d", car)
+		}
+		sub.cars[int(car)] = true
+	}
+
+	s.mu.Lock()
+	s.subscribers[sub] = struct{}{}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.subscribers, sub)
+		dropped := sub.dropped
+		s.mu.Unlock()
+		if dropped > 0 {
+			logrus.Warnf("grpc subscriber missed %d documents", dropped)
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-s.done:
+			return status.Error(codes.Unavailable, "server is stopping")
+		case doc := <-sub.ch:
+			if err := stream.Send(doc); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ListSessions returns the latest sessions stored
+func (s *Server) ListSessions(ctx context.Context, req *ListSessionsRequest) (*ListSessionsResponse, error) {
+	if s.history == nil {
+		return nil, status.Error(codes.FailedPrecondition, "the sessions are only stored by the sqlite repository")
+	}
+	limit := DefaultSessions
+	if req.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid limit %d", req.Limit)
+	}
+	if req.Limit > 0 {
+		limit = int(req.Limit)
+	}
+
+	sessions, err := s.history.Sessions(ctx, limit)
+	if err != nil {
+		logrus.WithError(err).Error("could not query sessions")
+		return nil, status.Error(codes.Internal, "could not query sessions")
+	}
+	res := &ListSessionsResponse{Sessions: make([]*StoredSession, 0, len(sessions))}
+	for _, session := range sessions {
+		res.Sessions = append(res.Sessions, &StoredSession{
+			SessionUid:      session.SessionUID,
+			Rig:             session.Rig,
+			Driver:          session.Driver,
+			TrackId:         int32(session.TrackID),
+			TrackName:       session.TrackName,
+			Formula:         uint32(session.Formula),
+			SessionType:     uint32(session.SessionType),
+			SessionTypeName: session.SessionTypeName,
+			TotalLaps:       uint32(session.TotalLaps),
+			Weather:         uint32(session.Weather),
+			WeatherName:     session.WeatherName,
+			CreatedAt:       timestamppb.New(session.CreatedAt),
+			UpdatedAt:       timestamppb.New(session.UpdatedAt),
+			Laps:            int32(session.Laps),
+			BestLapTime:     session.BestLapTime,
+		})
+	}
+	return res, nil
+}
+
+// ListLaps returns the laps stored of a session
+func (s *Server) ListLaps(ctx context.Context, req *ListLapsRequest) (*ListLapsResponse, error) {
+	if s.history == nil {
+		return nil, status.Error(codes.FailedPrecondition, "the laps are only stored by the sqlite repository")
+	}
+	if req.SessionUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "a session is required")
+	}
+	car := -1
+	if req.Car != nil {
+		if *req.Car > 21 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid car %d", *req.Car)
+		}
+		car = int(*req.Car)
+	}
+
+	laps, err := s.history.Laps(ctx, req.SessionUid, car)
+	if err != nil {
+		logrus.WithError(err).Error("could not query laps")
+		return nil, status.Error(codes.Internal, "could not query laps")
+	}
+	res := &ListLapsResponse{Laps: make([]*StoredLap, 0, len(laps))}
+	for _, lap := range laps {
+		res.Laps = append(res.Laps, &StoredLap{
+			CarIndex:           uint32(lap.CarIndex),
+			Name:               lap.Name,
+			LapNum:             uint32(lap.LapNum),
+			LapTime:            lap.LapTime,
+			Sector1Time:        lap.Sector1Time,
+			Sector2Time:        lap.Sector2Time,
+			Sector3Time:        lap.Sector3Time,
+			Valid:              lap.Valid,
+			PitLap:             lap.PitLap,
+			Position:           uint32(lap.Position),
+			ActualTyreCompound: uint32(lap.ActualTyreCompound),
+			VisualTyreCompound: uint32(lap.VisualTyreCompound),
+			TyresAgeLaps:       uint32(lap.TyresAgeLaps),
+			CompletedAt:        timestamppb.New(lap.CompletedAt),
+		})
+	}
+	return res, nil
+}
+
+// GetTelemetry returns the telemetry stored of a car between two session times
+func (s *Server) GetTelemetry(ctx context.Context, req *GetTelemetryRequest) (*GetTelemetryResponse, error) {
+	if s.history == nil {
+		return nil, status.Error(codes.FailedPrecondition, "the telemetry is only stored by the sqlite repository")
+	}
+	if req.SessionUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "a session is required")
+	}
+	if req.Car > 21 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid car %d", req.Car)
+	}
+	from, to := 0.0, float64(math.MaxFloat32)
+	if req.From != nil {
+		from = float64(*req.From)
+	}
+	if req.To != nil {
+		to = float64(*req.To)
+	}
+	limit := MaxSamples
+	if req.Limit < 0 || req.Limit > MaxSamples {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid limit %d", req.Limit)
+	}
+	if req.Limit > 0 {
+		limit = int(req.Limit)
+	}
+
+	samples, err := s.history.Telemetry(ctx, req.SessionUid, int(req.Car), from, to, limit)
+	if err != nil {
+		logrus.WithError(err).Error("could not query telemetry")
+		return nil, status.Error(codes.Internal, "could not query telemetry")
+	}
+	res := &GetTelemetryResponse{Samples: make([]*TelemetrySample, 0, len(samples))}
+	for _, sample := range samples {
+		res.Samples = append(res.Samples, &TelemetrySample{
+			Time:        timestamppb.New(sample.Time),
+			SessionTime: sample.SessionTime,
+			Speed:       uint32(sample.Speed),
+			Throttle:    sample.Throttle,
+			Steer:       sample.Steer,
+			Brake:       sample.Brake,
+			Gear:        int32(sample.Gear),
+			EngineRpm:   uint32(sample.EngineRPM),
+			Drs:         sample.Drs,
+		})
+	}
+	return res, nil
+}
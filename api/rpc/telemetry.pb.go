@@ -0,0 +1,12540 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        (unknown)
+// source: telemetry.proto
+
+package rpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types of the documents, the names of the fields of Document.data: car_telemetry, lap, timing_tower...
+	// Every type when empty.
+	Types []string `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	// Cars the documents are about, every car when empty.
+	// The documents about the whole session, ie: session or timing_tower, are not filtered by car.
+	Cars []uint32 `protobuf:"varint,2,rep,packed,name=cars,proto3" json:"cars,omitempty"`
+	// Session the documents are about, every session when empty
+	SessionUid string `protobuf:"bytes,3,opt,name=session_uid,json=sessionUid,proto3" json:"session_uid,omitempty"`
+	// Rig the packets were received from, every rig when empty
+	Rig string `protobuf:"bytes,4,opt,name=rig,proto3" json:"rig,omitempty"`
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetCars() []uint32 {
+	if x != nil {
+		return x.Cars
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetSessionUid() string {
+	if x != nil {
+		return x.SessionUid
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetRig() string {
+	if x != nil {
+		return x.Rig
+	}
+	return ""
+}
+
+// Document is a raw packet of the player car or an analysis.
+// The messages of the documents mirror the json documents of the models package:
+// their json names are the names of the fields of the models, a json document is read with protojson.
+type Document struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the document, or the name of its packet for the raw packets. Also the name of the field set in data.
+	Type       string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	SessionUid string `protobuf:"bytes,2,opt,name=session_uid,json=sessionUid,proto3" json:"session_uid,omitempty"`
+	// Car the document is about, -1 for the documents about the whole session
+	CarIndex int32  `protobuf:"varint,3,opt,name=car_index,json=carIndex,proto3" json:"car_index,omitempty"`
+	Rig      string `protobuf:"bytes,4,opt,name=rig,proto3" json:"rig,omitempty"`
+	Driver   string `protobuf:"bytes,5,opt,name=driver,proto3" json:"driver,omitempty"`
+	// Types that are assignable to Data:
+	//	*Document_Motion
+	//	*Document_Session
+	//	*Document_LapData
+	//	*Document_Event
+	//	*Document_Participants
+	//	*Document_CarSetups
+	//	*Document_CarTelemetry
+	//	*Document_CarStatus
+	//	*Document_FinalClassification
+	//	*Document_LobbyInfo
+	//	*Document_Lap
+	//	*Document_Stint
+	//	*Document_FuelLap
+	//	*Document_FuelStrategy
+	//	*Document_PitStop
+	//	*Document_TrackMap
+	//	*Document_CarPositions
+	//	*Document_TimingTower
+	//	*Document_PositionChange
+	//	*Document_Incident
+	//	*Document_SessionSummary
+	//	*Document_PersonalBest
+	//	*Document_Corners
+	//	*Document_CornerLap
+	//	*Document_BrakingEvent
+	//	*Document_BrakingLap
+	//	*Document_WheelspinEvent
+	//	*Document_TractionLap
+	//	*Document_TractionSetting
+	//	*Document_TheoreticalBest
+	Data isDocument_Data `protobuf_oneof:"data"`
+}
+
+func (x *Document) Reset() {
+	*x = Document{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Document) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document) ProtoMessage() {}
+
+func (x *Document) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Document) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Document) GetSessionUid() string {
+	if x != nil {
+		return x.SessionUid
+	}
+	return ""
+}
+
+func (x *Document) GetCarIndex() int32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *Document) GetRig() string {
+	if x != nil {
+		return x.Rig
+	}
+	return ""
+}
+
+func (x *Document) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (m *Document) GetData() isDocument_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *Document) GetMotion() *MotionData {
+	if x, ok := x.GetData().(*Document_Motion); ok {
+		return x.Motion
+	}
+	return nil
+}
+
+func (x *Document) GetSession() *SessionData {
+	if x, ok := x.GetData().(*Document_Session); ok {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *Document) GetLapData() *LapData {
+	if x, ok := x.GetData().(*Document_LapData); ok {
+		return x.LapData
+	}
+	return nil
+}
+
+func (x *Document) GetEvent() *EventData {
+	if x, ok := x.GetData().(*Document_Event); ok {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *Document) GetParticipants() *ParticipantsData {
+	if x, ok := x.GetData().(*Document_Participants); ok {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *Document) GetCarSetups() *CarSetupData {
+	if x, ok := x.GetData().(*Document_CarSetups); ok {
+		return x.CarSetups
+	}
+	return nil
+}
+
+func (x *Document) GetCarTelemetry() *CarTelemetryData {
+	if x, ok := x.GetData().(*Document_CarTelemetry); ok {
+		return x.CarTelemetry
+	}
+	return nil
+}
+
+func (x *Document) GetCarStatus() *CarStatusData {
+	if x, ok := x.GetData().(*Document_CarStatus); ok {
+		return x.CarStatus
+	}
+	return nil
+}
+
+func (x *Document) GetFinalClassification() *FinalClassificationData {
+	if x, ok := x.GetData().(*Document_FinalClassification); ok {
+		return x.FinalClassification
+	}
+	return nil
+}
+
+func (x *Document) GetLobbyInfo() *LobbyInfoData {
+	if x, ok := x.GetData().(*Document_LobbyInfo); ok {
+		return x.LobbyInfo
+	}
+	return nil
+}
+
+func (x *Document) GetLap() *Lap {
+	if x, ok := x.GetData().(*Document_Lap); ok {
+		return x.Lap
+	}
+	return nil
+}
+
+func (x *Document) GetStint() *Stint {
+	if x, ok := x.GetData().(*Document_Stint); ok {
+		return x.Stint
+	}
+	return nil
+}
+
+func (x *Document) GetFuelLap() *FuelLap {
+	if x, ok := x.GetData().(*Document_FuelLap); ok {
+		return x.FuelLap
+	}
+	return nil
+}
+
+func (x *Document) GetFuelStrategy() *FuelStrategy {
+	if x, ok := x.GetData().(*Document_FuelStrategy); ok {
+		return x.FuelStrategy
+	}
+	return nil
+}
+
+func (x *Document) GetPitStop() *PitStop {
+	if x, ok := x.GetData().(*Document_PitStop); ok {
+		return x.PitStop
+	}
+	return nil
+}
+
+func (x *Document) GetTrackMap() *TrackMap {
+	if x, ok := x.GetData().(*Document_TrackMap); ok {
+		return x.TrackMap
+	}
+	return nil
+}
+
+func (x *Document) GetCarPositions() *CarPositions {
+	if x, ok := x.GetData().(*Document_CarPositions); ok {
+		return x.CarPositions
+	}
+	return nil
+}
+
+func (x *Document) GetTimingTower() *TimingTower {
+	if x, ok := x.GetData().(*Document_TimingTower); ok {
+		return x.TimingTower
+	}
+	return nil
+}
+
+func (x *Document) GetPositionChange() *PositionChange {
+	if x, ok := x.GetData().(*Document_PositionChange); ok {
+		return x.PositionChange
+	}
+	return nil
+}
+
+func (x *Document) GetIncident() *Incident {
+	if x, ok := x.GetData().(*Document_Incident); ok {
+		return x.Incident
+	}
+	return nil
+}
+
+func (x *Document) GetSessionSummary() *SessionSummary {
+	if x, ok := x.GetData().(*Document_SessionSummary); ok {
+		return x.SessionSummary
+	}
+	return nil
+}
+
+func (x *Document) GetPersonalBest() *PersonalBest {
+	if x, ok := x.GetData().(*Document_PersonalBest); ok {
+		return x.PersonalBest
+	}
+	return nil
+}
+
+func (x *Document) GetCorners() *Corners {
+	if x, ok := x.GetData().(*Document_Corners); ok {
+		return x.Corners
+	}
+	return nil
+}
+
+func (x *Document) GetCornerLap() *CornerLap {
+	if x, ok := x.GetData().(*Document_CornerLap); ok {
+		return x.CornerLap
+	}
+	return nil
+}
+
+func (x *Document) GetBrakingEvent() *BrakingEvent {
+	if x, ok := x.GetData().(*Document_BrakingEvent); ok {
+		return x.BrakingEvent
+	}
+	return nil
+}
+
+func (x *Document) GetBrakingLap() *BrakingLap {
+	if x, ok := x.GetData().(*Document_BrakingLap); ok {
+		return x.BrakingLap
+	}
+	return nil
+}
+
+func (x *Document) GetWheelspinEvent() *WheelspinEvent {
+	if x, ok := x.GetData().(*Document_WheelspinEvent); ok {
+		return x.WheelspinEvent
+	}
+	return nil
+}
+
+func (x *Document) GetTractionLap() *TractionLap {
+	if x, ok := x.GetData().(*Document_TractionLap); ok {
+		return x.TractionLap
+	}
+	return nil
+}
+
+func (x *Document) GetTractionSetting() *TractionSetting {
+	if x, ok := x.GetData().(*Document_TractionSetting); ok {
+		return x.TractionSetting
+	}
+	return nil
+}
+
+func (x *Document) GetTheoreticalBest() *TheoreticalBest {
+	if x, ok := x.GetData().(*Document_TheoreticalBest); ok {
+		return x.TheoreticalBest
+	}
+	return nil
+}
+
+type isDocument_Data interface {
+	isDocument_Data()
+}
+
+type Document_Motion struct {
+	Motion *MotionData `protobuf:"bytes,10,opt,name=motion,proto3,oneof"`
+}
+
+type Document_Session struct {
+	Session *SessionData `protobuf:"bytes,11,opt,name=session,proto3,oneof"`
+}
+
+type Document_LapData struct {
+	LapData *LapData `protobuf:"bytes,12,opt,name=lap_data,json=lapData,proto3,oneof"`
+}
+
+type Document_Event struct {
+	Event *EventData `protobuf:"bytes,13,opt,name=event,proto3,oneof"`
+}
+
+type Document_Participants struct {
+	Participants *ParticipantsData `protobuf:"bytes,14,opt,name=participants,proto3,oneof"`
+}
+
+type Document_CarSetups struct {
+	CarSetups *CarSetupData `protobuf:"bytes,15,opt,name=car_setups,json=carSetups,proto3,oneof"`
+}
+
+type Document_CarTelemetry struct {
+	CarTelemetry *CarTelemetryData `protobuf:"bytes,16,opt,name=car_telemetry,json=carTelemetry,proto3,oneof"`
+}
+
+type Document_CarStatus struct {
+	CarStatus *CarStatusData `protobuf:"bytes,17,opt,name=car_status,json=carStatus,proto3,oneof"`
+}
+
+type Document_FinalClassification struct {
+	FinalClassification *FinalClassificationData `protobuf:"bytes,18,opt,name=final_classification,json=finalClassification,proto3,oneof"`
+}
+
+type Document_LobbyInfo struct {
+	LobbyInfo *LobbyInfoData `protobuf:"bytes,19,opt,name=lobby_info,json=lobbyInfo,proto3,oneof"`
+}
+
+type Document_Lap struct {
+	Lap *Lap `protobuf:"bytes,100,opt,name=lap,proto3,oneof"`
+}
+
+type Document_Stint struct {
+	Stint *Stint `protobuf:"bytes,101,opt,name=stint,proto3,oneof"`
+}
+
+type Document_FuelLap struct {
+	FuelLap *FuelLap `protobuf:"bytes,102,opt,name=fuel_lap,json=fuelLap,proto3,oneof"`
+}
+
+type Document_FuelStrategy struct {
+	FuelStrategy *FuelStrategy `protobuf:"bytes,103,opt,name=fuel_strategy,json=fuelStrategy,proto3,oneof"`
+}
+
+type Document_PitStop struct {
+	PitStop *PitStop `protobuf:"bytes,104,opt,name=pit_stop,json=pitStop,proto3,oneof"`
+}
+
+type Document_TrackMap struct {
+	TrackMap *TrackMap `protobuf:"bytes,105,opt,name=track_map,json=trackMap,proto3,oneof"`
+}
+
+type Document_CarPositions struct {
+	CarPositions *CarPositions `protobuf:"bytes,106,opt,name=car_positions,json=carPositions,proto3,oneof"`
+}
+
+type Document_TimingTower struct {
+	TimingTower *TimingTower `protobuf:"bytes,107,opt,name=timing_tower,json=timingTower,proto3,oneof"`
+}
+
+type Document_PositionChange struct {
+	PositionChange *PositionChange `protobuf:"bytes,108,opt,name=position_change,json=positionChange,proto3,oneof"`
+}
+
+type Document_Incident struct {
+	Incident *Incident `protobuf:"bytes,109,opt,name=incident,proto3,oneof"`
+}
+
+type Document_SessionSummary struct {
+	SessionSummary *SessionSummary `protobuf:"bytes,110,opt,name=session_summary,json=sessionSummary,proto3,oneof"`
+}
+
+type Document_PersonalBest struct {
+	PersonalBest *PersonalBest `protobuf:"bytes,111,opt,name=personal_best,json=personalBest,proto3,oneof"`
+}
+
+type Document_Corners struct {
+	Corners *Corners `protobuf:"bytes,112,opt,name=corners,proto3,oneof"`
+}
+
+type Document_CornerLap struct {
+	CornerLap *CornerLap `protobuf:"bytes,113,opt,name=corner_lap,json=cornerLap,proto3,oneof"`
+}
+
+type Document_BrakingEvent struct {
+	BrakingEvent *BrakingEvent `protobuf:"bytes,114,opt,name=braking_event,json=brakingEvent,proto3,oneof"`
+}
+
+type Document_BrakingLap struct {
+	BrakingLap *BrakingLap `protobuf:"bytes,115,opt,name=braking_lap,json=brakingLap,proto3,oneof"`
+}
+
+type Document_WheelspinEvent struct {
+	WheelspinEvent *WheelspinEvent `protobuf:"bytes,116,opt,name=wheelspin_event,json=wheelspinEvent,proto3,oneof"`
+}
+
+type Document_TractionLap struct {
+	TractionLap *TractionLap `protobuf:"bytes,117,opt,name=traction_lap,json=tractionLap,proto3,oneof"`
+}
+
+type Document_TractionSetting struct {
+	TractionSetting *TractionSetting `protobuf:"bytes,118,opt,name=traction_setting,json=tractionSetting,proto3,oneof"`
+}
+
+type Document_TheoreticalBest struct {
+	TheoreticalBest *TheoreticalBest `protobuf:"bytes,119,opt,name=theoretical_best,json=theoreticalBest,proto3,oneof"`
+}
+
+func (*Document_Motion) isDocument_Data() {}
+
+func (*Document_Session) isDocument_Data() {}
+
+func (*Document_LapData) isDocument_Data() {}
+
+func (*Document_Event) isDocument_Data() {}
+
+func (*Document_Participants) isDocument_Data() {}
+
+func (*Document_CarSetups) isDocument_Data() {}
+
+func (*Document_CarTelemetry) isDocument_Data() {}
+
+func (*Document_CarStatus) isDocument_Data() {}
+
+func (*Document_FinalClassification) isDocument_Data() {}
+
+func (*Document_LobbyInfo) isDocument_Data() {}
+
+func (*Document_Lap) isDocument_Data() {}
+
+func (*Document_Stint) isDocument_Data() {}
+
+func (*Document_FuelLap) isDocument_Data() {}
+
+func (*Document_FuelStrategy) isDocument_Data() {}
+
+func (*Document_PitStop) isDocument_Data() {}
+
+func (*Document_TrackMap) isDocument_Data() {}
+
+func (*Document_CarPositions) isDocument_Data() {}
+
+func (*Document_TimingTower) isDocument_Data() {}
+
+func (*Document_PositionChange) isDocument_Data() {}
+
+func (*Document_Incident) isDocument_Data() {}
+
+func (*Document_SessionSummary) isDocument_Data() {}
+
+func (*Document_PersonalBest) isDocument_Data() {}
+
+func (*Document_Corners) isDocument_Data() {}
+
+func (*Document_CornerLap) isDocument_Data() {}
+
+func (*Document_BrakingEvent) isDocument_Data() {}
+
+func (*Document_BrakingLap) isDocument_Data() {}
+
+func (*Document_WheelspinEvent) isDocument_Data() {}
+
+func (*Document_TractionLap) isDocument_Data() {}
+
+func (*Document_TractionSetting) isDocument_Data() {}
+
+func (*Document_TheoreticalBest) isDocument_Data() {}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of sessions, default 50
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSessionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*StoredSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*StoredSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// StoredSession is a session stored in the database
+type StoredSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionUid      string                 `protobuf:"bytes,1,opt,name=session_uid,json=sessionUid,proto3" json:"session_uid,omitempty"`
+	Rig             string                 `protobuf:"bytes,2,opt,name=rig,proto3" json:"rig,omitempty"`
+	Driver          string                 `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
+	TrackId         int32                  `protobuf:"varint,4,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	TrackName       string                 `protobuf:"bytes,5,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	Formula         uint32                 `protobuf:"varint,6,opt,name=formula,proto3" json:"formula,omitempty"`
+	SessionType     uint32                 `protobuf:"varint,7,opt,name=session_type,json=sessionType,proto3" json:"session_type,omitempty"`
+	SessionTypeName string                 `protobuf:"bytes,8,opt,name=session_type_name,json=sessionTypeName,proto3" json:"session_type_name,omitempty"`
+	TotalLaps       uint32                 `protobuf:"varint,9,opt,name=total_laps,json=totalLaps,proto3" json:"total_laps,omitempty"`
+	Weather         uint32                 `protobuf:"varint,10,opt,name=weather,proto3" json:"weather,omitempty"`
+	WeatherName     string                 `protobuf:"bytes,11,opt,name=weather_name,json=weatherName,proto3" json:"weather_name,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Number of laps completed by every car
+	Laps int32 `protobuf:"varint,14,opt,name=laps,proto3" json:"laps,omitempty"`
+	// Best valid lap of the session in seconds, 0 if none
+	BestLapTime float32 `protobuf:"fixed32,15,opt,name=best_lap_time,json=bestLapTime,proto3" json:"best_lap_time,omitempty"`
+}
+
+func (x *StoredSession) Reset() {
+	*x = StoredSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoredSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoredSession) ProtoMessage() {}
+
+func (x *StoredSession) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoredSession.ProtoReflect.Descriptor instead.
+func (*StoredSession) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StoredSession) GetSessionUid() string {
+	if x != nil {
+		return x.SessionUid
+	}
+	return ""
+}
+
+func (x *StoredSession) GetRig() string {
+	if x != nil {
+		return x.Rig
+	}
+	return ""
+}
+
+func (x *StoredSession) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *StoredSession) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *StoredSession) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *StoredSession) GetFormula() uint32 {
+	if x != nil {
+		return x.Formula
+	}
+	return 0
+}
+
+func (x *StoredSession) GetSessionType() uint32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *StoredSession) GetSessionTypeName() string {
+	if x != nil {
+		return x.SessionTypeName
+	}
+	return ""
+}
+
+func (x *StoredSession) GetTotalLaps() uint32 {
+	if x != nil {
+		return x.TotalLaps
+	}
+	return 0
+}
+
+func (x *StoredSession) GetWeather() uint32 {
+	if x != nil {
+		return x.Weather
+	}
+	return 0
+}
+
+func (x *StoredSession) GetWeatherName() string {
+	if x != nil {
+		return x.WeatherName
+	}
+	return ""
+}
+
+func (x *StoredSession) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StoredSession) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *StoredSession) GetLaps() int32 {
+	if x != nil {
+		return x.Laps
+	}
+	return 0
+}
+
+func (x *StoredSession) GetBestLapTime() float32 {
+	if x != nil {
+		return x.BestLapTime
+	}
+	return 0
+}
+
+type ListLapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionUid string `protobuf:"bytes,1,opt,name=session_uid,json=sessionUid,proto3" json:"session_uid,omitempty"`
+	// Car the laps are of, every car when not set
+	Car *uint32 `protobuf:"varint,2,opt,name=car,proto3,oneof" json:"car,omitempty"`
+}
+
+func (x *ListLapsRequest) Reset() {
+	*x = ListLapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLapsRequest) ProtoMessage() {}
+
+func (x *ListLapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLapsRequest.ProtoReflect.Descriptor instead.
+func (*ListLapsRequest) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListLapsRequest) GetSessionUid() string {
+	if x != nil {
+		return x.SessionUid
+	}
+	return ""
+}
+
+func (x *ListLapsRequest) GetCar() uint32 {
+	if x != nil && x.Car != nil {
+		return *x.Car
+	}
+	return 0
+}
+
+type ListLapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Laps []*StoredLap `protobuf:"bytes,1,rep,name=laps,proto3" json:"laps,omitempty"`
+}
+
+func (x *ListLapsResponse) Reset() {
+	*x = ListLapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLapsResponse) ProtoMessage() {}
+
+func (x *ListLapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLapsResponse.ProtoReflect.Descriptor instead.
+func (*ListLapsResponse) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListLapsResponse) GetLaps() []*StoredLap {
+	if x != nil {
+		return x.Laps
+	}
+	return nil
+}
+
+// StoredLap is a lap stored in the database
+type StoredLap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarIndex uint32 `protobuf:"varint,1,opt,name=car_index,json=carIndex,proto3" json:"car_index,omitempty"`
+	// Participant name, empty when not known
+	Name    string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LapNum  uint32  `protobuf:"varint,3,opt,name=lap_num,json=lapNum,proto3" json:"lap_num,omitempty"`
+	LapTime float32 `protobuf:"fixed32,4,opt,name=lap_time,json=lapTime,proto3" json:"lap_time,omitempty"`
+	// Sector times in seconds, 0 when not known
+	Sector1Time        float32                `protobuf:"fixed32,5,opt,name=sector1_time,json=sector1Time,proto3" json:"sector1_time,omitempty"`
+	Sector2Time        float32                `protobuf:"fixed32,6,opt,name=sector2_time,json=sector2Time,proto3" json:"sector2_time,omitempty"`
+	Sector3Time        float32                `protobuf:"fixed32,7,opt,name=sector3_time,json=sector3Time,proto3" json:"sector3_time,omitempty"`
+	Valid              bool                   `protobuf:"varint,8,opt,name=valid,proto3" json:"valid,omitempty"`
+	PitLap             bool                   `protobuf:"varint,9,opt,name=pit_lap,json=pitLap,proto3" json:"pit_lap,omitempty"`
+	Position           uint32                 `protobuf:"varint,10,opt,name=position,proto3" json:"position,omitempty"`
+	ActualTyreCompound uint32                 `protobuf:"varint,11,opt,name=actual_tyre_compound,json=actualTyreCompound,proto3" json:"actual_tyre_compound,omitempty"`
+	VisualTyreCompound uint32                 `protobuf:"varint,12,opt,name=visual_tyre_compound,json=visualTyreCompound,proto3" json:"visual_tyre_compound,omitempty"`
+	TyresAgeLaps       uint32                 `protobuf:"varint,13,opt,name=tyres_age_laps,json=tyresAgeLaps,proto3" json:"tyres_age_laps,omitempty"`
+	CompletedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+}
+
+func (x *StoredLap) Reset() {
+	*x = StoredLap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoredLap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoredLap) ProtoMessage() {}
+
+func (x *StoredLap) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoredLap.ProtoReflect.Descriptor instead.
+func (*StoredLap) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StoredLap) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *StoredLap) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StoredLap) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *StoredLap) GetLapTime() float32 {
+	if x != nil {
+		return x.LapTime
+	}
+	return 0
+}
+
+func (x *StoredLap) GetSector1Time() float32 {
+	if x != nil {
+		return x.Sector1Time
+	}
+	return 0
+}
+
+func (x *StoredLap) GetSector2Time() float32 {
+	if x != nil {
+		return x.Sector2Time
+	}
+	return 0
+}
+
+func (x *StoredLap) GetSector3Time() float32 {
+	if x != nil {
+		return x.Sector3Time
+	}
+	return 0
+}
+
+func (x *StoredLap) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *StoredLap) GetPitLap() bool {
+	if x != nil {
+		return x.PitLap
+	}
+	return false
+}
+
+func (x *StoredLap) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *StoredLap) GetActualTyreCompound() uint32 {
+	if x != nil {
+		return x.ActualTyreCompound
+	}
+	return 0
+}
+
+func (x *StoredLap) GetVisualTyreCompound() uint32 {
+	if x != nil {
+		return x.VisualTyreCompound
+	}
+	return 0
+}
+
+func (x *StoredLap) GetTyresAgeLaps() uint32 {
+	if x != nil {
+		return x.TyresAgeLaps
+	}
+	return 0
+}
+
+func (x *StoredLap) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type GetTelemetryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionUid string `protobuf:"bytes,1,opt,name=session_uid,json=sessionUid,proto3" json:"session_uid,omitempty"`
+	Car        uint32 `protobuf:"varint,2,opt,name=car,proto3" json:"car,omitempty"`
+	// Session times in seconds, the whole session when not set
+	From *float32 `protobuf:"fixed32,3,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To   *float32 `protobuf:"fixed32,4,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	// Number of samples, default and at most 50000
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetTelemetryRequest) Reset() {
+	*x = GetTelemetryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTelemetryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTelemetryRequest) ProtoMessage() {}
+
+func (x *GetTelemetryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTelemetryRequest.ProtoReflect.Descriptor instead.
+func (*GetTelemetryRequest) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetTelemetryRequest) GetSessionUid() string {
+	if x != nil {
+		return x.SessionUid
+	}
+	return ""
+}
+
+func (x *GetTelemetryRequest) GetCar() uint32 {
+	if x != nil {
+		return x.Car
+	}
+	return 0
+}
+
+func (x *GetTelemetryRequest) GetFrom() float32 {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return 0
+}
+
+func (x *GetTelemetryRequest) GetTo() float32 {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return 0
+}
+
+func (x *GetTelemetryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTelemetryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Samples []*TelemetrySample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *GetTelemetryResponse) Reset() {
+	*x = GetTelemetryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTelemetryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTelemetryResponse) ProtoMessage() {}
+
+func (x *GetTelemetryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTelemetryResponse.ProtoReflect.Descriptor instead.
+func (*GetTelemetryResponse) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetTelemetryResponse) GetSamples() []*TelemetrySample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+// TelemetrySample is a telemetry row stored in the database
+type TelemetrySample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	SessionTime float32                `protobuf:"fixed32,2,opt,name=session_time,json=sessionTime,proto3" json:"session_time,omitempty"`
+	Speed       uint32                 `protobuf:"varint,3,opt,name=speed,proto3" json:"speed,omitempty"`
+	Throttle    float32                `protobuf:"fixed32,4,opt,name=throttle,proto3" json:"throttle,omitempty"`
+	Steer       float32                `protobuf:"fixed32,5,opt,name=steer,proto3" json:"steer,omitempty"`
+	Brake       float32                `protobuf:"fixed32,6,opt,name=brake,proto3" json:"brake,omitempty"`
+	Gear        int32                  `protobuf:"varint,7,opt,name=gear,proto3" json:"gear,omitempty"`
+	EngineRpm   uint32                 `protobuf:"varint,8,opt,name=engine_rpm,json=engineRpm,proto3" json:"engine_rpm,omitempty"`
+	Drs         bool                   `protobuf:"varint,9,opt,name=drs,proto3" json:"drs,omitempty"`
+}
+
+func (x *TelemetrySample) Reset() {
+	*x = TelemetrySample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TelemetrySample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelemetrySample) ProtoMessage() {}
+
+func (x *TelemetrySample) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelemetrySample.ProtoReflect.Descriptor instead.
+func (*TelemetrySample) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TelemetrySample) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *TelemetrySample) GetSessionTime() float32 {
+	if x != nil {
+		return x.SessionTime
+	}
+	return 0
+}
+
+func (x *TelemetrySample) GetSpeed() uint32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *TelemetrySample) GetThrottle() float32 {
+	if x != nil {
+		return x.Throttle
+	}
+	return 0
+}
+
+func (x *TelemetrySample) GetSteer() float32 {
+	if x != nil {
+		return x.Steer
+	}
+	return 0
+}
+
+func (x *TelemetrySample) GetBrake() float32 {
+	if x != nil {
+		return x.Brake
+	}
+	return 0
+}
+
+func (x *TelemetrySample) GetGear() int32 {
+	if x != nil {
+		return x.Gear
+	}
+	return 0
+}
+
+func (x *TelemetrySample) GetEngineRpm() uint32 {
+	if x != nil {
+		return x.EngineRpm
+	}
+	return 0
+}
+
+func (x *TelemetrySample) GetDrs() bool {
+	if x != nil {
+		return x.Drs
+	}
+	return false
+}
+
+// MotionData the motion packet gives physics data for all the cars being driven.
+// There is additional data for the car being driven with the goal of being able to drive a motion platform setup.
+//
+// N.B. For the normalised vectors below,
+// to convert to float values divide by 32767.0f – 16-bit signed values are used to pack the data and
+// on the assumption that direction values are always between -1.0f and 1.0f.
+type MotionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// Data of the player car
+	CarMotionData *CarMotionData `protobuf:"bytes,3,opt,name=car_motion_data,json=CarMotionData,proto3" json:"car_motion_data,omitempty"`
+	// Extra player car only data.
+	// The wheels are in the order: RL, RR, FL, FR
+	RearLeftSuspensionPosition       float32 `protobuf:"fixed32,4,opt,name=rear_left_suspension_position,json=RearLeftSuspensionPosition,proto3" json:"rear_left_suspension_position,omitempty"`
+	RearRightSuspensionPosition      float32 `protobuf:"fixed32,5,opt,name=rear_right_suspension_position,json=RearRightSuspensionPosition,proto3" json:"rear_right_suspension_position,omitempty"`
+	FrontLeftSuspensionPosition      float32 `protobuf:"fixed32,6,opt,name=front_left_suspension_position,json=FrontLeftSuspensionPosition,proto3" json:"front_left_suspension_position,omitempty"`
+	FrontRightSuspensionPosition     float32 `protobuf:"fixed32,7,opt,name=front_right_suspension_position,json=FrontRightSuspensionPosition,proto3" json:"front_right_suspension_position,omitempty"`
+	RearLeftSuspensionVelocity       float32 `protobuf:"fixed32,8,opt,name=rear_left_suspension_velocity,json=RearLeftSuspensionVelocity,proto3" json:"rear_left_suspension_velocity,omitempty"`
+	RearRightSuspensionVelocity      float32 `protobuf:"fixed32,9,opt,name=rear_right_suspension_velocity,json=RearRightSuspensionVelocity,proto3" json:"rear_right_suspension_velocity,omitempty"`
+	FrontLeftSuspensionVelocity      float32 `protobuf:"fixed32,10,opt,name=front_left_suspension_velocity,json=FrontLeftSuspensionVelocity,proto3" json:"front_left_suspension_velocity,omitempty"`
+	FrontRightSuspensionVelocity     float32 `protobuf:"fixed32,11,opt,name=front_right_suspension_velocity,json=FrontRightSuspensionVelocity,proto3" json:"front_right_suspension_velocity,omitempty"`
+	RearLeftSuspensionAcceleration   float32 `protobuf:"fixed32,12,opt,name=rear_left_suspension_acceleration,json=RearLeftSuspensionAcceleration,proto3" json:"rear_left_suspension_acceleration,omitempty"`
+	RearRightSuspensionAcceleration  float32 `protobuf:"fixed32,13,opt,name=rear_right_suspension_acceleration,json=RearRightSuspensionAcceleration,proto3" json:"rear_right_suspension_acceleration,omitempty"`
+	FrontLeftSuspensionAcceleration  float32 `protobuf:"fixed32,14,opt,name=front_left_suspension_acceleration,json=FrontLeftSuspensionAcceleration,proto3" json:"front_left_suspension_acceleration,omitempty"`
+	FrontRightSuspensionAcceleration float32 `protobuf:"fixed32,15,opt,name=front_right_suspension_acceleration,json=FrontRightSuspensionAcceleration,proto3" json:"front_right_suspension_acceleration,omitempty"`
+	// Speed of each wheel
+	RearLeftWheelSpeed   float32 `protobuf:"fixed32,16,opt,name=rear_left_wheel_speed,json=RearLeftWheelSpeed,proto3" json:"rear_left_wheel_speed,omitempty"`
+	RearRightWheelSpeed  float32 `protobuf:"fixed32,17,opt,name=rear_right_wheel_speed,json=RearRightWheelSpeed,proto3" json:"rear_right_wheel_speed,omitempty"`
+	FrontLeftWheelSpeed  float32 `protobuf:"fixed32,18,opt,name=front_left_wheel_speed,json=FrontLeftWheelSpeed,proto3" json:"front_left_wheel_speed,omitempty"`
+	FrontRightWheelSpeed float32 `protobuf:"fixed32,19,opt,name=front_right_wheel_speed,json=FrontRightWheelSpeed,proto3" json:"front_right_wheel_speed,omitempty"`
+	// Slip ratio for each wheel
+	RearLeftWheelSlip   float32 `protobuf:"fixed32,20,opt,name=rear_left_wheel_slip,json=RearLeftWheelSlip,proto3" json:"rear_left_wheel_slip,omitempty"`
+	RearRightWheelSlip  float32 `protobuf:"fixed32,21,opt,name=rear_right_wheel_slip,json=RearRightWheelSlip,proto3" json:"rear_right_wheel_slip,omitempty"`
+	FrontLeftWheelSlip  float32 `protobuf:"fixed32,22,opt,name=front_left_wheel_slip,json=FrontLeftWheelSlip,proto3" json:"front_left_wheel_slip,omitempty"`
+	FrontRightWheelSlip float32 `protobuf:"fixed32,23,opt,name=front_right_wheel_slip,json=FrontRightWheelSlip,proto3" json:"front_right_wheel_slip,omitempty"`
+	// Velocity in local space
+	LocalVelocityX float32 `protobuf:"fixed32,24,opt,name=local_velocity_x,json=LocalVelocityX,proto3" json:"local_velocity_x,omitempty"`
+	LocalVelocityY float32 `protobuf:"fixed32,25,opt,name=local_velocity_y,json=LocalVelocityY,proto3" json:"local_velocity_y,omitempty"`
+	LocalVelocityZ float32 `protobuf:"fixed32,26,opt,name=local_velocity_z,json=LocalVelocityZ,proto3" json:"local_velocity_z,omitempty"`
+	// Angular velocity
+	AngularVelocityX float32 `protobuf:"fixed32,27,opt,name=angular_velocity_x,json=AngularVelocityX,proto3" json:"angular_velocity_x,omitempty"`
+	AngularVelocityY float32 `protobuf:"fixed32,28,opt,name=angular_velocity_y,json=AngularVelocityY,proto3" json:"angular_velocity_y,omitempty"`
+	AngularVelocityZ float32 `protobuf:"fixed32,29,opt,name=angular_velocity_z,json=AngularVelocityZ,proto3" json:"angular_velocity_z,omitempty"`
+	// Angular acceleration
+	AngularAccelerationX float32 `protobuf:"fixed32,30,opt,name=angular_acceleration_x,json=AngularAccelerationX,proto3" json:"angular_acceleration_x,omitempty"`
+	AngularAccelerationY float32 `protobuf:"fixed32,31,opt,name=angular_acceleration_y,json=AngularAccelerationY,proto3" json:"angular_acceleration_y,omitempty"`
+	AngularAccelerationZ float32 `protobuf:"fixed32,32,opt,name=angular_acceleration_z,json=AngularAccelerationZ,proto3" json:"angular_acceleration_z,omitempty"`
+	// Current front wheels angle in radians
+	FrontWheelsAngle float32 `protobuf:"fixed32,33,opt,name=front_wheels_angle,json=FrontWheelsAngle,proto3" json:"front_wheels_angle,omitempty"`
+}
+
+func (x *MotionData) Reset() {
+	*x = MotionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MotionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MotionData) ProtoMessage() {}
+
+func (x *MotionData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MotionData.ProtoReflect.Descriptor instead.
+func (*MotionData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MotionData) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *MotionData) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *MotionData) GetCarMotionData() *CarMotionData {
+	if x != nil {
+		return x.CarMotionData
+	}
+	return nil
+}
+
+func (x *MotionData) GetRearLeftSuspensionPosition() float32 {
+	if x != nil {
+		return x.RearLeftSuspensionPosition
+	}
+	return 0
+}
+
+func (x *MotionData) GetRearRightSuspensionPosition() float32 {
+	if x != nil {
+		return x.RearRightSuspensionPosition
+	}
+	return 0
+}
+
+func (x *MotionData) GetFrontLeftSuspensionPosition() float32 {
+	if x != nil {
+		return x.FrontLeftSuspensionPosition
+	}
+	return 0
+}
+
+func (x *MotionData) GetFrontRightSuspensionPosition() float32 {
+	if x != nil {
+		return x.FrontRightSuspensionPosition
+	}
+	return 0
+}
+
+func (x *MotionData) GetRearLeftSuspensionVelocity() float32 {
+	if x != nil {
+		return x.RearLeftSuspensionVelocity
+	}
+	return 0
+}
+
+func (x *MotionData) GetRearRightSuspensionVelocity() float32 {
+	if x != nil {
+		return x.RearRightSuspensionVelocity
+	}
+	return 0
+}
+
+func (x *MotionData) GetFrontLeftSuspensionVelocity() float32 {
+	if x != nil {
+		return x.FrontLeftSuspensionVelocity
+	}
+	return 0
+}
+
+func (x *MotionData) GetFrontRightSuspensionVelocity() float32 {
+	if x != nil {
+		return x.FrontRightSuspensionVelocity
+	}
+	return 0
+}
+
+func (x *MotionData) GetRearLeftSuspensionAcceleration() float32 {
+	if x != nil {
+		return x.RearLeftSuspensionAcceleration
+	}
+	return 0
+}
+
+func (x *MotionData) GetRearRightSuspensionAcceleration() float32 {
+	if x != nil {
+		return x.RearRightSuspensionAcceleration
+	}
+	return 0
+}
+
+func (x *MotionData) GetFrontLeftSuspensionAcceleration() float32 {
+	if x != nil {
+		return x.FrontLeftSuspensionAcceleration
+	}
+	return 0
+}
+
+func (x *MotionData) GetFrontRightSuspensionAcceleration() float32 {
+	if x != nil {
+		return x.FrontRightSuspensionAcceleration
+	}
+	return 0
+}
+
+func (x *MotionData) GetRearLeftWheelSpeed() float32 {
+	if x != nil {
+		return x.RearLeftWheelSpeed
+	}
+	return 0
+}
+
+func (x *MotionData) GetRearRightWheelSpeed() float32 {
+	if x != nil {
+		return x.RearRightWheelSpeed
+	}
+	return 0
+}
+
+func (x *MotionData) GetFrontLeftWheelSpeed() float32 {
+	if x != nil {
+		return x.FrontLeftWheelSpeed
+	}
+	return 0
+}
+
+func (x *MotionData) GetFrontRightWheelSpeed() float32 {
+	if x != nil {
+		return x.FrontRightWheelSpeed
+	}
+	return 0
+}
+
+func (x *MotionData) GetRearLeftWheelSlip() float32 {
+	if x != nil {
+		return x.RearLeftWheelSlip
+	}
+	return 0
+}
+
+func (x *MotionData) GetRearRightWheelSlip() float32 {
+	if x != nil {
+		return x.RearRightWheelSlip
+	}
+	return 0
+}
+
+func (x *MotionData) GetFrontLeftWheelSlip() float32 {
+	if x != nil {
+		return x.FrontLeftWheelSlip
+	}
+	return 0
+}
+
+func (x *MotionData) GetFrontRightWheelSlip() float32 {
+	if x != nil {
+		return x.FrontRightWheelSlip
+	}
+	return 0
+}
+
+func (x *MotionData) GetLocalVelocityX() float32 {
+	if x != nil {
+		return x.LocalVelocityX
+	}
+	return 0
+}
+
+func (x *MotionData) GetLocalVelocityY() float32 {
+	if x != nil {
+		return x.LocalVelocityY
+	}
+	return 0
+}
+
+func (x *MotionData) GetLocalVelocityZ() float32 {
+	if x != nil {
+		return x.LocalVelocityZ
+	}
+	return 0
+}
+
+func (x *MotionData) GetAngularVelocityX() float32 {
+	if x != nil {
+		return x.AngularVelocityX
+	}
+	return 0
+}
+
+func (x *MotionData) GetAngularVelocityY() float32 {
+	if x != nil {
+		return x.AngularVelocityY
+	}
+	return 0
+}
+
+func (x *MotionData) GetAngularVelocityZ() float32 {
+	if x != nil {
+		return x.AngularVelocityZ
+	}
+	return 0
+}
+
+func (x *MotionData) GetAngularAccelerationX() float32 {
+	if x != nil {
+		return x.AngularAccelerationX
+	}
+	return 0
+}
+
+func (x *MotionData) GetAngularAccelerationY() float32 {
+	if x != nil {
+		return x.AngularAccelerationY
+	}
+	return 0
+}
+
+func (x *MotionData) GetAngularAccelerationZ() float32 {
+	if x != nil {
+		return x.AngularAccelerationZ
+	}
+	return 0
+}
+
+func (x *MotionData) GetFrontWheelsAngle() float32 {
+	if x != nil {
+		return x.FrontWheelsAngle
+	}
+	return 0
+}
+
+// SessionData includes details about the current session in progress.
+type SessionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// Weather - 0 = clear, 1 = light cloud, 2 = overcast
+	// 3 = light rain, 4 = heavy rain, 5 = storm
+	Weather uint32 `protobuf:"varint,3,opt,name=weather,json=Weather,proto3" json:"weather,omitempty"`
+	// Track temp. in degrees celsius
+	TrackTemperature int32 `protobuf:"varint,4,opt,name=track_temperature,json=TrackTemperature,proto3" json:"track_temperature,omitempty"`
+	// Air temp. in degrees celsius
+	AirTemperature int32 `protobuf:"varint,5,opt,name=air_temperature,json=AirTemperature,proto3" json:"air_temperature,omitempty"`
+	// Total number of laps in this race
+	TotalLaps uint32 `protobuf:"varint,6,opt,name=total_laps,json=TotalLaps,proto3" json:"total_laps,omitempty"`
+	// Track length in metres
+	TrackLength uint32 `protobuf:"varint,7,opt,name=track_length,json=TrackLength,proto3" json:"track_length,omitempty"`
+	// 0 = unknown, 1 = P1, 2 = P2, 3 = P3, 4 = Short P
+	// 5 = Q1, 6 = Q2, 7 = Q3, 8 = Short Q, 9 = OSQ
+	// 10 = R, 11 = R2, 12 = Time Trial
+	SessionType uint32 `protobuf:"varint,8,opt,name=session_type,json=SessionType,proto3" json:"session_type,omitempty"`
+	// -1 for unknown, 0-21 for tracks, see appendix
+	TrackId int32 `protobuf:"varint,9,opt,name=track_id,json=TrackID,proto3" json:"track_id,omitempty"`
+	// Formula
+	// 0 = F1 Modern
+	// 1 = F1 Classic
+	// 2 = F2
+	// 3 = F1 Generic
+	Formula uint32 `protobuf:"varint,10,opt,name=formula,json=Formula,proto3" json:"formula,omitempty"`
+	// Time left in session in seconds
+	SessionTimeLeft uint32 `protobuf:"varint,11,opt,name=session_time_left,json=SessionTimeLeft,proto3" json:"session_time_left,omitempty"`
+	// Session duration in seconds
+	SessionDuration uint32 `protobuf:"varint,12,opt,name=session_duration,json=SessionDuration,proto3" json:"session_duration,omitempty"`
+	// Pit speed limit in kilometres per hour
+	PitSpeedLimit uint32 `protobuf:"varint,13,opt,name=pit_speed_limit,json=PitSpeedLimit,proto3" json:"pit_speed_limit,omitempty"`
+	// Whether the game is paused
+	GamePaused uint32 `protobuf:"varint,14,opt,name=game_paused,json=GamePaused,proto3" json:"game_paused,omitempty"`
+	// Whether the player is spectating
+	IsSpectating uint32 `protobuf:"varint,15,opt,name=is_spectating,json=IsSpectating,proto3" json:"is_spectating,omitempty"`
+	// Index of the car being spectated
+	SpectatorCarIndex uint32 `protobuf:"varint,16,opt,name=spectator_car_index,json=SpectatorCarIndex,proto3" json:"spectator_car_index,omitempty"`
+	// SLI Pro support, 0 = inactive, 1 = active
+	SliProNativeSupport uint32 `protobuf:"varint,17,opt,name=sli_pro_native_support,json=SliProNativeSupport,proto3" json:"sli_pro_native_support,omitempty"`
+	// Number of marshal zones to follow
+	NumMarshalZones uint32 `protobuf:"varint,18,opt,name=num_marshal_zones,json=NumMarshalZones,proto3" json:"num_marshal_zones,omitempty"`
+	// First marshal zone of the track
+	MarshalZones *MarshalZone `protobuf:"bytes,19,opt,name=marshal_zones,json=MarshalZones,proto3" json:"marshal_zones,omitempty"`
+	// SafetyCarStatus
+	// 0 = no safety car
+	// 1 = full safety car
+	// 2 = virtual safety car
+	SafetyCarStatus uint32 `protobuf:"varint,20,opt,name=safety_car_status,json=SafetyCarStatus,proto3" json:"safety_car_status,omitempty"`
+	// 0 = offline, 1 = online
+	NetworkGame uint32 `protobuf:"varint,21,opt,name=network_game,json=NetworkGame,proto3" json:"network_game,omitempty"`
+	// Number of weather samples to follow
+	NumWeatherForecastSamples uint32 `protobuf:"varint,22,opt,name=num_weather_forecast_samples,json=NumWeatherForecastSamples,proto3" json:"num_weather_forecast_samples,omitempty"`
+	// First weather forecast sample
+	WeatherForecastSamples *WeatherForecastSample `protobuf:"bytes,23,opt,name=weather_forecast_samples,json=WeatherForecastSamples,proto3" json:"weather_forecast_samples,omitempty"`
+}
+
+func (x *SessionData) Reset() {
+	*x = SessionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionData) ProtoMessage() {}
+
+func (x *SessionData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionData.ProtoReflect.Descriptor instead.
+func (*SessionData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SessionData) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *SessionData) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *SessionData) GetWeather() uint32 {
+	if x != nil {
+		return x.Weather
+	}
+	return 0
+}
+
+func (x *SessionData) GetTrackTemperature() int32 {
+	if x != nil {
+		return x.TrackTemperature
+	}
+	return 0
+}
+
+func (x *SessionData) GetAirTemperature() int32 {
+	if x != nil {
+		return x.AirTemperature
+	}
+	return 0
+}
+
+func (x *SessionData) GetTotalLaps() uint32 {
+	if x != nil {
+		return x.TotalLaps
+	}
+	return 0
+}
+
+func (x *SessionData) GetTrackLength() uint32 {
+	if x != nil {
+		return x.TrackLength
+	}
+	return 0
+}
+
+func (x *SessionData) GetSessionType() uint32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *SessionData) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *SessionData) GetFormula() uint32 {
+	if x != nil {
+		return x.Formula
+	}
+	return 0
+}
+
+func (x *SessionData) GetSessionTimeLeft() uint32 {
+	if x != nil {
+		return x.SessionTimeLeft
+	}
+	return 0
+}
+
+func (x *SessionData) GetSessionDuration() uint32 {
+	if x != nil {
+		return x.SessionDuration
+	}
+	return 0
+}
+
+func (x *SessionData) GetPitSpeedLimit() uint32 {
+	if x != nil {
+		return x.PitSpeedLimit
+	}
+	return 0
+}
+
+func (x *SessionData) GetGamePaused() uint32 {
+	if x != nil {
+		return x.GamePaused
+	}
+	return 0
+}
+
+func (x *SessionData) GetIsSpectating() uint32 {
+	if x != nil {
+		return x.IsSpectating
+	}
+	return 0
+}
+
+func (x *SessionData) GetSpectatorCarIndex() uint32 {
+	if x != nil {
+		return x.SpectatorCarIndex
+	}
+	return 0
+}
+
+func (x *SessionData) GetSliProNativeSupport() uint32 {
+	if x != nil {
+		return x.SliProNativeSupport
+	}
+	return 0
+}
+
+func (x *SessionData) GetNumMarshalZones() uint32 {
+	if x != nil {
+		return x.NumMarshalZones
+	}
+	return 0
+}
+
+func (x *SessionData) GetMarshalZones() *MarshalZone {
+	if x != nil {
+		return x.MarshalZones
+	}
+	return nil
+}
+
+func (x *SessionData) GetSafetyCarStatus() uint32 {
+	if x != nil {
+		return x.SafetyCarStatus
+	}
+	return 0
+}
+
+func (x *SessionData) GetNetworkGame() uint32 {
+	if x != nil {
+		return x.NetworkGame
+	}
+	return 0
+}
+
+func (x *SessionData) GetNumWeatherForecastSamples() uint32 {
+	if x != nil {
+		return x.NumWeatherForecastSamples
+	}
+	return 0
+}
+
+func (x *SessionData) GetWeatherForecastSamples() *WeatherForecastSample {
+	if x != nil {
+		return x.WeatherForecastSamples
+	}
+	return nil
+}
+
+// LapData contains the LapData for all the cars on track
+type LapData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// Lap data for all cars on track
+	LapData *PacketLapData `protobuf:"bytes,3,opt,name=lap_data,json=LapData,proto3" json:"lap_data,omitempty"`
+}
+
+func (x *LapData) Reset() {
+	*x = LapData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LapData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LapData) ProtoMessage() {}
+
+func (x *LapData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LapData.ProtoReflect.Descriptor instead.
+func (*LapData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LapData) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *LapData) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *LapData) GetLapData() *PacketLapData {
+	if x != nil {
+		return x.LapData
+	}
+	return nil
+}
+
+// EventData gives details of events that happen during the course of a session.
+type EventData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// EventStringCodes
+	//
+	//     Event           | Code     | Description
+	//     Session Started     | “SSTA”   | Sent when the session starts
+	//     Session Ended       | “SEND”   | Sent when the session ends
+	//     Fastest Lap       | “FTLP”   | When a driver achieves the fastest lap
+	//     Retirement         | “RTMT”   | When a driver retires
+	//     DRS enabled       | “DRSE”   | Race control have enabled DRS
+	//     DRS disabled       | “DRSD”   | Race control have disabled DRS
+	//     Team mate in pits     | “TMPT”   | Your team mate has entered the pits
+	//     Chequered flag       | “CHQF”   | The chequered flag has been waved
+	//     Race Winner       | “RCWN”   | The race winner is announced
+	//     Penalty Issued       | “PENA”   | A penalty has been issued – details in event
+	//     Speed Trap Triggered   | “SPTP”   | Speed trap has been triggered by fastest speed
+	EventStringCode string `protobuf:"bytes,3,opt,name=event_string_code,json=EventStringCode,proto3" json:"event_string_code,omitempty"`
+	// EventDetails - should be interpreted differently for each type
+	EventDetails *structpb.Value `protobuf:"bytes,4,opt,name=event_details,json=EventDetails,proto3" json:"event_details,omitempty"`
+}
+
+func (x *EventData) Reset() {
+	*x = EventData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventData) ProtoMessage() {}
+
+func (x *EventData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventData.ProtoReflect.Descriptor instead.
+func (*EventData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EventData) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *EventData) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *EventData) GetEventStringCode() string {
+	if x != nil {
+		return x.EventStringCode
+	}
+	return ""
+}
+
+func (x *EventData) GetEventDetails() *structpb.Value {
+	if x != nil {
+		return x.EventDetails
+	}
+	return nil
+}
+
+// ParticipantsData is a list of participants in the race.
+// If the vehicle is controlled by AI, then the name will be the driver name.
+// If this is a multiplayer game, the names will be the Steam Id on PC, or the LAN name if appropriate.
+//
+// N.B. on Xbox One, the names will always be the driver name, on PS4 the name will be the LAN name if playing a LAN game, otherwise it will be the driver name.
+//
+// The array should be indexed by vehicle index.
+//
+// Frequency: Every 5 seconds
+// Size: 1213 bytes (Packet size updated in Beta 3)
+// Version: 1
+type ParticipantsData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// Number of active cars in the data – should match number of cars on HUD
+	NumActiveCars uint32           `protobuf:"varint,3,opt,name=num_active_cars,json=NumActiveCars,proto3" json:"num_active_cars,omitempty"`
+	Participants  *ParticipantData `protobuf:"bytes,4,opt,name=participants,json=Participants,proto3" json:"participants,omitempty"`
+}
+
+func (x *ParticipantsData) Reset() {
+	*x = ParticipantsData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParticipantsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantsData) ProtoMessage() {}
+
+func (x *ParticipantsData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantsData.ProtoReflect.Descriptor instead.
+func (*ParticipantsData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ParticipantsData) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ParticipantsData) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ParticipantsData) GetNumActiveCars() uint32 {
+	if x != nil {
+		return x.NumActiveCars
+	}
+	return 0
+}
+
+func (x *ParticipantsData) GetParticipants() *ParticipantData {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+// CarSetupData details the car setups for each vehicle in the session.
+// Note that in multiplayer games, other player cars will appear as blank, you will only be able to see your car setup and AI cars.
+type CarSetupData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	CarSetups *PacketCarSetupData    `protobuf:"bytes,3,opt,name=car_setups,json=CarSetups,proto3" json:"car_setups,omitempty"`
+}
+
+func (x *CarSetupData) Reset() {
+	*x = CarSetupData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarSetupData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarSetupData) ProtoMessage() {}
+
+func (x *CarSetupData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarSetupData.ProtoReflect.Descriptor instead.
+func (*CarSetupData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CarSetupData) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *CarSetupData) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *CarSetupData) GetCarSetups() *PacketCarSetupData {
+	if x != nil {
+		return x.CarSetups
+	}
+	return nil
+}
+
+// CarTelemetryData details telemetry for all the cars in the race.
+// It details various values that would be recorded on the car such as speed, throttle application, DRS etc.
+type CarTelemetryData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header           *Header                  `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp        *timestamppb.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	CarTelemetryData *CarTelemetryDataDetails `protobuf:"bytes,3,opt,name=car_telemetry_data,json=CarTelemetryData,proto3" json:"car_telemetry_data,omitempty"`
+	// Bit flags specifying which buttons are being pressed
+	// currently - see appendices
+	ButtonStatus uint32 `protobuf:"varint,4,opt,name=button_status,json=ButtonStatus,proto3" json:"button_status,omitempty"`
+	// Added in Beta 3:
+	// Index of MFD panel open - 255 = MFD closed
+	// Single player, race – 0 = Car setup, 1 = Pits
+	// 2 = Damage, 3 =  Engine, 4 = Temperatures
+	// May vary depending on game mode
+	MfdPanelIndex uint32 `protobuf:"varint,5,opt,name=mfd_panel_index,json=MfdPanelIndex,proto3" json:"mfd_panel_index,omitempty"`
+	// Index of MFD panel open of the secondary player, see above
+	MfdPanelIndexSecondaryPlayer uint32 `protobuf:"varint,6,opt,name=mfd_panel_index_secondary_player,json=MfdPanelIndexSecondaryPlayer,proto3" json:"mfd_panel_index_secondary_player,omitempty"`
+	// Suggested gear for the player (1-8)
+	// 0 if no gear suggested
+	SuggestedGear int32 `protobuf:"varint,7,opt,name=suggested_gear,json=SuggestedGear,proto3" json:"suggested_gear,omitempty"`
+}
+
+func (x *CarTelemetryData) Reset() {
+	*x = CarTelemetryData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarTelemetryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarTelemetryData) ProtoMessage() {}
+
+func (x *CarTelemetryData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarTelemetryData.ProtoReflect.Descriptor instead.
+func (*CarTelemetryData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CarTelemetryData) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *CarTelemetryData) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *CarTelemetryData) GetCarTelemetryData() *CarTelemetryDataDetails {
+	if x != nil {
+		return x.CarTelemetryData
+	}
+	return nil
+}
+
+func (x *CarTelemetryData) GetButtonStatus() uint32 {
+	if x != nil {
+		return x.ButtonStatus
+	}
+	return 0
+}
+
+func (x *CarTelemetryData) GetMfdPanelIndex() uint32 {
+	if x != nil {
+		return x.MfdPanelIndex
+	}
+	return 0
+}
+
+func (x *CarTelemetryData) GetMfdPanelIndexSecondaryPlayer() uint32 {
+	if x != nil {
+		return x.MfdPanelIndexSecondaryPlayer
+	}
+	return 0
+}
+
+func (x *CarTelemetryData) GetSuggestedGear() int32 {
+	if x != nil {
+		return x.SuggestedGear
+	}
+	return 0
+}
+
+// CarStatusData details car statuses for all the cars in the race.
+// It includes values such as the damage readings on the car.
+type CarStatusData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header        *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	CarStatusData *CarStatusDataDetail   `protobuf:"bytes,3,opt,name=car_status_data,json=CarStatusData,proto3" json:"car_status_data,omitempty"`
+}
+
+func (x *CarStatusData) Reset() {
+	*x = CarStatusData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarStatusData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarStatusData) ProtoMessage() {}
+
+func (x *CarStatusData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarStatusData.ProtoReflect.Descriptor instead.
+func (*CarStatusData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CarStatusData) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *CarStatusData) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *CarStatusData) GetCarStatusData() *CarStatusDataDetail {
+	if x != nil {
+		return x.CarStatusData
+	}
+	return nil
+}
+
+// FinalClassificationData details the final classification at the end of the race
+// and the data will match with the post race results screen.
+// This is especially useful for multiplayer games where it is not always possible to send lap times on the final frame because of network delay.
+type FinalClassificationData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// Number of cars in the final classification
+	NumCars            uint32                         `protobuf:"varint,3,opt,name=num_cars,json=NumCars,proto3" json:"num_cars,omitempty"`
+	ClassificationData *PacketFinalClassificationData `protobuf:"bytes,4,opt,name=classification_data,json=ClassificationData,proto3" json:"classification_data,omitempty"`
+}
+
+func (x *FinalClassificationData) Reset() {
+	*x = FinalClassificationData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinalClassificationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalClassificationData) ProtoMessage() {}
+
+func (x *FinalClassificationData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalClassificationData.ProtoReflect.Descriptor instead.
+func (*FinalClassificationData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FinalClassificationData) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *FinalClassificationData) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *FinalClassificationData) GetNumCars() uint32 {
+	if x != nil {
+		return x.NumCars
+	}
+	return 0
+}
+
+func (x *FinalClassificationData) GetClassificationData() *PacketFinalClassificationData {
+	if x != nil {
+		return x.ClassificationData
+	}
+	return nil
+}
+
+// LobbyInfoData details the players currently in a multiplayer lobby. It details each player’s selected car, any AI involved in the game and also the ready status of each of the participants.
+type LobbyInfoData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// specific data
+	//
+	// Number of players in the lobby data
+	NumPlayers   uint32               `protobuf:"varint,3,opt,name=num_players,json=NumPlayers,proto3" json:"num_players,omitempty"`
+	LobbyPlayers *PacketLobbyInfoData `protobuf:"bytes,4,opt,name=lobby_players,json=LobbyPlayers,proto3" json:"lobby_players,omitempty"`
+}
+
+func (x *LobbyInfoData) Reset() {
+	*x = LobbyInfoData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyInfoData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyInfoData) ProtoMessage() {}
+
+func (x *LobbyInfoData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyInfoData.ProtoReflect.Descriptor instead.
+func (*LobbyInfoData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LobbyInfoData) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *LobbyInfoData) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *LobbyInfoData) GetNumPlayers() uint32 {
+	if x != nil {
+		return x.NumPlayers
+	}
+	return 0
+}
+
+func (x *LobbyInfoData) GetLobbyPlayers() *PacketLobbyInfoData {
+	if x != nil {
+		return x.LobbyPlayers
+	}
+	return nil
+}
+
+// Lap is a lap completed by a car
+type Lap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// lap
+	Type string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,4,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// Lap number
+	LapNum uint32 `protobuf:"varint,5,opt,name=lap_num,json=LapNum,proto3" json:"lap_num,omitempty"`
+	// Lap time in seconds
+	LapTime float32 `protobuf:"fixed32,6,opt,name=lap_time,json=LapTime,proto3" json:"lap_time,omitempty"`
+	// Sector times in seconds, 0 when not known
+	Sector1Time float32 `protobuf:"fixed32,7,opt,name=sector1_time,json=Sector1Time,proto3" json:"sector1_time,omitempty"`
+	Sector2Time float32 `protobuf:"fixed32,8,opt,name=sector2_time,json=Sector2Time,proto3" json:"sector2_time,omitempty"`
+	Sector3Time float32 `protobuf:"fixed32,9,opt,name=sector3_time,json=Sector3Time,proto3" json:"sector3_time,omitempty"`
+	// Whether the lap was not invalidated
+	Valid bool `protobuf:"varint,10,opt,name=valid,json=Valid,proto3" json:"valid,omitempty"`
+	// Whether the car went through the pit lane during the lap
+	PitLap bool `protobuf:"varint,11,opt,name=pit_lap,json=PitLap,proto3" json:"pit_lap,omitempty"`
+	// Position of the car at the end of the lap
+	Position uint32 `protobuf:"varint,12,opt,name=position,json=Position,proto3" json:"position,omitempty"`
+	// Tyres used during the lap, 0 when the car status is not known
+	ActualTyreCompound     uint32 `protobuf:"varint,13,opt,name=actual_tyre_compound,json=ActualTyreCompound,proto3" json:"actual_tyre_compound,omitempty"`
+	ActualTyreCompoundName string `protobuf:"bytes,14,opt,name=actual_tyre_compound_name,json=ActualTyreCompoundName,proto3" json:"actual_tyre_compound_name,omitempty"`
+	VisualTyreCompound     uint32 `protobuf:"varint,15,opt,name=visual_tyre_compound,json=VisualTyreCompound,proto3" json:"visual_tyre_compound,omitempty"`
+	VisualTyreCompoundName string `protobuf:"bytes,16,opt,name=visual_tyre_compound_name,json=VisualTyreCompoundName,proto3" json:"visual_tyre_compound_name,omitempty"`
+	// Age in laps of the tyres at the end of the lap
+	TyresAgeLaps uint32 `protobuf:"varint,17,opt,name=tyres_age_laps,json=TyresAgeLaps,proto3" json:"tyres_age_laps,omitempty"`
+}
+
+func (x *Lap) Reset() {
+	*x = Lap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lap) ProtoMessage() {}
+
+func (x *Lap) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lap.ProtoReflect.Descriptor instead.
+func (*Lap) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Lap) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Lap) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Lap) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Lap) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *Lap) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *Lap) GetLapTime() float32 {
+	if x != nil {
+		return x.LapTime
+	}
+	return 0
+}
+
+func (x *Lap) GetSector1Time() float32 {
+	if x != nil {
+		return x.Sector1Time
+	}
+	return 0
+}
+
+func (x *Lap) GetSector2Time() float32 {
+	if x != nil {
+		return x.Sector2Time
+	}
+	return 0
+}
+
+func (x *Lap) GetSector3Time() float32 {
+	if x != nil {
+		return x.Sector3Time
+	}
+	return 0
+}
+
+func (x *Lap) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *Lap) GetPitLap() bool {
+	if x != nil {
+		return x.PitLap
+	}
+	return false
+}
+
+func (x *Lap) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Lap) GetActualTyreCompound() uint32 {
+	if x != nil {
+		return x.ActualTyreCompound
+	}
+	return 0
+}
+
+func (x *Lap) GetActualTyreCompoundName() string {
+	if x != nil {
+		return x.ActualTyreCompoundName
+	}
+	return ""
+}
+
+func (x *Lap) GetVisualTyreCompound() uint32 {
+	if x != nil {
+		return x.VisualTyreCompound
+	}
+	return 0
+}
+
+func (x *Lap) GetVisualTyreCompoundName() string {
+	if x != nil {
+		return x.VisualTyreCompoundName
+	}
+	return ""
+}
+
+func (x *Lap) GetTyresAgeLaps() uint32 {
+	if x != nil {
+		return x.TyresAgeLaps
+	}
+	return 0
+}
+
+// Stint details a set of tyres used by a car, from the lap it was fitted to the lap it was removed.
+type Stint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// stint
+	Type string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,4,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// Stint number of the car, starting at 1
+	StintNumber int32 `protobuf:"varint,5,opt,name=stint_number,json=StintNumber,proto3" json:"stint_number,omitempty"`
+	// Lap the tyres were fitted on
+	StartLap uint32 `protobuf:"varint,6,opt,name=start_lap,json=StartLap,proto3" json:"start_lap,omitempty"`
+	// Last lap completed on the tyres
+	EndLap uint32 `protobuf:"varint,7,opt,name=end_lap,json=EndLap,proto3" json:"end_lap,omitempty"`
+	// Number of laps completed on the tyres
+	NumLaps                int32  `protobuf:"varint,8,opt,name=num_laps,json=NumLaps,proto3" json:"num_laps,omitempty"`
+	ActualTyreCompound     uint32 `protobuf:"varint,9,opt,name=actual_tyre_compound,json=ActualTyreCompound,proto3" json:"actual_tyre_compound,omitempty"`
+	ActualTyreCompoundName string `protobuf:"bytes,10,opt,name=actual_tyre_compound_name,json=ActualTyreCompoundName,proto3" json:"actual_tyre_compound_name,omitempty"`
+	VisualTyreCompound     uint32 `protobuf:"varint,11,opt,name=visual_tyre_compound,json=VisualTyreCompound,proto3" json:"visual_tyre_compound,omitempty"`
+	VisualTyreCompoundName string `protobuf:"bytes,12,opt,name=visual_tyre_compound_name,json=VisualTyreCompoundName,proto3" json:"visual_tyre_compound_name,omitempty"`
+	// Age in laps of the tyres when they were fitted
+	TyresAgeAtStart uint32 `protobuf:"varint,13,opt,name=tyres_age_at_start,json=TyresAgeAtStart,proto3" json:"tyres_age_at_start,omitempty"`
+	// Wear progression and lap time of every lap of the stint
+	Laps []*StintLap `protobuf:"bytes,14,rep,name=laps,json=Laps,proto3" json:"laps,omitempty"`
+	// Average tyre wear gained per lap (percentage)
+	DegradationRate float32 `protobuf:"fixed32,15,opt,name=degradation_rate,json=DegradationRate,proto3" json:"degradation_rate,omitempty"`
+	// Lap time gained per lap in seconds, pit laps excluded
+	LapTimeTrend float32 `protobuf:"fixed32,16,opt,name=lap_time_trend,json=LapTimeTrend,proto3" json:"lap_time_trend,omitempty"`
+	// Best lap time of the stint in seconds
+	BestLapTime float32 `protobuf:"fixed32,17,opt,name=best_lap_time,json=BestLapTime,proto3" json:"best_lap_time,omitempty"`
+	// Average lap time of the stint in seconds, pit laps excluded
+	AverageLapTime float32 `protobuf:"fixed32,18,opt,name=average_lap_time,json=AverageLapTime,proto3" json:"average_lap_time,omitempty"`
+}
+
+func (x *Stint) Reset() {
+	*x = Stint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stint) ProtoMessage() {}
+
+func (x *Stint) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stint.ProtoReflect.Descriptor instead.
+func (*Stint) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Stint) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Stint) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Stint) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Stint) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *Stint) GetStintNumber() int32 {
+	if x != nil {
+		return x.StintNumber
+	}
+	return 0
+}
+
+func (x *Stint) GetStartLap() uint32 {
+	if x != nil {
+		return x.StartLap
+	}
+	return 0
+}
+
+func (x *Stint) GetEndLap() uint32 {
+	if x != nil {
+		return x.EndLap
+	}
+	return 0
+}
+
+func (x *Stint) GetNumLaps() int32 {
+	if x != nil {
+		return x.NumLaps
+	}
+	return 0
+}
+
+func (x *Stint) GetActualTyreCompound() uint32 {
+	if x != nil {
+		return x.ActualTyreCompound
+	}
+	return 0
+}
+
+func (x *Stint) GetActualTyreCompoundName() string {
+	if x != nil {
+		return x.ActualTyreCompoundName
+	}
+	return ""
+}
+
+func (x *Stint) GetVisualTyreCompound() uint32 {
+	if x != nil {
+		return x.VisualTyreCompound
+	}
+	return 0
+}
+
+func (x *Stint) GetVisualTyreCompoundName() string {
+	if x != nil {
+		return x.VisualTyreCompoundName
+	}
+	return ""
+}
+
+func (x *Stint) GetTyresAgeAtStart() uint32 {
+	if x != nil {
+		return x.TyresAgeAtStart
+	}
+	return 0
+}
+
+func (x *Stint) GetLaps() []*StintLap {
+	if x != nil {
+		return x.Laps
+	}
+	return nil
+}
+
+func (x *Stint) GetDegradationRate() float32 {
+	if x != nil {
+		return x.DegradationRate
+	}
+	return 0
+}
+
+func (x *Stint) GetLapTimeTrend() float32 {
+	if x != nil {
+		return x.LapTimeTrend
+	}
+	return 0
+}
+
+func (x *Stint) GetBestLapTime() float32 {
+	if x != nil {
+		return x.BestLapTime
+	}
+	return 0
+}
+
+func (x *Stint) GetAverageLapTime() float32 {
+	if x != nil {
+		return x.AverageLapTime
+	}
+	return 0
+}
+
+// FuelLap details the fuel used by a car during a lap and projects the fuel needed to finish the race
+type FuelLap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// fuel_lap
+	Type string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,4,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// Lap number
+	LapNum uint32 `protobuf:"varint,5,opt,name=lap_num,json=LapNum,proto3" json:"lap_num,omitempty"`
+	// Whether the car went through the pit lane during the lap
+	PitLap bool `protobuf:"varint,6,opt,name=pit_lap,json=PitLap,proto3" json:"pit_lap,omitempty"`
+	// Fuel used during the lap
+	FuelUsed float32 `protobuf:"fixed32,7,opt,name=fuel_used,json=FuelUsed,proto3" json:"fuel_used,omitempty"`
+	// Fuel used by fuel mix during the lap
+	FuelMixes []*FuelMixUsage `protobuf:"bytes,8,rep,name=fuel_mixes,json=FuelMixes,proto3" json:"fuel_mixes,omitempty"`
+	// Fuel in tank at the end of the lap
+	FuelInTank float32 `protobuf:"fixed32,9,opt,name=fuel_in_tank,json=FuelInTank,proto3" json:"fuel_in_tank,omitempty"`
+	// Fuel capacity
+	FuelCapacity float32 `protobuf:"fixed32,10,opt,name=fuel_capacity,json=FuelCapacity,proto3" json:"fuel_capacity,omitempty"`
+	// Fuel remaining in terms of laps (value on MFD)
+	FuelRemainingLaps float32 `protobuf:"fixed32,11,opt,name=fuel_remaining_laps,json=FuelRemainingLaps,proto3" json:"fuel_remaining_laps,omitempty"`
+	// Average fuel used per lap during the session, pit laps excluded
+	AverageFuelPerLap float32 `protobuf:"fixed32,12,opt,name=average_fuel_per_lap,json=AverageFuelPerLap,proto3" json:"average_fuel_per_lap,omitempty"`
+	// Laps left to complete the race
+	RemainingLaps uint32 `protobuf:"varint,13,opt,name=remaining_laps,json=RemainingLaps,proto3" json:"remaining_laps,omitempty"`
+	// Fuel needed to finish the race at the average consumption
+	ProjectedFuelNeeded float32 `protobuf:"fixed32,14,opt,name=projected_fuel_needed,json=ProjectedFuelNeeded,proto3" json:"projected_fuel_needed,omitempty"`
+	// Fuel left at the finish at the average consumption, negative if short
+	ProjectedFuelDelta float32 `protobuf:"fixed32,15,opt,name=projected_fuel_delta,json=ProjectedFuelDelta,proto3" json:"projected_fuel_delta,omitempty"`
+}
+
+func (x *FuelLap) Reset() {
+	*x = FuelLap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FuelLap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelLap) ProtoMessage() {}
+
+func (x *FuelLap) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelLap.ProtoReflect.Descriptor instead.
+func (*FuelLap) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FuelLap) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *FuelLap) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *FuelLap) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FuelLap) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *FuelLap) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *FuelLap) GetPitLap() bool {
+	if x != nil {
+		return x.PitLap
+	}
+	return false
+}
+
+func (x *FuelLap) GetFuelUsed() float32 {
+	if x != nil {
+		return x.FuelUsed
+	}
+	return 0
+}
+
+func (x *FuelLap) GetFuelMixes() []*FuelMixUsage {
+	if x != nil {
+		return x.FuelMixes
+	}
+	return nil
+}
+
+func (x *FuelLap) GetFuelInTank() float32 {
+	if x != nil {
+		return x.FuelInTank
+	}
+	return 0
+}
+
+func (x *FuelLap) GetFuelCapacity() float32 {
+	if x != nil {
+		return x.FuelCapacity
+	}
+	return 0
+}
+
+func (x *FuelLap) GetFuelRemainingLaps() float32 {
+	if x != nil {
+		return x.FuelRemainingLaps
+	}
+	return 0
+}
+
+func (x *FuelLap) GetAverageFuelPerLap() float32 {
+	if x != nil {
+		return x.AverageFuelPerLap
+	}
+	return 0
+}
+
+func (x *FuelLap) GetRemainingLaps() uint32 {
+	if x != nil {
+		return x.RemainingLaps
+	}
+	return 0
+}
+
+func (x *FuelLap) GetProjectedFuelNeeded() float32 {
+	if x != nil {
+		return x.ProjectedFuelNeeded
+	}
+	return 0
+}
+
+func (x *FuelLap) GetProjectedFuelDelta() float32 {
+	if x != nil {
+		return x.ProjectedFuelDelta
+	}
+	return 0
+}
+
+// FuelStrategy sums up the fuel used by a car during a session
+// and recommends a starting fuel load for the next race at the same track.
+type FuelStrategy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// fuel_strategy
+	Type string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,4,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// -1 for unknown, 0-21 for tracks, see appendix
+	TrackId int32 `protobuf:"varint,5,opt,name=track_id,json=TrackID,proto3" json:"track_id,omitempty"`
+	// 0 = F1 Modern, 1 = F1 Classic, 2 = F2, 3 = F1 Generic
+	Formula uint32 `protobuf:"varint,6,opt,name=formula,json=Formula,proto3" json:"formula,omitempty"`
+	// See SessionData
+	SessionType uint32 `protobuf:"varint,7,opt,name=session_type,json=SessionType,proto3" json:"session_type,omitempty"`
+	// Fuel used by fuel mix during the session, pit laps excluded
+	FuelMixes []*FuelMixUsage `protobuf:"bytes,8,rep,name=fuel_mixes,json=FuelMixes,proto3" json:"fuel_mixes,omitempty"`
+	// Recommendation based on every session recorded at the track
+	//
+	// Number of sessions the recommendation is based on
+	SessionsRecorded int32 `protobuf:"varint,9,opt,name=sessions_recorded,json=SessionsRecorded,proto3" json:"sessions_recorded,omitempty"`
+	// Fuel used per lap with the standard mix
+	FuelPerLap float32 `protobuf:"fixed32,10,opt,name=fuel_per_lap,json=FuelPerLap,proto3" json:"fuel_per_lap,omitempty"`
+	// Number of laps of the race
+	RaceLaps uint32 `protobuf:"varint,11,opt,name=race_laps,json=RaceLaps,proto3" json:"race_laps,omitempty"`
+	// Fuel to start the race with, safety margin included
+	RecommendedFuelLoad float32 `protobuf:"fixed32,12,opt,name=recommended_fuel_load,json=RecommendedFuelLoad,proto3" json:"recommended_fuel_load,omitempty"`
+}
+
+func (x *FuelStrategy) Reset() {
+	*x = FuelStrategy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FuelStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelStrategy) ProtoMessage() {}
+
+func (x *FuelStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelStrategy.ProtoReflect.Descriptor instead.
+func (*FuelStrategy) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FuelStrategy) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *FuelStrategy) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *FuelStrategy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FuelStrategy) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *FuelStrategy) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *FuelStrategy) GetFormula() uint32 {
+	if x != nil {
+		return x.Formula
+	}
+	return 0
+}
+
+func (x *FuelStrategy) GetSessionType() uint32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *FuelStrategy) GetFuelMixes() []*FuelMixUsage {
+	if x != nil {
+		return x.FuelMixes
+	}
+	return nil
+}
+
+func (x *FuelStrategy) GetSessionsRecorded() int32 {
+	if x != nil {
+		return x.SessionsRecorded
+	}
+	return 0
+}
+
+func (x *FuelStrategy) GetFuelPerLap() float32 {
+	if x != nil {
+		return x.FuelPerLap
+	}
+	return 0
+}
+
+func (x *FuelStrategy) GetRaceLaps() uint32 {
+	if x != nil {
+		return x.RaceLaps
+	}
+	return 0
+}
+
+func (x *FuelStrategy) GetRecommendedFuelLoad() float32 {
+	if x != nil {
+		return x.RecommendedFuelLoad
+	}
+	return 0
+}
+
+// PitStop details a car going through the pit lane, from the pit lane entry to the pit lane exit.
+type PitStop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// pit_stop
+	Type string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,4,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// Lap the car entered the pit lane on
+	LapNum uint32 `protobuf:"varint,5,opt,name=lap_num,json=LapNum,proto3" json:"lap_num,omitempty"`
+	// Session time the car entered the pit lane at
+	PitLaneEntryTime float32 `protobuf:"fixed32,6,opt,name=pit_lane_entry_time,json=PitLaneEntryTime,proto3" json:"pit_lane_entry_time,omitempty"`
+	// Session time the car left the pit lane at
+	PitLaneExitTime float32 `protobuf:"fixed32,7,opt,name=pit_lane_exit_time,json=PitLaneExitTime,proto3" json:"pit_lane_exit_time,omitempty"`
+	// Time spent in the pit lane in seconds
+	PitLaneTime float32 `protobuf:"fixed32,8,opt,name=pit_lane_time,json=PitLaneTime,proto3" json:"pit_lane_time,omitempty"`
+	// Time spent stationary in the pit lane in seconds
+	StationaryTime float32 `protobuf:"fixed32,9,opt,name=stationary_time,json=StationaryTime,proto3" json:"stationary_time,omitempty"`
+	// Lap distance the car entered the pit lane at in metres
+	PitLaneEntryLapDistance float32 `protobuf:"fixed32,10,opt,name=pit_lane_entry_lap_distance,json=PitLaneEntryLapDistance,proto3" json:"pit_lane_entry_lap_distance,omitempty"`
+	// Lap distance the car left the pit lane at in metres
+	PitLaneExitLapDistance float32 `protobuf:"fixed32,11,opt,name=pit_lane_exit_lap_distance,json=PitLaneExitLapDistance,proto3" json:"pit_lane_exit_lap_distance,omitempty"`
+	// Tyres fitted when the car entered the pit lane
+	TyresBefore *PitStopTyres `protobuf:"bytes,12,opt,name=tyres_before,json=TyresBefore,proto3" json:"tyres_before,omitempty"`
+	// Tyres fitted when the car left the pit lane
+	TyresAfter   *PitStopTyres `protobuf:"bytes,13,opt,name=tyres_after,json=TyresAfter,proto3" json:"tyres_after,omitempty"`
+	TyresChanged bool          `protobuf:"varint,14,opt,name=tyres_changed,json=TyresChanged,proto3" json:"tyres_changed,omitempty"`
+	// Race position when the car entered the pit lane
+	PositionBefore uint32 `protobuf:"varint,15,opt,name=position_before,json=PositionBefore,proto3" json:"position_before,omitempty"`
+	// Race position when the car left the pit lane
+	PositionAfter uint32 `protobuf:"varint,16,opt,name=position_after,json=PositionAfter,proto3" json:"position_after,omitempty"`
+	// Positions gained during the stop, negative when lost
+	PositionsGained int32 `protobuf:"varint,17,opt,name=positions_gained,json=PositionsGained,proto3" json:"positions_gained,omitempty"`
+}
+
+func (x *PitStop) Reset() {
+	*x = PitStop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PitStop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PitStop) ProtoMessage() {}
+
+func (x *PitStop) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PitStop.ProtoReflect.Descriptor instead.
+func (*PitStop) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PitStop) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PitStop) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *PitStop) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PitStop) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *PitStop) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *PitStop) GetPitLaneEntryTime() float32 {
+	if x != nil {
+		return x.PitLaneEntryTime
+	}
+	return 0
+}
+
+func (x *PitStop) GetPitLaneExitTime() float32 {
+	if x != nil {
+		return x.PitLaneExitTime
+	}
+	return 0
+}
+
+func (x *PitStop) GetPitLaneTime() float32 {
+	if x != nil {
+		return x.PitLaneTime
+	}
+	return 0
+}
+
+func (x *PitStop) GetStationaryTime() float32 {
+	if x != nil {
+		return x.StationaryTime
+	}
+	return 0
+}
+
+func (x *PitStop) GetPitLaneEntryLapDistance() float32 {
+	if x != nil {
+		return x.PitLaneEntryLapDistance
+	}
+	return 0
+}
+
+func (x *PitStop) GetPitLaneExitLapDistance() float32 {
+	if x != nil {
+		return x.PitLaneExitLapDistance
+	}
+	return 0
+}
+
+func (x *PitStop) GetTyresBefore() *PitStopTyres {
+	if x != nil {
+		return x.TyresBefore
+	}
+	return nil
+}
+
+func (x *PitStop) GetTyresAfter() *PitStopTyres {
+	if x != nil {
+		return x.TyresAfter
+	}
+	return nil
+}
+
+func (x *PitStop) GetTyresChanged() bool {
+	if x != nil {
+		return x.TyresChanged
+	}
+	return false
+}
+
+func (x *PitStop) GetPositionBefore() uint32 {
+	if x != nil {
+		return x.PositionBefore
+	}
+	return 0
+}
+
+func (x *PitStop) GetPositionAfter() uint32 {
+	if x != nil {
+		return x.PositionAfter
+	}
+	return 0
+}
+
+func (x *PitStop) GetPositionsGained() int32 {
+	if x != nil {
+		return x.PositionsGained
+	}
+	return 0
+}
+
+// TrackMap is the centreline of a track, indexed by the distance from the start line.
+type TrackMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// track_map
+	Type string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	// -1 for unknown, 0-21 for tracks, see appendix
+	TrackId int32 `protobuf:"varint,4,opt,name=track_id,json=TrackID,proto3" json:"track_id,omitempty"`
+	// Track length in metres
+	TrackLength uint32 `protobuf:"varint,5,opt,name=track_length,json=TrackLength,proto3" json:"track_length,omitempty"`
+	// Distance between two points in metres
+	Step   float32          `protobuf:"fixed32,6,opt,name=step,json=Step,proto3" json:"step,omitempty"`
+	Points []*TrackMapPoint `protobuf:"bytes,7,rep,name=points,json=Points,proto3" json:"points,omitempty"`
+}
+
+func (x *TrackMap) Reset() {
+	*x = TrackMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackMap) ProtoMessage() {}
+
+func (x *TrackMap) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackMap.ProtoReflect.Descriptor instead.
+func (*TrackMap) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TrackMap) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TrackMap) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *TrackMap) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TrackMap) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *TrackMap) GetTrackLength() uint32 {
+	if x != nil {
+		return x.TrackLength
+	}
+	return 0
+}
+
+func (x *TrackMap) GetStep() float32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *TrackMap) GetPoints() []*TrackMapPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// CarPositions places every car of the session on the map of the track.
+type CarPositions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// car_positions
+	Type    string        `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	TrackId int32         `protobuf:"varint,4,opt,name=track_id,json=TrackID,proto3" json:"track_id,omitempty"`
+	Cars    []*CarOnTrack `protobuf:"bytes,5,rep,name=cars,json=Cars,proto3" json:"cars,omitempty"`
+}
+
+func (x *CarPositions) Reset() {
+	*x = CarPositions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarPositions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarPositions) ProtoMessage() {}
+
+func (x *CarPositions) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarPositions.ProtoReflect.Descriptor instead.
+func (*CarPositions) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CarPositions) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *CarPositions) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *CarPositions) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CarPositions) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *CarPositions) GetCars() []*CarOnTrack {
+	if x != nil {
+		return x.Cars
+	}
+	return nil
+}
+
+// TimingTower orders the cars of a session with their gap to the leader and to the car ahead.
+// During a race the gaps are the time between the cars on track, during other sessions they are computed on the best lap times.
+type TimingTower struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// timing_tower
+	Type string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	// -1 for unknown, 0-21 for tracks, see appendix
+	TrackId int32 `protobuf:"varint,4,opt,name=track_id,json=TrackID,proto3" json:"track_id,omitempty"`
+	// 0 = unknown, 1 = P1, 2 = P2, 3 = P3, 4 = Short P, 5 = Q1 ...
+	SessionType uint32 `protobuf:"varint,5,opt,name=session_type,json=SessionType,proto3" json:"session_type,omitempty"`
+	// Whether the gaps are the time between the cars on track
+	Race bool `protobuf:"varint,6,opt,name=race,json=Race,proto3" json:"race,omitempty"`
+	// Ordered by position
+	Cars []*TimingEntry `protobuf:"bytes,7,rep,name=cars,json=Cars,proto3" json:"cars,omitempty"`
+}
+
+func (x *TimingTower) Reset() {
+	*x = TimingTower{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimingTower) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimingTower) ProtoMessage() {}
+
+func (x *TimingTower) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimingTower.ProtoReflect.Descriptor instead.
+func (*TimingTower) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TimingTower) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TimingTower) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *TimingTower) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TimingTower) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *TimingTower) GetSessionType() uint32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *TimingTower) GetRace() bool {
+	if x != nil {
+		return x.Race
+	}
+	return false
+}
+
+func (x *TimingTower) GetCars() []*TimingEntry {
+	if x != nil {
+		return x.Cars
+	}
+	return nil
+}
+
+// PositionChange is a car taking the position of another car during a race.
+type PositionChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// position_change
+	Type string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	// overtake: the car passed the other car on track
+	// pit_cycle: one of the cars was in the pit lane
+	// penalty: the other car got a time penalty
+	// retirement: the other car retired, was disqualified or not classified
+	// other: the cars swapped positions without passing each other on track
+	Kind string `protobuf:"bytes,4,opt,name=kind,json=Kind,proto3" json:"kind,omitempty"`
+	// Index of the car gaining the position
+	CarIndex uint32 `protobuf:"varint,5,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// Index of the car losing the position
+	OtherCarIndex uint32 `protobuf:"varint,6,opt,name=other_car_index,json=OtherCarIndex,proto3" json:"other_car_index,omitempty"`
+	// Race position of the car after the change
+	Position uint32 `protobuf:"varint,7,opt,name=position,json=Position,proto3" json:"position,omitempty"`
+	// Race position of the car before the change
+	PreviousPosition uint32 `protobuf:"varint,8,opt,name=previous_position,json=PreviousPosition,proto3" json:"previous_position,omitempty"`
+	// Race position of the other car after the change
+	OtherPosition uint32 `protobuf:"varint,9,opt,name=other_position,json=OtherPosition,proto3" json:"other_position,omitempty"`
+	// Session time the change happened at
+	SessionTime float32 `protobuf:"fixed32,10,opt,name=session_time,json=SessionTime,proto3" json:"session_time,omitempty"`
+	// Lap number of the car gaining the position
+	LapNum uint32 `protobuf:"varint,11,opt,name=lap_num,json=LapNum,proto3" json:"lap_num,omitempty"`
+	// Lap distance of the car gaining the position in metres
+	LapDistance float32 `protobuf:"fixed32,12,opt,name=lap_distance,json=LapDistance,proto3" json:"lap_distance,omitempty"`
+	// Number of the corner the car was going through or exiting, 0 if none or unknown
+	Corner int32 `protobuf:"varint,13,opt,name=corner,json=Corner,proto3" json:"corner,omitempty"`
+	// World space X position of the car gaining the position
+	WorldPositionX float32 `protobuf:"fixed32,14,opt,name=world_position_x,json=WorldPositionX,proto3" json:"world_position_x,omitempty"`
+	// World space Z position of the car gaining the position
+	WorldPositionZ float32 `protobuf:"fixed32,15,opt,name=world_position_z,json=WorldPositionZ,proto3" json:"world_position_z,omitempty"`
+	// Whether the DRS of the car was open
+	Drs bool `protobuf:"varint,16,opt,name=drs,json=Drs,proto3" json:"drs,omitempty"`
+	// Whether the DRS of the other car was open
+	OtherDrs bool `protobuf:"varint,17,opt,name=other_drs,json=OtherDrs,proto3" json:"other_drs,omitempty"`
+	// Whether the car was in the pit lane
+	InPit bool `protobuf:"varint,18,opt,name=in_pit,json=InPit,proto3" json:"in_pit,omitempty"`
+	// Whether the other car was in the pit lane
+	OtherInPit bool `protobuf:"varint,19,opt,name=other_in_pit,json=OtherInPit,proto3" json:"other_in_pit,omitempty"`
+	// Accumulated time penalties of the other car in seconds
+	OtherPenalties uint32 `protobuf:"varint,20,opt,name=other_penalties,json=OtherPenalties,proto3" json:"other_penalties,omitempty"`
+}
+
+func (x *PositionChange) Reset() {
+	*x = PositionChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PositionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionChange) ProtoMessage() {}
+
+func (x *PositionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionChange.ProtoReflect.Descriptor instead.
+func (*PositionChange) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PositionChange) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PositionChange) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *PositionChange) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PositionChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PositionChange) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *PositionChange) GetOtherCarIndex() uint32 {
+	if x != nil {
+		return x.OtherCarIndex
+	}
+	return 0
+}
+
+func (x *PositionChange) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PositionChange) GetPreviousPosition() uint32 {
+	if x != nil {
+		return x.PreviousPosition
+	}
+	return 0
+}
+
+func (x *PositionChange) GetOtherPosition() uint32 {
+	if x != nil {
+		return x.OtherPosition
+	}
+	return 0
+}
+
+func (x *PositionChange) GetSessionTime() float32 {
+	if x != nil {
+		return x.SessionTime
+	}
+	return 0
+}
+
+func (x *PositionChange) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *PositionChange) GetLapDistance() float32 {
+	if x != nil {
+		return x.LapDistance
+	}
+	return 0
+}
+
+func (x *PositionChange) GetCorner() int32 {
+	if x != nil {
+		return x.Corner
+	}
+	return 0
+}
+
+func (x *PositionChange) GetWorldPositionX() float32 {
+	if x != nil {
+		return x.WorldPositionX
+	}
+	return 0
+}
+
+func (x *PositionChange) GetWorldPositionZ() float32 {
+	if x != nil {
+		return x.WorldPositionZ
+	}
+	return 0
+}
+
+func (x *PositionChange) GetDrs() bool {
+	if x != nil {
+		return x.Drs
+	}
+	return false
+}
+
+func (x *PositionChange) GetOtherDrs() bool {
+	if x != nil {
+		return x.OtherDrs
+	}
+	return false
+}
+
+func (x *PositionChange) GetInPit() bool {
+	if x != nil {
+		return x.InPit
+	}
+	return false
+}
+
+func (x *PositionChange) GetOtherInPit() bool {
+	if x != nil {
+		return x.OtherInPit
+	}
+	return false
+}
+
+func (x *PositionChange) GetOtherPenalties() uint32 {
+	if x != nil {
+		return x.OtherPenalties
+	}
+	return 0
+}
+
+// Incident is a spin, an excursion off track or a likely contact of a car.
+type Incident struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// incident
+	Type string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	// spin, off_track or contact
+	Kind string `protobuf:"bytes,4,opt,name=kind,json=Kind,proto3" json:"kind,omitempty"`
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,5,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// Index of the closest car for a contact, -1 if none
+	OtherCarIndex int32 `protobuf:"varint,6,opt,name=other_car_index,json=OtherCarIndex,proto3" json:"other_car_index,omitempty"`
+	// Lap number
+	LapNum uint32 `protobuf:"varint,7,opt,name=lap_num,json=LapNum,proto3" json:"lap_num,omitempty"`
+	// Lap distance the incident started at in metres
+	LapDistance float32 `protobuf:"fixed32,8,opt,name=lap_distance,json=LapDistance,proto3" json:"lap_distance,omitempty"`
+	// Session time the incident started at
+	SessionTime float32 `protobuf:"fixed32,9,opt,name=session_time,json=SessionTime,proto3" json:"session_time,omitempty"`
+	// Duration of the incident in seconds
+	Duration float32 `protobuf:"fixed32,10,opt,name=duration,json=Duration,proto3" json:"duration,omitempty"`
+	// Speed when the incident started in kilometres per hour
+	Speed float32 `protobuf:"fixed32,11,opt,name=speed,json=Speed,proto3" json:"speed,omitempty"`
+	// Highest yaw rate during the incident in radians per second
+	MaxYawRate float32 `protobuf:"fixed32,12,opt,name=max_yaw_rate,json=MaxYawRate,proto3" json:"max_yaw_rate,omitempty"`
+	// Highest angle between the heading and the direction of travel in degrees
+	MaxSlipAngle float32 `protobuf:"fixed32,13,opt,name=max_slip_angle,json=MaxSlipAngle,proto3" json:"max_slip_angle,omitempty"`
+	// Surfaces the car went on during an excursion off track
+	Surfaces []string `protobuf:"bytes,14,rep,name=surfaces,json=Surfaces,proto3" json:"surfaces,omitempty"`
+	// Wing damage (percentage) after a contact, and the highest increase of the three
+	FrontLeftWingDamage  uint32 `protobuf:"varint,15,opt,name=front_left_wing_damage,json=FrontLeftWingDamage,proto3" json:"front_left_wing_damage,omitempty"`
+	FrontRightWingDamage uint32 `protobuf:"varint,16,opt,name=front_right_wing_damage,json=FrontRightWingDamage,proto3" json:"front_right_wing_damage,omitempty"`
+	RearWingDamage       uint32 `protobuf:"varint,17,opt,name=rear_wing_damage,json=RearWingDamage,proto3" json:"rear_wing_damage,omitempty"`
+	DamageIncrease       uint32 `protobuf:"varint,18,opt,name=damage_increase,json=DamageIncrease,proto3" json:"damage_increase,omitempty"`
+	// Telemetry from a few seconds before the incident to its end
+	Snippet []*IncidentSample `protobuf:"bytes,19,rep,name=snippet,json=Snippet,proto3" json:"snippet,omitempty"`
+}
+
+func (x *Incident) Reset() {
+	*x = Incident{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Incident) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Incident) ProtoMessage() {}
+
+func (x *Incident) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Incident.ProtoReflect.Descriptor instead.
+func (*Incident) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Incident) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Incident) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Incident) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Incident) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Incident) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *Incident) GetOtherCarIndex() int32 {
+	if x != nil {
+		return x.OtherCarIndex
+	}
+	return 0
+}
+
+func (x *Incident) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *Incident) GetLapDistance() float32 {
+	if x != nil {
+		return x.LapDistance
+	}
+	return 0
+}
+
+func (x *Incident) GetSessionTime() float32 {
+	if x != nil {
+		return x.SessionTime
+	}
+	return 0
+}
+
+func (x *Incident) GetDuration() float32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Incident) GetSpeed() float32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *Incident) GetMaxYawRate() float32 {
+	if x != nil {
+		return x.MaxYawRate
+	}
+	return 0
+}
+
+func (x *Incident) GetMaxSlipAngle() float32 {
+	if x != nil {
+		return x.MaxSlipAngle
+	}
+	return 0
+}
+
+func (x *Incident) GetSurfaces() []string {
+	if x != nil {
+		return x.Surfaces
+	}
+	return nil
+}
+
+func (x *Incident) GetFrontLeftWingDamage() uint32 {
+	if x != nil {
+		return x.FrontLeftWingDamage
+	}
+	return 0
+}
+
+func (x *Incident) GetFrontRightWingDamage() uint32 {
+	if x != nil {
+		return x.FrontRightWingDamage
+	}
+	return 0
+}
+
+func (x *Incident) GetRearWingDamage() uint32 {
+	if x != nil {
+		return x.RearWingDamage
+	}
+	return 0
+}
+
+func (x *Incident) GetDamageIncrease() uint32 {
+	if x != nil {
+		return x.DamageIncrease
+	}
+	return 0
+}
+
+func (x *Incident) GetSnippet() []*IncidentSample {
+	if x != nil {
+		return x.Snippet
+	}
+	return nil
+}
+
+// SessionSummary sums up a session once it is over.
+type SessionSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// session_summary
+	Type            string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	TrackId         int32  `protobuf:"varint,4,opt,name=track_id,json=TrackID,proto3" json:"track_id,omitempty"`
+	TrackName       string `protobuf:"bytes,5,opt,name=track_name,json=TrackName,proto3" json:"track_name,omitempty"`
+	SessionType     uint32 `protobuf:"varint,6,opt,name=session_type,json=SessionType,proto3" json:"session_type,omitempty"`
+	SessionTypeName string `protobuf:"bytes,7,opt,name=session_type_name,json=SessionTypeName,proto3" json:"session_type_name,omitempty"`
+	// 0 = F1 Modern, 1 = F1 Classic, 2 = F2, 3 = F1 Generic
+	Formula uint32 `protobuf:"varint,8,opt,name=formula,json=Formula,proto3" json:"formula,omitempty"`
+	// Total number of laps of the race
+	TotalLaps uint32 `protobuf:"varint,9,opt,name=total_laps,json=TotalLaps,proto3" json:"total_laps,omitempty"`
+	// Whether the results come from the final classification
+	Final bool `protobuf:"varint,10,opt,name=final,json=Final,proto3" json:"final,omitempty"`
+	// Weather evolution during the session
+	Weather []*WeatherSample `protobuf:"bytes,11,rep,name=weather,json=Weather,proto3" json:"weather,omitempty"`
+	// Ordered by position
+	Drivers []*DriverResult `protobuf:"bytes,12,rep,name=drivers,json=Drivers,proto3" json:"drivers,omitempty"`
+}
+
+func (x *SessionSummary) Reset() {
+	*x = SessionSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionSummary) ProtoMessage() {}
+
+func (x *SessionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionSummary.ProtoReflect.Descriptor instead.
+func (*SessionSummary) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SessionSummary) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *SessionSummary) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *SessionSummary) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetSessionType() uint32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetSessionTypeName() string {
+	if x != nil {
+		return x.SessionTypeName
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetFormula() uint32 {
+	if x != nil {
+		return x.Formula
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetTotalLaps() uint32 {
+	if x != nil {
+		return x.TotalLaps
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+func (x *SessionSummary) GetWeather() []*WeatherSample {
+	if x != nil {
+		return x.Weather
+	}
+	return nil
+}
+
+func (x *SessionSummary) GetDrivers() []*DriverResult {
+	if x != nil {
+		return x.Drivers
+	}
+	return nil
+}
+
+// PersonalBest is a lap improving the personal best lap or sector times of a driver
+// on a track, for a formula and a session type, across sessions.
+type PersonalBest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// personal_best
+	Type   string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	Driver string `protobuf:"bytes,4,opt,name=driver,json=Driver,proto3" json:"driver,omitempty"`
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,5,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// Lap number
+	LapNum    uint32 `protobuf:"varint,6,opt,name=lap_num,json=LapNum,proto3" json:"lap_num,omitempty"`
+	TrackId   int32  `protobuf:"varint,7,opt,name=track_id,json=TrackID,proto3" json:"track_id,omitempty"`
+	TrackName string `protobuf:"bytes,8,opt,name=track_name,json=TrackName,proto3" json:"track_name,omitempty"`
+	// 0 = F1 Modern, 1 = F1 Classic, 2 = F2, 3 = F1 Generic
+	Formula         uint32 `protobuf:"varint,9,opt,name=formula,json=Formula,proto3" json:"formula,omitempty"`
+	SessionType     uint32 `protobuf:"varint,10,opt,name=session_type,json=SessionType,proto3" json:"session_type,omitempty"`
+	SessionTypeName string `protobuf:"bytes,11,opt,name=session_type_name,json=SessionTypeName,proto3" json:"session_type_name,omitempty"`
+	// Lap time in seconds
+	LapTime float32 `protobuf:"fixed32,12,opt,name=lap_time,json=LapTime,proto3" json:"lap_time,omitempty"`
+	// Sector times in seconds, 0 when not known
+	Sectors []float32 `protobuf:"fixed32,13,rep,packed,name=sectors,json=Sectors,proto3" json:"sectors,omitempty"`
+	// Whether the lap is a new personal best
+	LapImproved bool `protobuf:"varint,14,opt,name=lap_improved,json=LapImproved,proto3" json:"lap_improved,omitempty"`
+	// Whether each sector is a new personal best
+	SectorsImproved []bool `protobuf:"varint,15,rep,packed,name=sectors_improved,json=SectorsImproved,proto3" json:"sectors_improved,omitempty"`
+	// Personal best lap time before the lap in seconds, 0 if none
+	PreviousLapTime float32 `protobuf:"fixed32,16,opt,name=previous_lap_time,json=PreviousLapTime,proto3" json:"previous_lap_time,omitempty"`
+	// Time in seconds gained on the previous personal best, 0 if none
+	Improvement float32 `protobuf:"fixed32,17,opt,name=improvement,json=Improvement,proto3" json:"improvement,omitempty"`
+	// Personal best lap time after the lap in seconds
+	PersonalBestLapTime float32 `protobuf:"fixed32,18,opt,name=personal_best_lap_time,json=PersonalBestLapTime,proto3" json:"personal_best_lap_time,omitempty"`
+	// Personal best time in every sector after the lap in seconds
+	BestSectors []float32 `protobuf:"fixed32,19,rep,packed,name=best_sectors,json=BestSectors,proto3" json:"best_sectors,omitempty"`
+	// Sum of the personal best sectors in seconds, 0 when a sector is not known
+	TheoreticalBest float32 `protobuf:"fixed32,20,opt,name=theoretical_best,json=TheoreticalBest,proto3" json:"theoretical_best,omitempty"`
+	// Position of the driver in the leaderboard of the track after the lap
+	LeaderboardPosition int32 `protobuf:"varint,21,opt,name=leaderboard_position,json=LeaderboardPosition,proto3" json:"leaderboard_position,omitempty"`
+}
+
+func (x *PersonalBest) Reset() {
+	*x = PersonalBest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonalBest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalBest) ProtoMessage() {}
+
+func (x *PersonalBest) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalBest.ProtoReflect.Descriptor instead.
+func (*PersonalBest) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PersonalBest) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PersonalBest) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *PersonalBest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PersonalBest) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *PersonalBest) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *PersonalBest) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *PersonalBest) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *PersonalBest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *PersonalBest) GetFormula() uint32 {
+	if x != nil {
+		return x.Formula
+	}
+	return 0
+}
+
+func (x *PersonalBest) GetSessionType() uint32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *PersonalBest) GetSessionTypeName() string {
+	if x != nil {
+		return x.SessionTypeName
+	}
+	return ""
+}
+
+func (x *PersonalBest) GetLapTime() float32 {
+	if x != nil {
+		return x.LapTime
+	}
+	return 0
+}
+
+func (x *PersonalBest) GetSectors() []float32 {
+	if x != nil {
+		return x.Sectors
+	}
+	return nil
+}
+
+func (x *PersonalBest) GetLapImproved() bool {
+	if x != nil {
+		return x.LapImproved
+	}
+	return false
+}
+
+func (x *PersonalBest) GetSectorsImproved() []bool {
+	if x != nil {
+		return x.SectorsImproved
+	}
+	return nil
+}
+
+func (x *PersonalBest) GetPreviousLapTime() float32 {
+	if x != nil {
+		return x.PreviousLapTime
+	}
+	return 0
+}
+
+func (x *PersonalBest) GetImprovement() float32 {
+	if x != nil {
+		return x.Improvement
+	}
+	return 0
+}
+
+func (x *PersonalBest) GetPersonalBestLapTime() float32 {
+	if x != nil {
+		return x.PersonalBestLapTime
+	}
+	return 0
+}
+
+func (x *PersonalBest) GetBestSectors() []float32 {
+	if x != nil {
+		return x.BestSectors
+	}
+	return nil
+}
+
+func (x *PersonalBest) GetTheoreticalBest() float32 {
+	if x != nil {
+		return x.TheoreticalBest
+	}
+	return 0
+}
+
+func (x *PersonalBest) GetLeaderboardPosition() int32 {
+	if x != nil {
+		return x.LeaderboardPosition
+	}
+	return 0
+}
+
+// Corners lists the corners detected on a track.
+type Corners struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// corners
+	Type    string    `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	TrackId int32     `protobuf:"varint,4,opt,name=track_id,json=TrackID,proto3" json:"track_id,omitempty"`
+	Corners []*Corner `protobuf:"bytes,5,rep,name=corners,json=Corners,proto3" json:"corners,omitempty"`
+}
+
+func (x *Corners) Reset() {
+	*x = Corners{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Corners) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Corners) ProtoMessage() {}
+
+func (x *Corners) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Corners.ProtoReflect.Descriptor instead.
+func (*Corners) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Corners) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Corners) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Corners) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Corners) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *Corners) GetCorners() []*Corner {
+	if x != nil {
+		return x.Corners
+	}
+	return nil
+}
+
+// CornerLap details how a car went through every corner of a lap.
+type CornerLap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// corner_lap
+	Type    string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	TrackId int32  `protobuf:"varint,4,opt,name=track_id,json=TrackID,proto3" json:"track_id,omitempty"`
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,5,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// Lap number
+	LapNum uint32 `protobuf:"varint,6,opt,name=lap_num,json=LapNum,proto3" json:"lap_num,omitempty"`
+	// Lap time in seconds
+	LapTime float32 `protobuf:"fixed32,7,opt,name=lap_time,json=LapTime,proto3" json:"lap_time,omitempty"`
+	// Whether the lap was not invalidated
+	Valid bool `protobuf:"varint,8,opt,name=valid,json=Valid,proto3" json:"valid,omitempty"`
+	// Whether the car went through the pit lane during the lap
+	PitLap  bool            `protobuf:"varint,9,opt,name=pit_lap,json=PitLap,proto3" json:"pit_lap,omitempty"`
+	Corners []*CornerReport `protobuf:"bytes,10,rep,name=corners,json=Corners,proto3" json:"corners,omitempty"`
+}
+
+func (x *CornerLap) Reset() {
+	*x = CornerLap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CornerLap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CornerLap) ProtoMessage() {}
+
+func (x *CornerLap) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CornerLap.ProtoReflect.Descriptor instead.
+func (*CornerLap) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CornerLap) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *CornerLap) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *CornerLap) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CornerLap) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *CornerLap) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *CornerLap) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *CornerLap) GetLapTime() float32 {
+	if x != nil {
+		return x.LapTime
+	}
+	return 0
+}
+
+func (x *CornerLap) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *CornerLap) GetPitLap() bool {
+	if x != nil {
+		return x.PitLap
+	}
+	return false
+}
+
+func (x *CornerLap) GetCorners() []*CornerReport {
+	if x != nil {
+		return x.Corners
+	}
+	return nil
+}
+
+// BrakingEvent details a braking of a car, from the moment the brake is applied to its release.
+type BrakingEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// braking_event
+	Type string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,4,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// Lap number
+	LapNum uint32 `protobuf:"varint,5,opt,name=lap_num,json=LapNum,proto3" json:"lap_num,omitempty"`
+	// Braking event number in the lap, starting at 1
+	EventNumber int32 `protobuf:"varint,6,opt,name=event_number,json=EventNumber,proto3" json:"event_number,omitempty"`
+	// Distance the brake was applied at, in metres from the start line
+	StartDistance float32 `protobuf:"fixed32,7,opt,name=start_distance,json=StartDistance,proto3" json:"start_distance,omitempty"`
+	// Distance the brake was released at, in metres from the start line
+	EndDistance float32 `protobuf:"fixed32,8,opt,name=end_distance,json=EndDistance,proto3" json:"end_distance,omitempty"`
+	// Session time the brake was applied at
+	StartSessionTime float32 `protobuf:"fixed32,9,opt,name=start_session_time,json=StartSessionTime,proto3" json:"start_session_time,omitempty"`
+	// Time the brake was applied for in seconds
+	Duration float32 `protobuf:"fixed32,10,opt,name=duration,json=Duration,proto3" json:"duration,omitempty"`
+	// Speed when the brake was applied in kilometres per hour
+	StartSpeed float32 `protobuf:"fixed32,11,opt,name=start_speed,json=StartSpeed,proto3" json:"start_speed,omitempty"`
+	// Speed when the brake was released in kilometres per hour
+	EndSpeed float32 `protobuf:"fixed32,12,opt,name=end_speed,json=EndSpeed,proto3" json:"end_speed,omitempty"`
+	// Highest amount of brake applied (0.0 to 1.0)
+	PeakPressure float32 `protobuf:"fixed32,13,opt,name=peak_pressure,json=PeakPressure,proto3" json:"peak_pressure,omitempty"`
+	// Average deceleration in G
+	Deceleration float32 `protobuf:"fixed32,14,opt,name=deceleration,json=Deceleration,proto3" json:"deceleration,omitempty"`
+	// Highest longitudinal G-Force
+	PeakDeceleration float32 `protobuf:"fixed32,15,opt,name=peak_deceleration,json=PeakDeceleration,proto3" json:"peak_deceleration,omitempty"`
+	// Highest brakes temperature during the braking (celsius)
+	RearLeftBrakesTemperature   uint32 `protobuf:"varint,16,opt,name=rear_left_brakes_temperature,json=RearLeftBrakesTemperature,proto3" json:"rear_left_brakes_temperature,omitempty"`
+	RearRightBrakesTemperature  uint32 `protobuf:"varint,17,opt,name=rear_right_brakes_temperature,json=RearRightBrakesTemperature,proto3" json:"rear_right_brakes_temperature,omitempty"`
+	FrontLeftBrakesTemperature  uint32 `protobuf:"varint,18,opt,name=front_left_brakes_temperature,json=FrontLeftBrakesTemperature,proto3" json:"front_left_brakes_temperature,omitempty"`
+	FrontRightBrakesTemperature uint32 `protobuf:"varint,19,opt,name=front_right_brakes_temperature,json=FrontRightBrakesTemperature,proto3" json:"front_right_brakes_temperature,omitempty"`
+	// Lock-ups are only detected for the player cars
+	LockUp bool `protobuf:"varint,20,opt,name=lock_up,json=LockUp,proto3" json:"lock_up,omitempty"`
+	// Wheels whose slip went past the threshold: RL, RR, FL, FR
+	LockedWheels []string `protobuf:"bytes,21,rep,name=locked_wheels,json=LockedWheels,proto3" json:"locked_wheels,omitempty"`
+	// Time at least one wheel was locked in seconds
+	LockUpDuration float32 `protobuf:"fixed32,22,opt,name=lock_up_duration,json=LockUpDuration,proto3" json:"lock_up_duration,omitempty"`
+}
+
+func (x *BrakingEvent) Reset() {
+	*x = BrakingEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrakingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrakingEvent) ProtoMessage() {}
+
+func (x *BrakingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrakingEvent.ProtoReflect.Descriptor instead.
+func (*BrakingEvent) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BrakingEvent) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *BrakingEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *BrakingEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BrakingEvent) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetEventNumber() int32 {
+	if x != nil {
+		return x.EventNumber
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetStartDistance() float32 {
+	if x != nil {
+		return x.StartDistance
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetEndDistance() float32 {
+	if x != nil {
+		return x.EndDistance
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetStartSessionTime() float32 {
+	if x != nil {
+		return x.StartSessionTime
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetDuration() float32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetStartSpeed() float32 {
+	if x != nil {
+		return x.StartSpeed
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetEndSpeed() float32 {
+	if x != nil {
+		return x.EndSpeed
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetPeakPressure() float32 {
+	if x != nil {
+		return x.PeakPressure
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetDeceleration() float32 {
+	if x != nil {
+		return x.Deceleration
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetPeakDeceleration() float32 {
+	if x != nil {
+		return x.PeakDeceleration
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetRearLeftBrakesTemperature() uint32 {
+	if x != nil {
+		return x.RearLeftBrakesTemperature
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetRearRightBrakesTemperature() uint32 {
+	if x != nil {
+		return x.RearRightBrakesTemperature
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetFrontLeftBrakesTemperature() uint32 {
+	if x != nil {
+		return x.FrontLeftBrakesTemperature
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetFrontRightBrakesTemperature() uint32 {
+	if x != nil {
+		return x.FrontRightBrakesTemperature
+	}
+	return 0
+}
+
+func (x *BrakingEvent) GetLockUp() bool {
+	if x != nil {
+		return x.LockUp
+	}
+	return false
+}
+
+func (x *BrakingEvent) GetLockedWheels() []string {
+	if x != nil {
+		return x.LockedWheels
+	}
+	return nil
+}
+
+func (x *BrakingEvent) GetLockUpDuration() float32 {
+	if x != nil {
+		return x.LockUpDuration
+	}
+	return 0
+}
+
+// BrakingLap sums up the braking events of a car during a lap.
+type BrakingLap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// braking_lap
+	Type string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,4,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// Lap number
+	LapNum uint32 `protobuf:"varint,5,opt,name=lap_num,json=LapNum,proto3" json:"lap_num,omitempty"`
+	// Lap time in seconds
+	LapTime float32 `protobuf:"fixed32,6,opt,name=lap_time,json=LapTime,proto3" json:"lap_time,omitempty"`
+	// Whether the lap was not invalidated
+	Valid bool `protobuf:"varint,7,opt,name=valid,json=Valid,proto3" json:"valid,omitempty"`
+	// Number of braking events
+	Events int32 `protobuf:"varint,8,opt,name=events,json=Events,proto3" json:"events,omitempty"`
+	// Number of braking events with a lock-up
+	LockUps int32 `protobuf:"varint,9,opt,name=lock_ups,json=LockUps,proto3" json:"lock_ups,omitempty"`
+	// Time spent braking in seconds
+	TotalBrakingTime float32 `protobuf:"fixed32,10,opt,name=total_braking_time,json=TotalBrakingTime,proto3" json:"total_braking_time,omitempty"`
+	// Average of the highest amount of brake applied per event
+	AveragePeakPressure float32 `protobuf:"fixed32,11,opt,name=average_peak_pressure,json=AveragePeakPressure,proto3" json:"average_peak_pressure,omitempty"`
+	// Average deceleration in G
+	AverageDeceleration float32 `protobuf:"fixed32,12,opt,name=average_deceleration,json=AverageDeceleration,proto3" json:"average_deceleration,omitempty"`
+	// Average distance in metres between the braking points of the lap and the ones of the previous lap.
+	// It is a measure of the consistency of the braking, 0 when there is no previous lap to compare to.
+	BrakingPointVariation float32 `protobuf:"fixed32,13,opt,name=braking_point_variation,json=BrakingPointVariation,proto3" json:"braking_point_variation,omitempty"`
+}
+
+func (x *BrakingLap) Reset() {
+	*x = BrakingLap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrakingLap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrakingLap) ProtoMessage() {}
+
+func (x *BrakingLap) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrakingLap.ProtoReflect.Descriptor instead.
+func (*BrakingLap) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BrakingLap) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *BrakingLap) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *BrakingLap) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BrakingLap) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *BrakingLap) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *BrakingLap) GetLapTime() float32 {
+	if x != nil {
+		return x.LapTime
+	}
+	return 0
+}
+
+func (x *BrakingLap) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *BrakingLap) GetEvents() int32 {
+	if x != nil {
+		return x.Events
+	}
+	return 0
+}
+
+func (x *BrakingLap) GetLockUps() int32 {
+	if x != nil {
+		return x.LockUps
+	}
+	return 0
+}
+
+func (x *BrakingLap) GetTotalBrakingTime() float32 {
+	if x != nil {
+		return x.TotalBrakingTime
+	}
+	return 0
+}
+
+func (x *BrakingLap) GetAveragePeakPressure() float32 {
+	if x != nil {
+		return x.AveragePeakPressure
+	}
+	return 0
+}
+
+func (x *BrakingLap) GetAverageDeceleration() float32 {
+	if x != nil {
+		return x.AverageDeceleration
+	}
+	return 0
+}
+
+func (x *BrakingLap) GetBrakingPointVariation() float32 {
+	if x != nil {
+		return x.BrakingPointVariation
+	}
+	return 0
+}
+
+// WheelspinEvent details a loss of traction of the rear wheels under throttle.
+type WheelspinEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// wheelspin_event
+	Type string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,4,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// Lap number
+	LapNum uint32 `protobuf:"varint,5,opt,name=lap_num,json=LapNum,proto3" json:"lap_num,omitempty"`
+	// Wheelspin event number in the lap, starting at 1
+	EventNumber int32 `protobuf:"varint,6,opt,name=event_number,json=EventNumber,proto3" json:"event_number,omitempty"`
+	// Distance the wheels started spinning at, in metres from the start line
+	StartDistance float32 `protobuf:"fixed32,7,opt,name=start_distance,json=StartDistance,proto3" json:"start_distance,omitempty"`
+	// Distance the wheels stopped spinning at, in metres from the start line
+	EndDistance float32 `protobuf:"fixed32,8,opt,name=end_distance,json=EndDistance,proto3" json:"end_distance,omitempty"`
+	// Session time the wheels started spinning at
+	StartSessionTime float32 `protobuf:"fixed32,9,opt,name=start_session_time,json=StartSessionTime,proto3" json:"start_session_time,omitempty"`
+	// Time the wheels spun for in seconds
+	Duration float32 `protobuf:"fixed32,10,opt,name=duration,json=Duration,proto3" json:"duration,omitempty"`
+	// Highest rear wheel slip ratio
+	PeakSlip float32 `protobuf:"fixed32,11,opt,name=peak_slip,json=PeakSlip,proto3" json:"peak_slip,omitempty"`
+	// Average rear wheel slip ratio
+	AverageSlip float32 `protobuf:"fixed32,12,opt,name=average_slip,json=AverageSlip,proto3" json:"average_slip,omitempty"`
+	// Average amount of throttle applied (0.0 to 1.0)
+	AverageThrottle float32 `protobuf:"fixed32,13,opt,name=average_throttle,json=AverageThrottle,proto3" json:"average_throttle,omitempty"`
+	// Speed when the wheels started spinning in kilometres per hour
+	Speed float32 `protobuf:"fixed32,14,opt,name=speed,json=Speed,proto3" json:"speed,omitempty"`
+	// Gear selected when the wheels started spinning
+	Gear int32 `protobuf:"varint,15,opt,name=gear,json=Gear,proto3" json:"gear,omitempty"`
+	// Number of the corner the car was going through or exiting, 0 if none
+	Corner int32 `protobuf:"varint,16,opt,name=corner,json=Corner,proto3" json:"corner,omitempty"`
+	// 0 (off) - 2 (high)
+	TractionControl uint32 `protobuf:"varint,17,opt,name=traction_control,json=TractionControl,proto3" json:"traction_control,omitempty"`
+}
+
+func (x *WheelspinEvent) Reset() {
+	*x = WheelspinEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WheelspinEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WheelspinEvent) ProtoMessage() {}
+
+func (x *WheelspinEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WheelspinEvent.ProtoReflect.Descriptor instead.
+func (*WheelspinEvent) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WheelspinEvent) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *WheelspinEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *WheelspinEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WheelspinEvent) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *WheelspinEvent) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *WheelspinEvent) GetEventNumber() int32 {
+	if x != nil {
+		return x.EventNumber
+	}
+	return 0
+}
+
+func (x *WheelspinEvent) GetStartDistance() float32 {
+	if x != nil {
+		return x.StartDistance
+	}
+	return 0
+}
+
+func (x *WheelspinEvent) GetEndDistance() float32 {
+	if x != nil {
+		return x.EndDistance
+	}
+	return 0
+}
+
+func (x *WheelspinEvent) GetStartSessionTime() float32 {
+	if x != nil {
+		return x.StartSessionTime
+	}
+	return 0
+}
+
+func (x *WheelspinEvent) GetDuration() float32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *WheelspinEvent) GetPeakSlip() float32 {
+	if x != nil {
+		return x.PeakSlip
+	}
+	return 0
+}
+
+func (x *WheelspinEvent) GetAverageSlip() float32 {
+	if x != nil {
+		return x.AverageSlip
+	}
+	return 0
+}
+
+func (x *WheelspinEvent) GetAverageThrottle() float32 {
+	if x != nil {
+		return x.AverageThrottle
+	}
+	return 0
+}
+
+func (x *WheelspinEvent) GetSpeed() float32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *WheelspinEvent) GetGear() int32 {
+	if x != nil {
+		return x.Gear
+	}
+	return 0
+}
+
+func (x *WheelspinEvent) GetCorner() int32 {
+	if x != nil {
+		return x.Corner
+	}
+	return 0
+}
+
+func (x *WheelspinEvent) GetTractionControl() uint32 {
+	if x != nil {
+		return x.TractionControl
+	}
+	return 0
+}
+
+// TractionLap sums up the wheelspin events of a car during a lap.
+type TractionLap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// traction_lap
+	Type string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,4,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// Lap number
+	LapNum uint32 `protobuf:"varint,5,opt,name=lap_num,json=LapNum,proto3" json:"lap_num,omitempty"`
+	// Lap time in seconds
+	LapTime float32 `protobuf:"fixed32,6,opt,name=lap_time,json=LapTime,proto3" json:"lap_time,omitempty"`
+	// Whether the lap was not invalidated
+	Valid bool `protobuf:"varint,7,opt,name=valid,json=Valid,proto3" json:"valid,omitempty"`
+	// Traction control setting used for most of the lap, 0 (off) - 2 (high)
+	TractionControl uint32 `protobuf:"varint,8,opt,name=traction_control,json=TractionControl,proto3" json:"traction_control,omitempty"`
+	// Number of wheelspin events
+	Events int32 `protobuf:"varint,9,opt,name=events,json=Events,proto3" json:"events,omitempty"`
+	// Time spent spinning the rear wheels in seconds
+	TotalSpinTime float32 `protobuf:"fixed32,10,opt,name=total_spin_time,json=TotalSpinTime,proto3" json:"total_spin_time,omitempty"`
+	// Average of the highest rear wheel slip ratio per event
+	AveragePeakSlip float32 `protobuf:"fixed32,11,opt,name=average_peak_slip,json=AveragePeakSlip,proto3" json:"average_peak_slip,omitempty"`
+}
+
+func (x *TractionLap) Reset() {
+	*x = TractionLap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TractionLap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TractionLap) ProtoMessage() {}
+
+func (x *TractionLap) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TractionLap.ProtoReflect.Descriptor instead.
+func (*TractionLap) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TractionLap) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TractionLap) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *TractionLap) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TractionLap) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *TractionLap) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *TractionLap) GetLapTime() float32 {
+	if x != nil {
+		return x.LapTime
+	}
+	return 0
+}
+
+func (x *TractionLap) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *TractionLap) GetTractionControl() uint32 {
+	if x != nil {
+		return x.TractionControl
+	}
+	return 0
+}
+
+func (x *TractionLap) GetEvents() int32 {
+	if x != nil {
+		return x.Events
+	}
+	return 0
+}
+
+func (x *TractionLap) GetTotalSpinTime() float32 {
+	if x != nil {
+		return x.TotalSpinTime
+	}
+	return 0
+}
+
+func (x *TractionLap) GetAveragePeakSlip() float32 {
+	if x != nil {
+		return x.AveragePeakSlip
+	}
+	return 0
+}
+
+// TractionSetting sums up the laps of a car driven with a traction control setting.
+type TractionSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// traction_setting
+	Type string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,4,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// 0 (off) - 2 (high)
+	TractionControl uint32 `protobuf:"varint,5,opt,name=traction_control,json=TractionControl,proto3" json:"traction_control,omitempty"`
+	// Number of laps driven with the setting
+	Laps int32 `protobuf:"varint,6,opt,name=laps,json=Laps,proto3" json:"laps,omitempty"`
+	// Best valid lap time in seconds, 0 if none
+	BestLapTime float32 `protobuf:"fixed32,7,opt,name=best_lap_time,json=BestLapTime,proto3" json:"best_lap_time,omitempty"`
+	// Average valid lap time in seconds, 0 if none
+	AverageLapTime float32 `protobuf:"fixed32,8,opt,name=average_lap_time,json=AverageLapTime,proto3" json:"average_lap_time,omitempty"`
+	// Average number of wheelspin events per lap
+	EventsPerLap float32 `protobuf:"fixed32,9,opt,name=events_per_lap,json=EventsPerLap,proto3" json:"events_per_lap,omitempty"`
+	// Average time spent spinning the rear wheels per lap in seconds
+	SpinTimePerLap float32 `protobuf:"fixed32,10,opt,name=spin_time_per_lap,json=SpinTimePerLap,proto3" json:"spin_time_per_lap,omitempty"`
+	// Average of the highest rear wheel slip ratio per event
+	AveragePeakSlip float32 `protobuf:"fixed32,11,opt,name=average_peak_slip,json=AveragePeakSlip,proto3" json:"average_peak_slip,omitempty"`
+}
+
+func (x *TractionSetting) Reset() {
+	*x = TractionSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TractionSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TractionSetting) ProtoMessage() {}
+
+func (x *TractionSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TractionSetting.ProtoReflect.Descriptor instead.
+func (*TractionSetting) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TractionSetting) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TractionSetting) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *TractionSetting) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TractionSetting) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *TractionSetting) GetTractionControl() uint32 {
+	if x != nil {
+		return x.TractionControl
+	}
+	return 0
+}
+
+func (x *TractionSetting) GetLaps() int32 {
+	if x != nil {
+		return x.Laps
+	}
+	return 0
+}
+
+func (x *TractionSetting) GetBestLapTime() float32 {
+	if x != nil {
+		return x.BestLapTime
+	}
+	return 0
+}
+
+func (x *TractionSetting) GetAverageLapTime() float32 {
+	if x != nil {
+		return x.AverageLapTime
+	}
+	return 0
+}
+
+func (x *TractionSetting) GetEventsPerLap() float32 {
+	if x != nil {
+		return x.EventsPerLap
+	}
+	return 0
+}
+
+func (x *TractionSetting) GetSpinTimePerLap() float32 {
+	if x != nil {
+		return x.SpinTimePerLap
+	}
+	return 0
+}
+
+func (x *TractionSetting) GetAveragePeakSlip() float32 {
+	if x != nil {
+		return x.AveragePeakSlip
+	}
+	return 0
+}
+
+// TheoreticalBest is the theoretical best lap report of the cars of a session.
+type TheoreticalBest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header                `protobuf:"bytes,1,opt,name=header,json=Header,proto3" json:"header,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,json=Timestamp,proto3" json:"timestamp,omitempty"`
+	// theoretical_best
+	Type string `protobuf:"bytes,3,opt,name=type,json=Type,proto3" json:"type,omitempty"`
+	// -1 for unknown, 0-21 for tracks, see appendix
+	TrackId int32 `protobuf:"varint,4,opt,name=track_id,json=TrackID,proto3" json:"track_id,omitempty"`
+	// 0 = unknown, 1 = P1, 2 = P2, 3 = P3, 4 = Short P, 5 = Q1 ...
+	SessionType uint32 `protobuf:"varint,5,opt,name=session_type,json=SessionType,proto3" json:"session_type,omitempty"`
+	// Ordered by best lap time
+	Drivers []*DriverBest `protobuf:"bytes,6,rep,name=drivers,json=Drivers,proto3" json:"drivers,omitempty"`
+}
+
+func (x *TheoreticalBest) Reset() {
+	*x = TheoreticalBest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TheoreticalBest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TheoreticalBest) ProtoMessage() {}
+
+func (x *TheoreticalBest) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TheoreticalBest.ProtoReflect.Descriptor instead.
+func (*TheoreticalBest) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TheoreticalBest) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TheoreticalBest) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *TheoreticalBest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TheoreticalBest) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *TheoreticalBest) GetSessionType() uint32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *TheoreticalBest) GetDrivers() []*DriverBest {
+	if x != nil {
+		return x.Drivers
+	}
+	return nil
+}
+
+// Header is the header of every packet, and the source it was received from
+type Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 2020
+	PacketFormat uint32 `protobuf:"varint,1,opt,name=packet_format,json=PacketFormat,proto3" json:"packet_format,omitempty"`
+	// Game major version - "X.00"
+	GameMajorVersion uint32 `protobuf:"varint,2,opt,name=game_major_version,json=GameMajorVersion,proto3" json:"game_major_version,omitempty"`
+	// Game minor version - "1.XX"
+	GameMinorVersion uint32 `protobuf:"varint,3,opt,name=game_minor_version,json=GameMinorVersion,proto3" json:"game_minor_version,omitempty"`
+	// Version of this packet type, all start from 1
+	PacketVersion uint32 `protobuf:"varint,4,opt,name=packet_version,json=PacketVersion,proto3" json:"packet_version,omitempty"`
+	// Identifier for the packet type, see below
+	PacketId uint32 `protobuf:"varint,5,opt,name=packet_id,json=PacketID,proto3" json:"packet_id,omitempty"`
+	// Unique identifier for the session
+	SessionUid string `protobuf:"bytes,6,opt,name=session_uid,json=SessionUID,proto3" json:"session_uid,omitempty"`
+	// Session timestamp
+	SessionTime float32 `protobuf:"fixed32,7,opt,name=session_time,json=SessionTime,proto3" json:"session_time,omitempty"`
+	// Identifier for the frame the data was retrieved on
+	FrameIdentifier uint32 `protobuf:"varint,8,opt,name=frame_identifier,json=FrameIdentifier,proto3" json:"frame_identifier,omitempty"`
+	// Index of player's car in the array
+	PlayerCarIndex uint32 `protobuf:"varint,9,opt,name=player_car_index,json=PlayerCarIndex,proto3" json:"player_car_index,omitempty"`
+	// ADDED IN BETA 2:
+	//
+	// Index of secondary player's car in the array (splitscreen)
+	SecondaryPlayerCarIndex uint32 `protobuf:"varint,10,opt,name=secondary_player_car_index,json=SecondaryPlayerCarIndex,proto3" json:"secondary_player_car_index,omitempty"`
+	// Rig the packet was received from
+	Source *Source `protobuf:"bytes,11,opt,name=source,json=Source,proto3" json:"source,omitempty"`
+}
+
+func (x *Header) Reset() {
+	*x = Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Header) ProtoMessage() {}
+
+func (x *Header) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Header.ProtoReflect.Descriptor instead.
+func (*Header) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Header) GetPacketFormat() uint32 {
+	if x != nil {
+		return x.PacketFormat
+	}
+	return 0
+}
+
+func (x *Header) GetGameMajorVersion() uint32 {
+	if x != nil {
+		return x.GameMajorVersion
+	}
+	return 0
+}
+
+func (x *Header) GetGameMinorVersion() uint32 {
+	if x != nil {
+		return x.GameMinorVersion
+	}
+	return 0
+}
+
+func (x *Header) GetPacketVersion() uint32 {
+	if x != nil {
+		return x.PacketVersion
+	}
+	return 0
+}
+
+func (x *Header) GetPacketId() uint32 {
+	if x != nil {
+		return x.PacketId
+	}
+	return 0
+}
+
+func (x *Header) GetSessionUid() string {
+	if x != nil {
+		return x.SessionUid
+	}
+	return ""
+}
+
+func (x *Header) GetSessionTime() float32 {
+	if x != nil {
+		return x.SessionTime
+	}
+	return 0
+}
+
+func (x *Header) GetFrameIdentifier() uint32 {
+	if x != nil {
+		return x.FrameIdentifier
+	}
+	return 0
+}
+
+func (x *Header) GetPlayerCarIndex() uint32 {
+	if x != nil {
+		return x.PlayerCarIndex
+	}
+	return 0
+}
+
+func (x *Header) GetSecondaryPlayerCarIndex() uint32 {
+	if x != nil {
+		return x.SecondaryPlayerCarIndex
+	}
+	return 0
+}
+
+func (x *Header) GetSource() *Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+// CarMotionData data for the car being driven
+type CarMotionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// World space X position
+	WorldPositionX float32 `protobuf:"fixed32,1,opt,name=world_position_x,json=WorldPositionX,proto3" json:"world_position_x,omitempty"`
+	// World space Y position
+	WorldPositionY float32 `protobuf:"fixed32,2,opt,name=world_position_y,json=WorldPositionY,proto3" json:"world_position_y,omitempty"`
+	// World space Z position
+	WorldPositionZ float32 `protobuf:"fixed32,3,opt,name=world_position_z,json=WorldPositionZ,proto3" json:"world_position_z,omitempty"`
+	// Velocity in world space X
+	WorldVelocityX float32 `protobuf:"fixed32,4,opt,name=world_velocity_x,json=WorldVelocityX,proto3" json:"world_velocity_x,omitempty"`
+	// Velocity in world space Y
+	WorldVelocityY float32 `protobuf:"fixed32,5,opt,name=world_velocity_y,json=WorldVelocityY,proto3" json:"world_velocity_y,omitempty"`
+	// Velocity in world space Z
+	WorldVelocityZ float32 `protobuf:"fixed32,6,opt,name=world_velocity_z,json=WorldVelocityZ,proto3" json:"world_velocity_z,omitempty"`
+	// World space forward X direction (normalised)
+	WorldForwardDirX int32 `protobuf:"varint,7,opt,name=world_forward_dir_x,json=WorldForwardDirX,proto3" json:"world_forward_dir_x,omitempty"`
+	// World space forward Y direction (normalised)
+	WorldForwardDirY int32 `protobuf:"varint,8,opt,name=world_forward_dir_y,json=WorldForwardDirY,proto3" json:"world_forward_dir_y,omitempty"`
+	// World space forward Z direction (normalised)
+	WorldForwardDirZ int32 `protobuf:"varint,9,opt,name=world_forward_dir_z,json=WorldForwardDirZ,proto3" json:"world_forward_dir_z,omitempty"`
+	// World space right X direction (normalised)
+	WorldRightDirX int32 `protobuf:"varint,10,opt,name=world_right_dir_x,json=WorldRightDirX,proto3" json:"world_right_dir_x,omitempty"`
+	// World space right Y direction (normalised)
+	WorldRightDirY int32 `protobuf:"varint,11,opt,name=world_right_dir_y,json=WorldRightDirY,proto3" json:"world_right_dir_y,omitempty"`
+	// World space right Z direction (normalised)
+	WorldRightDirZ int32 `protobuf:"varint,12,opt,name=world_right_dir_z,json=WorldRightDirZ,proto3" json:"world_right_dir_z,omitempty"`
+	// Lateral G-Force component
+	GForceLateral float32 `protobuf:"fixed32,13,opt,name=g_force_lateral,json=GForceLateral,proto3" json:"g_force_lateral,omitempty"`
+	// Longitudinal G-Force component
+	GForceLongitudinal float32 `protobuf:"fixed32,14,opt,name=g_force_longitudinal,json=GForceLongitudinal,proto3" json:"g_force_longitudinal,omitempty"`
+	// Vertical G-Force component
+	GForceVertical float32 `protobuf:"fixed32,15,opt,name=g_force_vertical,json=GForceVertical,proto3" json:"g_force_vertical,omitempty"`
+	// Yaw angle in radians
+	Yaw float32 `protobuf:"fixed32,16,opt,name=yaw,json=Yaw,proto3" json:"yaw,omitempty"`
+	// Pitch angle in radians
+	Pitch float32 `protobuf:"fixed32,17,opt,name=pitch,json=Pitch,proto3" json:"pitch,omitempty"`
+	// Roll angle in radians
+	Roll float32 `protobuf:"fixed32,18,opt,name=roll,json=Roll,proto3" json:"roll,omitempty"`
+}
+
+func (x *CarMotionData) Reset() {
+	*x = CarMotionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarMotionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarMotionData) ProtoMessage() {}
+
+func (x *CarMotionData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarMotionData.ProtoReflect.Descriptor instead.
+func (*CarMotionData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CarMotionData) GetWorldPositionX() float32 {
+	if x != nil {
+		return x.WorldPositionX
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldPositionY() float32 {
+	if x != nil {
+		return x.WorldPositionY
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldPositionZ() float32 {
+	if x != nil {
+		return x.WorldPositionZ
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldVelocityX() float32 {
+	if x != nil {
+		return x.WorldVelocityX
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldVelocityY() float32 {
+	if x != nil {
+		return x.WorldVelocityY
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldVelocityZ() float32 {
+	if x != nil {
+		return x.WorldVelocityZ
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldForwardDirX() int32 {
+	if x != nil {
+		return x.WorldForwardDirX
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldForwardDirY() int32 {
+	if x != nil {
+		return x.WorldForwardDirY
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldForwardDirZ() int32 {
+	if x != nil {
+		return x.WorldForwardDirZ
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldRightDirX() int32 {
+	if x != nil {
+		return x.WorldRightDirX
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldRightDirY() int32 {
+	if x != nil {
+		return x.WorldRightDirY
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldRightDirZ() int32 {
+	if x != nil {
+		return x.WorldRightDirZ
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetGForceLateral() float32 {
+	if x != nil {
+		return x.GForceLateral
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetGForceLongitudinal() float32 {
+	if x != nil {
+		return x.GForceLongitudinal
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetGForceVertical() float32 {
+	if x != nil {
+		return x.GForceVertical
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetYaw() float32 {
+	if x != nil {
+		return x.Yaw
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetPitch() float32 {
+	if x != nil {
+		return x.Pitch
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetRoll() float32 {
+	if x != nil {
+		return x.Roll
+	}
+	return 0
+}
+
+// MarshalZone contains marshal zone data
+type MarshalZone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Fraction (0..1) of way through the lap the marshal zone starts
+	ZoneStart float32 `protobuf:"fixed32,1,opt,name=zone_start,json=ZoneStart,proto3" json:"zone_start,omitempty"`
+	// -1 = invalid/unknown, 0 = none, 1 = green, 2 = blue, 3 = yellow, 4 = red
+	ZoneFlag int32 `protobuf:"varint,2,opt,name=zone_flag,json=ZoneFlag,proto3" json:"zone_flag,omitempty"`
+}
+
+func (x *MarshalZone) Reset() {
+	*x = MarshalZone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarshalZone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarshalZone) ProtoMessage() {}
+
+func (x *MarshalZone) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarshalZone.ProtoReflect.Descriptor instead.
+func (*MarshalZone) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *MarshalZone) GetZoneStart() float32 {
+	if x != nil {
+		return x.ZoneStart
+	}
+	return 0
+}
+
+func (x *MarshalZone) GetZoneFlag() int32 {
+	if x != nil {
+		return x.ZoneFlag
+	}
+	return 0
+}
+
+// WeatherForecastSample contains weather data
+type WeatherForecastSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SessionType
+	// 0 = unknown, 1 = P1, 2 = P2, 3 = P3, 4 = Short P, 5 = Q1
+	// 6 = Q2, 7 = Q3, 8 = Short Q, 9 = OSQ, 10 = R, 11 = R2
+	// 12 = Time Trial
+	SessionType uint32 `protobuf:"varint,1,opt,name=session_type,json=SessionType,proto3" json:"session_type,omitempty"`
+	// Time in minutes the forecast is for
+	TimeOffset uint32 `protobuf:"varint,2,opt,name=time_offset,json=TimeOffset,proto3" json:"time_offset,omitempty"`
+	// Weather - 0 = clear, 1 = light cloud, 2 = overcast
+	// 3 = light rain, 4 = heavy rain, 5 = storm
+	Weather uint32 `protobuf:"varint,3,opt,name=weather,json=Weather,proto3" json:"weather,omitempty"`
+	// Track temp. in degrees celsius
+	TrackTemperature int32 `protobuf:"varint,4,opt,name=track_temperature,json=TrackTemperature,proto3" json:"track_temperature,omitempty"`
+	// Air temp. in degrees celsius
+	AirTemperature int32 `protobuf:"varint,5,opt,name=air_temperature,json=AirTemperature,proto3" json:"air_temperature,omitempty"`
+}
+
+func (x *WeatherForecastSample) Reset() {
+	*x = WeatherForecastSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeatherForecastSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeatherForecastSample) ProtoMessage() {}
+
+func (x *WeatherForecastSample) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeatherForecastSample.ProtoReflect.Descriptor instead.
+func (*WeatherForecastSample) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *WeatherForecastSample) GetSessionType() uint32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *WeatherForecastSample) GetTimeOffset() uint32 {
+	if x != nil {
+		return x.TimeOffset
+	}
+	return 0
+}
+
+func (x *WeatherForecastSample) GetWeather() uint32 {
+	if x != nil {
+		return x.Weather
+	}
+	return 0
+}
+
+func (x *WeatherForecastSample) GetTrackTemperature() int32 {
+	if x != nil {
+		return x.TrackTemperature
+	}
+	return 0
+}
+
+func (x *WeatherForecastSample) GetAirTemperature() int32 {
+	if x != nil {
+		return x.AirTemperature
+	}
+	return 0
+}
+
+// PacketLapData is the lap data of the player car
+type PacketLapData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Last lap time in seconds
+	LastLapTime float32 `protobuf:"fixed32,1,opt,name=last_lap_time,json=LastLapTime,proto3" json:"last_lap_time,omitempty"`
+	// Current time around the lap in seconds
+	CurrentLapTime float32 `protobuf:"fixed32,2,opt,name=current_lap_time,json=CurrentLapTime,proto3" json:"current_lap_time,omitempty"`
+	// UPDATED in Beta 3:
+	//
+	// Sector 1 time in milliseconds
+	Sector1TimeInMs uint32 `protobuf:"varint,3,opt,name=sector1_time_in_ms,json=Sector1TimeInMS,proto3" json:"sector1_time_in_ms,omitempty"`
+	// Sector 2 time in milliseconds
+	Sector2TimeInMs uint32 `protobuf:"varint,4,opt,name=sector2_time_in_ms,json=Sector2TimeInMS,proto3" json:"sector2_time_in_ms,omitempty"`
+	// Best lap time of the session in seconds
+	BestLapTime float32 `protobuf:"fixed32,5,opt,name=best_lap_time,json=BestLapTime,proto3" json:"best_lap_time,omitempty"`
+	// Lap number best time achieved on
+	BestLapNum uint32 `protobuf:"varint,6,opt,name=best_lap_num,json=BestLapNum,proto3" json:"best_lap_num,omitempty"`
+	// Sector 1 time of best lap in the session in milliseconds
+	BestLapSector1TimeInMs uint32 `protobuf:"varint,7,opt,name=best_lap_sector1_time_in_ms,json=BestLapSector1TimeInMS,proto3" json:"best_lap_sector1_time_in_ms,omitempty"`
+	// Sector 2 time of best lap in the session in milliseconds
+	BestLapSector2TimeInMs uint32 `protobuf:"varint,8,opt,name=best_lap_sector2_time_in_ms,json=BestLapSector2TimeInMS,proto3" json:"best_lap_sector2_time_in_ms,omitempty"`
+	// Sector 3 time of best lap in the session in milliseconds
+	BestLapSector3TimeInMs uint32 `protobuf:"varint,9,opt,name=best_lap_sector3_time_in_ms,json=BestLapSector3TimeInMS,proto3" json:"best_lap_sector3_time_in_ms,omitempty"`
+	// Best overall sector 1 time of the session in milliseconds
+	BestOverallSector1TimeInMs uint32 `protobuf:"varint,10,opt,name=best_overall_sector1_time_in_ms,json=BestOverallSector1TimeInMS,proto3" json:"best_overall_sector1_time_in_ms,omitempty"`
+	// Lap number best overall sector 1 time achieved on
+	BestOverallSector1LapNum uint32 `protobuf:"varint,11,opt,name=best_overall_sector1_lap_num,json=BestOverallSector1LapNum,proto3" json:"best_overall_sector1_lap_num,omitempty"`
+	// Best overall sector 2 time of the session in milliseconds
+	BestOverallSector2TimeInMs uint32 `protobuf:"varint,12,opt,name=best_overall_sector2_time_in_ms,json=BestOverallSector2TimeInMS,proto3" json:"best_overall_sector2_time_in_ms,omitempty"`
+	// Lap number best overall sector 2 time achieved on
+	BestOverallSector2LapNum uint32 `protobuf:"varint,13,opt,name=best_overall_sector2_lap_num,json=BestOverallSector2LapNum,proto3" json:"best_overall_sector2_lap_num,omitempty"`
+	// Best overall sector 3 time of the session in milliseconds
+	BestOverallSector3TimeInMs uint32 `protobuf:"varint,14,opt,name=best_overall_sector3_time_in_ms,json=BestOverallSector3TimeInMS,proto3" json:"best_overall_sector3_time_in_ms,omitempty"`
+	// Lap number best overall sector 3 time achieved on
+	BestOverallSector3LapNum uint32 `protobuf:"varint,15,opt,name=best_overall_sector3_lap_num,json=BestOverallSector3LapNum,proto3" json:"best_overall_sector3_lap_num,omitempty"`
+	// Distance vehicle is around current lap in metres – could be negative if line hasn’t been crossed yet
+	LapDistance float32 `protobuf:"fixed32,16,opt,name=lap_distance,json=LapDistance,proto3" json:"lap_distance,omitempty"`
+	// Total distance travelled in session in metres – could be negative if line hasn’t been crossed yet
+	TotalDistance float32 `protobuf:"fixed32,17,opt,name=total_distance,json=TotalDistance,proto3" json:"total_distance,omitempty"`
+	// Delta in seconds for safety car
+	SafetyCarDelta float32 `protobuf:"fixed32,18,opt,name=safety_car_delta,json=SafetyCarDelta,proto3" json:"safety_car_delta,omitempty"`
+	// Car race position
+	CarPosition uint32 `protobuf:"varint,19,opt,name=car_position,json=CarPosition,proto3" json:"car_position,omitempty"`
+	// Current lap number
+	CurrentLapNum uint32 `protobuf:"varint,20,opt,name=current_lap_num,json=CurrentLapNum,proto3" json:"current_lap_num,omitempty"`
+	// 0 = none, 1 = pitting, 2 = in pit area
+	PitStatus uint32 `protobuf:"varint,21,opt,name=pit_status,json=PitStatus,proto3" json:"pit_status,omitempty"`
+	// 0 = sector1, 1 = sector2, 2 = sector3
+	Sector uint32 `protobuf:"varint,22,opt,name=sector,json=Sector,proto3" json:"sector,omitempty"`
+	// Current lap invalid - 0 = valid, 1 = invalid
+	CurrentLapInvalid uint32 `protobuf:"varint,23,opt,name=current_lap_invalid,json=CurrentLapInvalid,proto3" json:"current_lap_invalid,omitempty"`
+	// Accumulated time penalties in seconds to be added
+	Penalties uint32 `protobuf:"varint,24,opt,name=penalties,json=Penalties,proto3" json:"penalties,omitempty"`
+	// Grid position the vehicle started the race in
+	GridPosition uint32 `protobuf:"varint,25,opt,name=grid_position,json=GridPosition,proto3" json:"grid_position,omitempty"`
+	// Status of driver - 0 = in garage, 1 = flying lap, 2 = in lap, 3 = out lap, 4 = on track
+	DriverStatus uint32 `protobuf:"varint,26,opt,name=driver_status,json=DriverStatus,proto3" json:"driver_status,omitempty"`
+	// Result status - 0 = invalid, 1 = inactive, 2 = active
+	ResultStatus uint32 `protobuf:"varint,27,opt,name=result_status,json=ResultStatus,proto3" json:"result_status,omitempty"`
+}
+
+func (x *PacketLapData) Reset() {
+	*x = PacketLapData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketLapData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketLapData) ProtoMessage() {}
+
+func (x *PacketLapData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketLapData.ProtoReflect.Descriptor instead.
+func (*PacketLapData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PacketLapData) GetLastLapTime() float32 {
+	if x != nil {
+		return x.LastLapTime
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetCurrentLapTime() float32 {
+	if x != nil {
+		return x.CurrentLapTime
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetSector1TimeInMs() uint32 {
+	if x != nil {
+		return x.Sector1TimeInMs
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetSector2TimeInMs() uint32 {
+	if x != nil {
+		return x.Sector2TimeInMs
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetBestLapTime() float32 {
+	if x != nil {
+		return x.BestLapTime
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetBestLapNum() uint32 {
+	if x != nil {
+		return x.BestLapNum
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetBestLapSector1TimeInMs() uint32 {
+	if x != nil {
+		return x.BestLapSector1TimeInMs
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetBestLapSector2TimeInMs() uint32 {
+	if x != nil {
+		return x.BestLapSector2TimeInMs
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetBestLapSector3TimeInMs() uint32 {
+	if x != nil {
+		return x.BestLapSector3TimeInMs
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetBestOverallSector1TimeInMs() uint32 {
+	if x != nil {
+		return x.BestOverallSector1TimeInMs
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetBestOverallSector1LapNum() uint32 {
+	if x != nil {
+		return x.BestOverallSector1LapNum
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetBestOverallSector2TimeInMs() uint32 {
+	if x != nil {
+		return x.BestOverallSector2TimeInMs
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetBestOverallSector2LapNum() uint32 {
+	if x != nil {
+		return x.BestOverallSector2LapNum
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetBestOverallSector3TimeInMs() uint32 {
+	if x != nil {
+		return x.BestOverallSector3TimeInMs
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetBestOverallSector3LapNum() uint32 {
+	if x != nil {
+		return x.BestOverallSector3LapNum
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetLapDistance() float32 {
+	if x != nil {
+		return x.LapDistance
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetTotalDistance() float32 {
+	if x != nil {
+		return x.TotalDistance
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetSafetyCarDelta() float32 {
+	if x != nil {
+		return x.SafetyCarDelta
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetCarPosition() uint32 {
+	if x != nil {
+		return x.CarPosition
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetCurrentLapNum() uint32 {
+	if x != nil {
+		return x.CurrentLapNum
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetPitStatus() uint32 {
+	if x != nil {
+		return x.PitStatus
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetSector() uint32 {
+	if x != nil {
+		return x.Sector
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetCurrentLapInvalid() uint32 {
+	if x != nil {
+		return x.CurrentLapInvalid
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetPenalties() uint32 {
+	if x != nil {
+		return x.Penalties
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetGridPosition() uint32 {
+	if x != nil {
+		return x.GridPosition
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetDriverStatus() uint32 {
+	if x != nil {
+		return x.DriverStatus
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetResultStatus() uint32 {
+	if x != nil {
+		return x.ResultStatus
+	}
+	return 0
+}
+
+// ParticipantData details a participant of the session, the player car
+type ParticipantData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the vehicle is AI (1) or Human (0) controlled
+	AiControlled uint32 `protobuf:"varint,1,opt,name=ai_controlled,json=AiControlled,proto3" json:"ai_controlled,omitempty"`
+	// Driver id - see appendix
+	DriverId uint32 `protobuf:"varint,2,opt,name=driver_id,json=DriverID,proto3" json:"driver_id,omitempty"`
+	// Team id - see appendix
+	TeamId uint32 `protobuf:"varint,3,opt,name=team_id,json=TeamID,proto3" json:"team_id,omitempty"`
+	// Race number of the car
+	RaceNumber uint32 `protobuf:"varint,4,opt,name=race_number,json=RaceNumber,proto3" json:"race_number,omitempty"`
+	// Nationality of the driver
+	Nationality uint32 `protobuf:"varint,5,opt,name=nationality,json=Nationality,proto3" json:"nationality,omitempty"`
+	// Name of participant in UTF-8 format – null terminated. Will be truncated with … (U+2026) if too long
+	Name string `protobuf:"bytes,6,opt,name=name,json=Name,proto3" json:"name,omitempty"`
+	// The player's UDP setting, 0 = restricted, 1 = public
+	YourTelemetry uint32 `protobuf:"varint,7,opt,name=your_telemetry,json=YourTelemetry,proto3" json:"your_telemetry,omitempty"`
+}
+
+func (x *ParticipantData) Reset() {
+	*x = ParticipantData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParticipantData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantData) ProtoMessage() {}
+
+func (x *ParticipantData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantData.ProtoReflect.Descriptor instead.
+func (*ParticipantData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ParticipantData) GetAiControlled() uint32 {
+	if x != nil {
+		return x.AiControlled
+	}
+	return 0
+}
+
+func (x *ParticipantData) GetDriverId() uint32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *ParticipantData) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *ParticipantData) GetRaceNumber() uint32 {
+	if x != nil {
+		return x.RaceNumber
+	}
+	return 0
+}
+
+func (x *ParticipantData) GetNationality() uint32 {
+	if x != nil {
+		return x.Nationality
+	}
+	return 0
+}
+
+func (x *ParticipantData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParticipantData) GetYourTelemetry() uint32 {
+	if x != nil {
+		return x.YourTelemetry
+	}
+	return 0
+}
+
+// PacketCarSetupData is the setup of the player car
+type PacketCarSetupData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Front wing aero
+	FrontWing uint32 `protobuf:"varint,1,opt,name=front_wing,json=FrontWing,proto3" json:"front_wing,omitempty"`
+	// Rear wing aero
+	RearWing uint32 `protobuf:"varint,2,opt,name=rear_wing,json=RearWing,proto3" json:"rear_wing,omitempty"`
+	// Differential adjustment on throttle (percentage)
+	OnThrottle uint32 `protobuf:"varint,3,opt,name=on_throttle,json=OnThrottle,proto3" json:"on_throttle,omitempty"`
+	// Differential adjustment off throttle (percentage)
+	OffThrottle uint32 `protobuf:"varint,4,opt,name=off_throttle,json=OffThrottle,proto3" json:"off_throttle,omitempty"`
+	// Front camber angle (suspension geometry)
+	FrontCamber float32 `protobuf:"fixed32,5,opt,name=front_camber,json=FrontCamber,proto3" json:"front_camber,omitempty"`
+	// Rear camber angle (suspension geometry)
+	RearCamber float32 `protobuf:"fixed32,6,opt,name=rear_camber,json=RearCamber,proto3" json:"rear_camber,omitempty"`
+	// Front toe angle (suspension geometry)
+	FrontToe float32 `protobuf:"fixed32,7,opt,name=front_toe,json=FrontToe,proto3" json:"front_toe,omitempty"`
+	// Rear toe angle (suspension geometry)
+	RearToe float32 `protobuf:"fixed32,8,opt,name=rear_toe,json=RearToe,proto3" json:"rear_toe,omitempty"`
+	// Front suspension
+	FrontSuspension uint32 `protobuf:"varint,9,opt,name=front_suspension,json=FrontSuspension,proto3" json:"front_suspension,omitempty"`
+	// Rear suspension
+	RearSuspension uint32 `protobuf:"varint,10,opt,name=rear_suspension,json=RearSuspension,proto3" json:"rear_suspension,omitempty"`
+	// Front anti-roll bar
+	FrontAntiRollBar uint32 `protobuf:"varint,11,opt,name=front_anti_roll_bar,json=FrontAntiRollBar,proto3" json:"front_anti_roll_bar,omitempty"`
+	// Front anti-roll bar
+	RearAntiRollBar uint32 `protobuf:"varint,12,opt,name=rear_anti_roll_bar,json=RearAntiRollBar,proto3" json:"rear_anti_roll_bar,omitempty"`
+	// Front ride height
+	FrontSuspensionHeight uint32 `protobuf:"varint,13,opt,name=front_suspension_height,json=FrontSuspensionHeight,proto3" json:"front_suspension_height,omitempty"`
+	// Rear ride height
+	RearSuspensionHeight uint32 `protobuf:"varint,14,opt,name=rear_suspension_height,json=RearSuspensionHeight,proto3" json:"rear_suspension_height,omitempty"`
+	// Brake pressure (percentage)
+	BrakePressure uint32 `protobuf:"varint,15,opt,name=brake_pressure,json=BrakePressure,proto3" json:"brake_pressure,omitempty"`
+	// Brake bias (percentage)
+	BrakeBias uint32 `protobuf:"varint,16,opt,name=brake_bias,json=BrakeBias,proto3" json:"brake_bias,omitempty"`
+	// Rear left tyre pressure (PSI)
+	RearLeftTyrePressure float32 `protobuf:"fixed32,17,opt,name=rear_left_tyre_pressure,json=RearLeftTyrePressure,proto3" json:"rear_left_tyre_pressure,omitempty"`
+	// Rear right tyre pressure (PSI)
+	RearRightTyrePressure float32 `protobuf:"fixed32,18,opt,name=rear_right_tyre_pressure,json=RearRightTyrePressure,proto3" json:"rear_right_tyre_pressure,omitempty"`
+	// Front left tyre pressure (PSI)
+	FrontLeftTyrePressure float32 `protobuf:"fixed32,19,opt,name=front_left_tyre_pressure,json=FrontLeftTyrePressure,proto3" json:"front_left_tyre_pressure,omitempty"`
+	// Front right tyre pressure (PSI)
+	FrontRightTyrePressure float32 `protobuf:"fixed32,20,opt,name=front_right_tyre_pressure,json=FrontRightTyrePressure,proto3" json:"front_right_tyre_pressure,omitempty"`
+	// Ballast
+	Ballast uint32 `protobuf:"varint,21,opt,name=ballast,json=Ballast,proto3" json:"ballast,omitempty"`
+	// Fuel load
+	FuelLoad float32 `protobuf:"fixed32,22,opt,name=fuel_load,json=FuelLoad,proto3" json:"fuel_load,omitempty"`
+}
+
+func (x *PacketCarSetupData) Reset() {
+	*x = PacketCarSetupData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketCarSetupData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketCarSetupData) ProtoMessage() {}
+
+func (x *PacketCarSetupData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketCarSetupData.ProtoReflect.Descriptor instead.
+func (*PacketCarSetupData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PacketCarSetupData) GetFrontWing() uint32 {
+	if x != nil {
+		return x.FrontWing
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetRearWing() uint32 {
+	if x != nil {
+		return x.RearWing
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetOnThrottle() uint32 {
+	if x != nil {
+		return x.OnThrottle
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetOffThrottle() uint32 {
+	if x != nil {
+		return x.OffThrottle
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetFrontCamber() float32 {
+	if x != nil {
+		return x.FrontCamber
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetRearCamber() float32 {
+	if x != nil {
+		return x.RearCamber
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetFrontToe() float32 {
+	if x != nil {
+		return x.FrontToe
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetRearToe() float32 {
+	if x != nil {
+		return x.RearToe
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetFrontSuspension() uint32 {
+	if x != nil {
+		return x.FrontSuspension
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetRearSuspension() uint32 {
+	if x != nil {
+		return x.RearSuspension
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetFrontAntiRollBar() uint32 {
+	if x != nil {
+		return x.FrontAntiRollBar
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetRearAntiRollBar() uint32 {
+	if x != nil {
+		return x.RearAntiRollBar
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetFrontSuspensionHeight() uint32 {
+	if x != nil {
+		return x.FrontSuspensionHeight
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetRearSuspensionHeight() uint32 {
+	if x != nil {
+		return x.RearSuspensionHeight
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetBrakePressure() uint32 {
+	if x != nil {
+		return x.BrakePressure
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetBrakeBias() uint32 {
+	if x != nil {
+		return x.BrakeBias
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetRearLeftTyrePressure() float32 {
+	if x != nil {
+		return x.RearLeftTyrePressure
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetRearRightTyrePressure() float32 {
+	if x != nil {
+		return x.RearRightTyrePressure
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetFrontLeftTyrePressure() float32 {
+	if x != nil {
+		return x.FrontLeftTyrePressure
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetFrontRightTyrePressure() float32 {
+	if x != nil {
+		return x.FrontRightTyrePressure
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetBallast() uint32 {
+	if x != nil {
+		return x.Ballast
+	}
+	return 0
+}
+
+func (x *PacketCarSetupData) GetFuelLoad() float32 {
+	if x != nil {
+		return x.FuelLoad
+	}
+	return 0
+}
+
+type CarTelemetryDataDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Speed of car in kilometres per hour
+	Speed uint32 `protobuf:"varint,1,opt,name=speed,json=Speed,proto3" json:"speed,omitempty"`
+	// Amount of throttle applied (0.0 to 1.0)
+	Throttle float32 `protobuf:"fixed32,2,opt,name=throttle,json=Throttle,proto3" json:"throttle,omitempty"`
+	// Steering (-1.0 (full lock left) to 1.0 (full lock right))
+	Steer float32 `protobuf:"fixed32,3,opt,name=steer,json=Steer,proto3" json:"steer,omitempty"`
+	// Amount of brake applied (0.0 to 1.0)
+	Brake float32 `protobuf:"fixed32,4,opt,name=brake,json=Brake,proto3" json:"brake,omitempty"`
+	// Amount of clutch applied (0 to 100)
+	Clutch uint32 `protobuf:"varint,5,opt,name=clutch,json=Clutch,proto3" json:"clutch,omitempty"`
+	// Gear selected (1-8, N=0, R=-1)
+	Gear int32 `protobuf:"varint,6,opt,name=gear,json=Gear,proto3" json:"gear,omitempty"`
+	// Engine RPM
+	EngineRpm uint32 `protobuf:"varint,7,opt,name=engine_rpm,json=EngineRPM,proto3" json:"engine_rpm,omitempty"`
+	// 0 = off, 1 = on
+	Drs uint32 `protobuf:"varint,8,opt,name=drs,json=Drs,proto3" json:"drs,omitempty"`
+	// Rev lights indicator (percentage)
+	RevLightsPercent uint32 `protobuf:"varint,9,opt,name=rev_lights_percent,json=RevLightsPercent,proto3" json:"rev_lights_percent,omitempty"`
+	// Brakes temperature (celsius)
+	RearLeftBrakesTemperature uint32 `protobuf:"varint,10,opt,name=rear_left_brakes_temperature,json=RearLeftBrakesTemperature,proto3" json:"rear_left_brakes_temperature,omitempty"`
+	// Brakes temperature (celsius)
+	RearRightBrakesTemperature uint32 `protobuf:"varint,11,opt,name=rear_right_brakes_temperature,json=RearRightBrakesTemperature,proto3" json:"rear_right_brakes_temperature,omitempty"`
+	// Brakes temperature (celsius)
+	FrontLeftBrakesTemperature uint32 `protobuf:"varint,12,opt,name=front_left_brakes_temperature,json=FrontLeftBrakesTemperature,proto3" json:"front_left_brakes_temperature,omitempty"`
+	// Brakes temperature (celsius)
+	FrontRightBrakesTemperature uint32 `protobuf:"varint,13,opt,name=front_right_brakes_temperature,json=FrontRightBrakesTemperature,proto3" json:"front_right_brakes_temperature,omitempty"`
+	// Tyres surface temperature (celsius)
+	RearLeftTyresSurfaceTemperature uint32 `protobuf:"varint,14,opt,name=rear_left_tyres_surface_temperature,json=RearLeftTyresSurfaceTemperature,proto3" json:"rear_left_tyres_surface_temperature,omitempty"`
+	// Tyres surface temperature (celsius)
+	RearRightTyresSurfaceTemperature uint32 `protobuf:"varint,15,opt,name=rear_right_tyres_surface_temperature,json=RearRightTyresSurfaceTemperature,proto3" json:"rear_right_tyres_surface_temperature,omitempty"`
+	// Tyres surface temperature (celsius)
+	FrontLeftTyresSurfaceTemperature uint32 `protobuf:"varint,16,opt,name=front_left_tyres_surface_temperature,json=FrontLeftTyresSurfaceTemperature,proto3" json:"front_left_tyres_surface_temperature,omitempty"`
+	// Tyres surface temperature (celsius)
+	FrontRightTyresSurfaceTemperature uint32 `protobuf:"varint,17,opt,name=front_right_tyres_surface_temperature,json=FrontRightTyresSurfaceTemperature,proto3" json:"front_right_tyres_surface_temperature,omitempty"`
+	// Tyres inner temperature (celsius)
+	RearLeftTyresInnerTemperature uint32 `protobuf:"varint,18,opt,name=rear_left_tyres_inner_temperature,json=RearLeftTyresInnerTemperature,proto3" json:"rear_left_tyres_inner_temperature,omitempty"`
+	// Tyres inner temperature (celsius)
+	RearRightTyresInnerTemperature uint32 `protobuf:"varint,19,opt,name=rear_right_tyres_inner_temperature,json=RearRightTyresInnerTemperature,proto3" json:"rear_right_tyres_inner_temperature,omitempty"`
+	// Tyres inner temperature (celsius)
+	FrontLeftTyresInnerTemperature uint32 `protobuf:"varint,20,opt,name=front_left_tyres_inner_temperature,json=FrontLeftTyresInnerTemperature,proto3" json:"front_left_tyres_inner_temperature,omitempty"`
+	// Tyres inner temperature (celsius)
+	FrontRightTyresInnerTemperature uint32 `protobuf:"varint,21,opt,name=front_right_tyres_inner_temperature,json=FrontRightTyresInnerTemperature,proto3" json:"front_right_tyres_inner_temperature,omitempty"`
+	// Engine temperature (celsius)
+	EngineTemperature uint32 `protobuf:"varint,22,opt,name=engine_temperature,json=EngineTemperature,proto3" json:"engine_temperature,omitempty"`
+	// Tyres pressure (PSI)
+	RearLeftTyresPressure float32 `protobuf:"fixed32,23,opt,name=rear_left_tyres_pressure,json=RearLeftTyresPressure,proto3" json:"rear_left_tyres_pressure,omitempty"`
+	// Tyres pressure (PSI)
+	RearRightTyresPressure float32 `protobuf:"fixed32,24,opt,name=rear_right_tyres_pressure,json=RearRightTyresPressure,proto3" json:"rear_right_tyres_pressure,omitempty"`
+	// Tyres pressure (PSI)
+	FrontLeftTyresPressure float32 `protobuf:"fixed32,25,opt,name=front_left_tyres_pressure,json=FrontLeftTyresPressure,proto3" json:"front_left_tyres_pressure,omitempty"`
+	// Tyres pressure (PSI)
+	FrontRightTyresPressure float32 `protobuf:"fixed32,26,opt,name=front_right_tyres_pressure,json=FrontRightTyresPressure,proto3" json:"front_right_tyres_pressure,omitempty"`
+	// Driving surface, see appendices
+	RearLeftSurfaceType uint32 `protobuf:"varint,27,opt,name=rear_left_surface_type,json=RearLeftSurfaceType,proto3" json:"rear_left_surface_type,omitempty"`
+	// Driving surface, see appendices
+	RearRightSurfaceType uint32 `protobuf:"varint,28,opt,name=rear_right_surface_type,json=RearRightSurfaceType,proto3" json:"rear_right_surface_type,omitempty"`
+	// Driving surface, see appendices
+	FrontLeftSurfaceType uint32 `protobuf:"varint,29,opt,name=front_left_surface_type,json=FrontLeftSurfaceType,proto3" json:"front_left_surface_type,omitempty"`
+	// Driving surface, see appendices
+	FrontRightSurfaceType uint32 `protobuf:"varint,30,opt,name=front_right_surface_type,json=FrontRightSurfaceType,proto3" json:"front_right_surface_type,omitempty"`
+}
+
+func (x *CarTelemetryDataDetails) Reset() {
+	*x = CarTelemetryDataDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarTelemetryDataDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarTelemetryDataDetails) ProtoMessage() {}
+
+func (x *CarTelemetryDataDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarTelemetryDataDetails.ProtoReflect.Descriptor instead.
+func (*CarTelemetryDataDetails) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CarTelemetryDataDetails) GetSpeed() uint32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetThrottle() float32 {
+	if x != nil {
+		return x.Throttle
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetSteer() float32 {
+	if x != nil {
+		return x.Steer
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetBrake() float32 {
+	if x != nil {
+		return x.Brake
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetClutch() uint32 {
+	if x != nil {
+		return x.Clutch
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetGear() int32 {
+	if x != nil {
+		return x.Gear
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetEngineRpm() uint32 {
+	if x != nil {
+		return x.EngineRpm
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetDrs() uint32 {
+	if x != nil {
+		return x.Drs
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetRevLightsPercent() uint32 {
+	if x != nil {
+		return x.RevLightsPercent
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetRearLeftBrakesTemperature() uint32 {
+	if x != nil {
+		return x.RearLeftBrakesTemperature
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetRearRightBrakesTemperature() uint32 {
+	if x != nil {
+		return x.RearRightBrakesTemperature
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetFrontLeftBrakesTemperature() uint32 {
+	if x != nil {
+		return x.FrontLeftBrakesTemperature
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetFrontRightBrakesTemperature() uint32 {
+	if x != nil {
+		return x.FrontRightBrakesTemperature
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetRearLeftTyresSurfaceTemperature() uint32 {
+	if x != nil {
+		return x.RearLeftTyresSurfaceTemperature
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetRearRightTyresSurfaceTemperature() uint32 {
+	if x != nil {
+		return x.RearRightTyresSurfaceTemperature
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetFrontLeftTyresSurfaceTemperature() uint32 {
+	if x != nil {
+		return x.FrontLeftTyresSurfaceTemperature
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetFrontRightTyresSurfaceTemperature() uint32 {
+	if x != nil {
+		return x.FrontRightTyresSurfaceTemperature
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetRearLeftTyresInnerTemperature() uint32 {
+	if x != nil {
+		return x.RearLeftTyresInnerTemperature
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetRearRightTyresInnerTemperature() uint32 {
+	if x != nil {
+		return x.RearRightTyresInnerTemperature
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetFrontLeftTyresInnerTemperature() uint32 {
+	if x != nil {
+		return x.FrontLeftTyresInnerTemperature
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetFrontRightTyresInnerTemperature() uint32 {
+	if x != nil {
+		return x.FrontRightTyresInnerTemperature
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetEngineTemperature() uint32 {
+	if x != nil {
+		return x.EngineTemperature
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetRearLeftTyresPressure() float32 {
+	if x != nil {
+		return x.RearLeftTyresPressure
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetRearRightTyresPressure() float32 {
+	if x != nil {
+		return x.RearRightTyresPressure
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetFrontLeftTyresPressure() float32 {
+	if x != nil {
+		return x.FrontLeftTyresPressure
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetFrontRightTyresPressure() float32 {
+	if x != nil {
+		return x.FrontRightTyresPressure
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetRearLeftSurfaceType() uint32 {
+	if x != nil {
+		return x.RearLeftSurfaceType
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetRearRightSurfaceType() uint32 {
+	if x != nil {
+		return x.RearRightSurfaceType
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetFrontLeftSurfaceType() uint32 {
+	if x != nil {
+		return x.FrontLeftSurfaceType
+	}
+	return 0
+}
+
+func (x *CarTelemetryDataDetails) GetFrontRightSurfaceType() uint32 {
+	if x != nil {
+		return x.FrontRightSurfaceType
+	}
+	return 0
+}
+
+// CarStatusDataDetail is the status of the player car
+type CarStatusDataDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 (off) - 2 (high)
+	TractionControl uint32 `protobuf:"varint,1,opt,name=traction_control,json=TractionControl,proto3" json:"traction_control,omitempty"`
+	// 0 (off) - 1 (on)
+	AntiLockBrakes uint32 `protobuf:"varint,2,opt,name=anti_lock_brakes,json=AntiLockBrakes,proto3" json:"anti_lock_brakes,omitempty"`
+	// Fuel mix - 0 = lean, 1 = standard, 2 = rich, 3 = max
+	FuelMix uint32 `protobuf:"varint,3,opt,name=fuel_mix,json=FuelMix,proto3" json:"fuel_mix,omitempty"`
+	// Front brake bias (percentage)
+	FrontBrakeBias uint32 `protobuf:"varint,4,opt,name=front_brake_bias,json=FrontBrakeBias,proto3" json:"front_brake_bias,omitempty"`
+	// Pit limiter status - 0 = off, 1 = on
+	PitLimiterStatus uint32 `protobuf:"varint,5,opt,name=pit_limiter_status,json=PitLimiterStatus,proto3" json:"pit_limiter_status,omitempty"`
+	// Current fuel mass
+	FuelInTank float32 `protobuf:"fixed32,6,opt,name=fuel_in_tank,json=FuelInTank,proto3" json:"fuel_in_tank,omitempty"`
+	// Fuel capacity
+	FuelCapacity float32 `protobuf:"fixed32,7,opt,name=fuel_capacity,json=FuelCapacity,proto3" json:"fuel_capacity,omitempty"`
+	// Fuel remaining in terms of laps (value on MFD)
+	FuelRemainingLaps float32 `protobuf:"fixed32,8,opt,name=fuel_remaining_laps,json=FuelRemainingLaps,proto3" json:"fuel_remaining_laps,omitempty"`
+	// Cars max RPM, point of rev limiter
+	MaxRpm uint32 `protobuf:"varint,9,opt,name=max_rpm,json=MaxRPM,proto3" json:"max_rpm,omitempty"`
+	// Cars idle RPM
+	IdleRpm uint32 `protobuf:"varint,10,opt,name=idle_rpm,json=IdleRPM,proto3" json:"idle_rpm,omitempty"`
+	// Maximum number of gears
+	MaxGears uint32 `protobuf:"varint,11,opt,name=max_gears,json=MaxGears,proto3" json:"max_gears,omitempty"`
+	// 0 = not allowed, 1 = allowed, -1 = unknown
+	DrsAllowed uint32 `protobuf:"varint,12,opt,name=drs_allowed,json=DrsAllowed,proto3" json:"drs_allowed,omitempty"`
+	// Added in Beta3:
+	// 0 = DRS not available, non-zero - DRS will be available
+	// in [X] metres
+	DrsActivationDistance uint32 `protobuf:"varint,13,opt,name=drs_activation_distance,json=DrsActivationDistance,proto3" json:"drs_activation_distance,omitempty"`
+	// Tyre wear percentage
+	// F1 Modern - 16 = C5, 17 = C4, 18 = C3, 19 = C2, 20 = C1
+	// 7 = inter, 8 = wet
+	// F1 Classic - 9 = dry, 10 = wet
+	// F2 – 11 = super soft, 12 = soft, 13 = medium, 14 = hard
+	// 15 = wet
+	RearLeftTyresWear   uint32 `protobuf:"varint,14,opt,name=rear_left_tyres_wear,json=RearLeftTyresWear,proto3" json:"rear_left_tyres_wear,omitempty"`
+	RearRightTyresWear  uint32 `protobuf:"varint,15,opt,name=rear_right_tyres_wear,json=RearRightTyresWear,proto3" json:"rear_right_tyres_wear,omitempty"`
+	FrontLeftTyresWear  uint32 `protobuf:"varint,16,opt,name=front_left_tyres_wear,json=FrontLeftTyresWear,proto3" json:"front_left_tyres_wear,omitempty"`
+	FrontRightTyresWear uint32 `protobuf:"varint,17,opt,name=front_right_tyres_wear,json=FrontRightTyresWear,proto3" json:"front_right_tyres_wear,omitempty"`
+	ActualTyreCompound  uint32 `protobuf:"varint,18,opt,name=actual_tyre_compound,json=ActualTyreCompound,proto3" json:"actual_tyre_compound,omitempty"`
+	VisualTyreCompound  uint32 `protobuf:"varint,19,opt,name=visual_tyre_compound,json=VisualTyreCompound,proto3" json:"visual_tyre_compound,omitempty"`
+	// Age in laps of the current set of tyres
+	TyresAgeLaps uint32 `protobuf:"varint,20,opt,name=tyres_age_laps,json=TyresAgeLaps,proto3" json:"tyres_age_laps,omitempty"`
+	// Tyre damage (percentage)
+	RearLeftTyresDamage   uint32 `protobuf:"varint,21,opt,name=rear_left_tyres_damage,json=RearLeftTyresDamage,proto3" json:"rear_left_tyres_damage,omitempty"`
+	RearRightTyresDamage  uint32 `protobuf:"varint,22,opt,name=rear_right_tyres_damage,json=RearRightTyresDamage,proto3" json:"rear_right_tyres_damage,omitempty"`
+	FrontLeftTyresDamage  uint32 `protobuf:"varint,23,opt,name=front_left_tyres_damage,json=FrontLeftTyresDamage,proto3" json:"front_left_tyres_damage,omitempty"`
+	FrontRightTyresDamage uint32 `protobuf:"varint,24,opt,name=front_right_tyres_damage,json=FrontRightTyresDamage,proto3" json:"front_right_tyres_damage,omitempty"`
+	// Front left wing damage (percentage)
+	FrontLeftWingDamage uint32 `protobuf:"varint,25,opt,name=front_left_wing_damage,json=FrontLeftWingDamage,proto3" json:"front_left_wing_damage,omitempty"`
+	// Front right wing damage (percentage)
+	FrontRightWingDamage uint32 `protobuf:"varint,26,opt,name=front_right_wing_damage,json=FrontRightWingDamage,proto3" json:"front_right_wing_damage,omitempty"`
+	// Rear wing damage (percentage)
+	RearWingDamage uint32 `protobuf:"varint,27,opt,name=rear_wing_damage,json=RearWingDamage,proto3" json:"rear_wing_damage,omitempty"`
+	// Added Beta 3:
+	//
+	// Indicator for DRS fault, 0 = OK, 1 = fault
+	DrsFault uint32 `protobuf:"varint,28,opt,name=drs_fault,json=DrsFault,proto3" json:"drs_fault,omitempty"`
+	// Engine damage (percentage)
+	EngineDamage uint32 `protobuf:"varint,29,opt,name=engine_damage,json=EngineDamage,proto3" json:"engine_damage,omitempty"`
+	// Gear box damage (percentage)
+	GearBoxDamage uint32 `protobuf:"varint,30,opt,name=gear_box_damage,json=GearBoxDamage,proto3" json:"gear_box_damage,omitempty"`
+	// -1 = invalid/unknown, 0 = none, 1 = green
+	// 2 = blue, 3 = yellow, 4 = red
+	VehicleFiaFlags int32 `protobuf:"varint,31,opt,name=vehicle_fia_flags,json=VehicleFiaFlags,proto3" json:"vehicle_fia_flags,omitempty"`
+	// ERS energy store in Joules
+	ErsStoreEnergy float32 `protobuf:"fixed32,32,opt,name=ers_store_energy,json=ErsStoreEnergy,proto3" json:"ers_store_energy,omitempty"`
+	// ERS deployment mode, 0 = none, 1 = medium
+	// 2 = overtake, 3 = hotlap
+	ErsDeployMode uint32 `protobuf:"varint,33,opt,name=ers_deploy_mode,json=ErsDeployMode,proto3" json:"ers_deploy_mode,omitempty"`
+	// ERS energy harvested this lap by MGU-K
+	ErsHarvestedThisLapMguk float32 `protobuf:"fixed32,34,opt,name=ers_harvested_this_lap_mguk,json=ErsHarvestedThisLapMGUK,proto3" json:"ers_harvested_this_lap_mguk,omitempty"`
+	// ERS energy harvested this lap by MGU-H
+	ErsHarvestedThisLapMguh float32 `protobuf:"fixed32,35,opt,name=ers_harvested_this_lap_mguh,json=ErsHarvestedThisLapMGUH,proto3" json:"ers_harvested_this_lap_mguh,omitempty"`
+	// ERS energy deployed this lap
+	ErsDeployedThisLap float32 `protobuf:"fixed32,36,opt,name=ers_deployed_this_lap,json=ErsDeployedThisLap,proto3" json:"ers_deployed_this_lap,omitempty"`
+}
+
+func (x *CarStatusDataDetail) Reset() {
+	*x = CarStatusDataDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarStatusDataDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarStatusDataDetail) ProtoMessage() {}
+
+func (x *CarStatusDataDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarStatusDataDetail.ProtoReflect.Descriptor instead.
+func (*CarStatusDataDetail) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CarStatusDataDetail) GetTractionControl() uint32 {
+	if x != nil {
+		return x.TractionControl
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetAntiLockBrakes() uint32 {
+	if x != nil {
+		return x.AntiLockBrakes
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetFuelMix() uint32 {
+	if x != nil {
+		return x.FuelMix
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetFrontBrakeBias() uint32 {
+	if x != nil {
+		return x.FrontBrakeBias
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetPitLimiterStatus() uint32 {
+	if x != nil {
+		return x.PitLimiterStatus
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetFuelInTank() float32 {
+	if x != nil {
+		return x.FuelInTank
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetFuelCapacity() float32 {
+	if x != nil {
+		return x.FuelCapacity
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetFuelRemainingLaps() float32 {
+	if x != nil {
+		return x.FuelRemainingLaps
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetMaxRpm() uint32 {
+	if x != nil {
+		return x.MaxRpm
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetIdleRpm() uint32 {
+	if x != nil {
+		return x.IdleRpm
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetMaxGears() uint32 {
+	if x != nil {
+		return x.MaxGears
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetDrsAllowed() uint32 {
+	if x != nil {
+		return x.DrsAllowed
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetDrsActivationDistance() uint32 {
+	if x != nil {
+		return x.DrsActivationDistance
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetRearLeftTyresWear() uint32 {
+	if x != nil {
+		return x.RearLeftTyresWear
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetRearRightTyresWear() uint32 {
+	if x != nil {
+		return x.RearRightTyresWear
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetFrontLeftTyresWear() uint32 {
+	if x != nil {
+		return x.FrontLeftTyresWear
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetFrontRightTyresWear() uint32 {
+	if x != nil {
+		return x.FrontRightTyresWear
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetActualTyreCompound() uint32 {
+	if x != nil {
+		return x.ActualTyreCompound
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetVisualTyreCompound() uint32 {
+	if x != nil {
+		return x.VisualTyreCompound
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetTyresAgeLaps() uint32 {
+	if x != nil {
+		return x.TyresAgeLaps
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetRearLeftTyresDamage() uint32 {
+	if x != nil {
+		return x.RearLeftTyresDamage
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetRearRightTyresDamage() uint32 {
+	if x != nil {
+		return x.RearRightTyresDamage
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetFrontLeftTyresDamage() uint32 {
+	if x != nil {
+		return x.FrontLeftTyresDamage
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetFrontRightTyresDamage() uint32 {
+	if x != nil {
+		return x.FrontRightTyresDamage
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetFrontLeftWingDamage() uint32 {
+	if x != nil {
+		return x.FrontLeftWingDamage
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetFrontRightWingDamage() uint32 {
+	if x != nil {
+		return x.FrontRightWingDamage
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetRearWingDamage() uint32 {
+	if x != nil {
+		return x.RearWingDamage
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetDrsFault() uint32 {
+	if x != nil {
+		return x.DrsFault
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetEngineDamage() uint32 {
+	if x != nil {
+		return x.EngineDamage
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetGearBoxDamage() uint32 {
+	if x != nil {
+		return x.GearBoxDamage
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetVehicleFiaFlags() int32 {
+	if x != nil {
+		return x.VehicleFiaFlags
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetErsStoreEnergy() float32 {
+	if x != nil {
+		return x.ErsStoreEnergy
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetErsDeployMode() uint32 {
+	if x != nil {
+		return x.ErsDeployMode
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetErsHarvestedThisLapMguk() float32 {
+	if x != nil {
+		return x.ErsHarvestedThisLapMguk
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetErsHarvestedThisLapMguh() float32 {
+	if x != nil {
+		return x.ErsHarvestedThisLapMguh
+	}
+	return 0
+}
+
+func (x *CarStatusDataDetail) GetErsDeployedThisLap() float32 {
+	if x != nil {
+		return x.ErsDeployedThisLap
+	}
+	return 0
+}
+
+// PacketFinalClassificationData is the final classification of the player car
+type PacketFinalClassificationData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Finishing position
+	Position uint32 `protobuf:"varint,1,opt,name=position,json=Position,proto3" json:"position,omitempty"`
+	// Number of laps completed
+	NumLaps uint32 `protobuf:"varint,2,opt,name=num_laps,json=NumLaps,proto3" json:"num_laps,omitempty"`
+	// Grid position of the car
+	GridPosition uint32 `protobuf:"varint,3,opt,name=grid_position,json=GridPosition,proto3" json:"grid_position,omitempty"`
+	// Number of points scored
+	Points uint32 `protobuf:"varint,4,opt,name=points,json=Points,proto3" json:"points,omitempty"`
+	// Number of pit stops made
+	NumPitStops uint32 `protobuf:"varint,5,opt,name=num_pit_stops,json=NumPitStops,proto3" json:"num_pit_stops,omitempty"`
+	// Result status
+	// 0 = invalid, 1 = inactive, 2 = active
+	// 3 = finished, 4 = disqualified, 5 = not classified
+	// 6 = retired
+	ResultStatus uint32 `protobuf:"varint,6,opt,name=result_status,json=ResultStatus,proto3" json:"result_status,omitempty"`
+	// Best lap time of the session in seconds
+	BestLapTime float32 `protobuf:"fixed32,7,opt,name=best_lap_time,json=BestLapTime,proto3" json:"best_lap_time,omitempty"`
+	// Total race time in seconds without penalties
+	TotalRaceTime float32 `protobuf:"fixed32,8,opt,name=total_race_time,json=TotalRaceTime,proto3" json:"total_race_time,omitempty"`
+	// Total penalties accumulated in seconds
+	PenaltiesTime uint32 `protobuf:"varint,9,opt,name=penalties_time,json=PenaltiesTime,proto3" json:"penalties_time,omitempty"`
+	// Number of penalties applied to this driver
+	NumPenalties uint32 `protobuf:"varint,10,opt,name=num_penalties,json=NumPenalties,proto3" json:"num_penalties,omitempty"`
+	// Number of tyres stints up to maximum
+	NumTyreStints uint32 `protobuf:"varint,11,opt,name=num_tyre_stints,json=NumTyreStints,proto3" json:"num_tyre_stints,omitempty"`
+	// Actual tyres used by this driver
+	//
+	// 8 values
+	TyreStintsActual []uint32 `protobuf:"varint,12,rep,packed,name=tyre_stints_actual,json=TyreStintsActual,proto3" json:"tyre_stints_actual,omitempty"`
+	// Visual tyres used by this driver
+	//
+	// 8 values
+	TyreStintsVisual []uint32 `protobuf:"varint,13,rep,packed,name=tyre_stints_visual,json=TyreStintsVisual,proto3" json:"tyre_stints_visual,omitempty"`
+}
+
+func (x *PacketFinalClassificationData) Reset() {
+	*x = PacketFinalClassificationData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketFinalClassificationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketFinalClassificationData) ProtoMessage() {}
+
+func (x *PacketFinalClassificationData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketFinalClassificationData.ProtoReflect.Descriptor instead.
+func (*PacketFinalClassificationData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PacketFinalClassificationData) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PacketFinalClassificationData) GetNumLaps() uint32 {
+	if x != nil {
+		return x.NumLaps
+	}
+	return 0
+}
+
+func (x *PacketFinalClassificationData) GetGridPosition() uint32 {
+	if x != nil {
+		return x.GridPosition
+	}
+	return 0
+}
+
+func (x *PacketFinalClassificationData) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *PacketFinalClassificationData) GetNumPitStops() uint32 {
+	if x != nil {
+		return x.NumPitStops
+	}
+	return 0
+}
+
+func (x *PacketFinalClassificationData) GetResultStatus() uint32 {
+	if x != nil {
+		return x.ResultStatus
+	}
+	return 0
+}
+
+func (x *PacketFinalClassificationData) GetBestLapTime() float32 {
+	if x != nil {
+		return x.BestLapTime
+	}
+	return 0
+}
+
+func (x *PacketFinalClassificationData) GetTotalRaceTime() float32 {
+	if x != nil {
+		return x.TotalRaceTime
+	}
+	return 0
+}
+
+func (x *PacketFinalClassificationData) GetPenaltiesTime() uint32 {
+	if x != nil {
+		return x.PenaltiesTime
+	}
+	return 0
+}
+
+func (x *PacketFinalClassificationData) GetNumPenalties() uint32 {
+	if x != nil {
+		return x.NumPenalties
+	}
+	return 0
+}
+
+func (x *PacketFinalClassificationData) GetNumTyreStints() uint32 {
+	if x != nil {
+		return x.NumTyreStints
+	}
+	return 0
+}
+
+func (x *PacketFinalClassificationData) GetTyreStintsActual() []uint32 {
+	if x != nil {
+		return x.TyreStintsActual
+	}
+	return nil
+}
+
+func (x *PacketFinalClassificationData) GetTyreStintsVisual() []uint32 {
+	if x != nil {
+		return x.TyreStintsVisual
+	}
+	return nil
+}
+
+// PacketLobbyInfoData details the player in the lobby
+type PacketLobbyInfoData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the vehicle is AI (1) or Human (0) controlled
+	AiControlled uint32 `protobuf:"varint,1,opt,name=ai_controlled,json=AIControlled,proto3" json:"ai_controlled,omitempty"`
+	// Team id - see appendix (255 if no team currently selected)
+	TeamId uint32 `protobuf:"varint,2,opt,name=team_id,json=TeamID,proto3" json:"team_id,omitempty"`
+	// Nationality of the driver
+	Nationality uint32 `protobuf:"varint,3,opt,name=nationality,json=Nationality,proto3" json:"nationality,omitempty"`
+	// Name of participant in UTF-8 format – null terminated
+	// Will be truncated with ... (U+2026) if too long
+	Name string `protobuf:"bytes,4,opt,name=name,json=Name,proto3" json:"name,omitempty"`
+	// 0 = not ready, 1 = ready, 2 = spectating
+	ReadyStatus uint32 `protobuf:"varint,5,opt,name=ready_status,json=ReadyStatus,proto3" json:"ready_status,omitempty"`
+}
+
+func (x *PacketLobbyInfoData) Reset() {
+	*x = PacketLobbyInfoData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketLobbyInfoData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketLobbyInfoData) ProtoMessage() {}
+
+func (x *PacketLobbyInfoData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketLobbyInfoData.ProtoReflect.Descriptor instead.
+func (*PacketLobbyInfoData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PacketLobbyInfoData) GetAiControlled() uint32 {
+	if x != nil {
+		return x.AiControlled
+	}
+	return 0
+}
+
+func (x *PacketLobbyInfoData) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *PacketLobbyInfoData) GetNationality() uint32 {
+	if x != nil {
+		return x.Nationality
+	}
+	return 0
+}
+
+func (x *PacketLobbyInfoData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PacketLobbyInfoData) GetReadyStatus() uint32 {
+	if x != nil {
+		return x.ReadyStatus
+	}
+	return 0
+}
+
+// StintLap details a lap completed during a stint
+type StintLap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Lap number
+	LapNum uint32 `protobuf:"varint,1,opt,name=lap_num,json=LapNum,proto3" json:"lap_num,omitempty"`
+	// Lap time in seconds
+	LapTime float32 `protobuf:"fixed32,2,opt,name=lap_time,json=LapTime,proto3" json:"lap_time,omitempty"`
+	// Whether the car went through the pit lane during the lap
+	PitLap bool `protobuf:"varint,3,opt,name=pit_lap,json=PitLap,proto3" json:"pit_lap,omitempty"`
+	// Tyre wear percentage at the end of the lap
+	RearLeftTyresWear   uint32  `protobuf:"varint,4,opt,name=rear_left_tyres_wear,json=RearLeftTyresWear,proto3" json:"rear_left_tyres_wear,omitempty"`
+	RearRightTyresWear  uint32  `protobuf:"varint,5,opt,name=rear_right_tyres_wear,json=RearRightTyresWear,proto3" json:"rear_right_tyres_wear,omitempty"`
+	FrontLeftTyresWear  uint32  `protobuf:"varint,6,opt,name=front_left_tyres_wear,json=FrontLeftTyresWear,proto3" json:"front_left_tyres_wear,omitempty"`
+	FrontRightTyresWear uint32  `protobuf:"varint,7,opt,name=front_right_tyres_wear,json=FrontRightTyresWear,proto3" json:"front_right_tyres_wear,omitempty"`
+	AverageTyresWear    float32 `protobuf:"fixed32,8,opt,name=average_tyres_wear,json=AverageTyresWear,proto3" json:"average_tyres_wear,omitempty"`
+}
+
+func (x *StintLap) Reset() {
+	*x = StintLap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StintLap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StintLap) ProtoMessage() {}
+
+func (x *StintLap) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StintLap.ProtoReflect.Descriptor instead.
+func (*StintLap) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StintLap) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *StintLap) GetLapTime() float32 {
+	if x != nil {
+		return x.LapTime
+	}
+	return 0
+}
+
+func (x *StintLap) GetPitLap() bool {
+	if x != nil {
+		return x.PitLap
+	}
+	return false
+}
+
+func (x *StintLap) GetRearLeftTyresWear() uint32 {
+	if x != nil {
+		return x.RearLeftTyresWear
+	}
+	return 0
+}
+
+func (x *StintLap) GetRearRightTyresWear() uint32 {
+	if x != nil {
+		return x.RearRightTyresWear
+	}
+	return 0
+}
+
+func (x *StintLap) GetFrontLeftTyresWear() uint32 {
+	if x != nil {
+		return x.FrontLeftTyresWear
+	}
+	return 0
+}
+
+func (x *StintLap) GetFrontRightTyresWear() uint32 {
+	if x != nil {
+		return x.FrontRightTyresWear
+	}
+	return 0
+}
+
+func (x *StintLap) GetAverageTyresWear() float32 {
+	if x != nil {
+		return x.AverageTyresWear
+	}
+	return 0
+}
+
+// FuelMixUsage details the fuel used with a fuel mix
+type FuelMixUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FuelMix     uint32 `protobuf:"varint,1,opt,name=fuel_mix,json=FuelMix,proto3" json:"fuel_mix,omitempty"`
+	FuelMixName string `protobuf:"bytes,2,opt,name=fuel_mix_name,json=FuelMixName,proto3" json:"fuel_mix_name,omitempty"`
+	// Fraction of laps driven with the mix
+	Laps float32 `protobuf:"fixed32,3,opt,name=laps,json=Laps,proto3" json:"laps,omitempty"`
+	// Fuel used with the mix
+	FuelUsed float32 `protobuf:"fixed32,4,opt,name=fuel_used,json=FuelUsed,proto3" json:"fuel_used,omitempty"`
+	// Fuel used per lap with the mix, 0 if it was not used long enough to tell
+	FuelPerLap float32 `protobuf:"fixed32,5,opt,name=fuel_per_lap,json=FuelPerLap,proto3" json:"fuel_per_lap,omitempty"`
+}
+
+func (x *FuelMixUsage) Reset() {
+	*x = FuelMixUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FuelMixUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelMixUsage) ProtoMessage() {}
+
+func (x *FuelMixUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelMixUsage.ProtoReflect.Descriptor instead.
+func (*FuelMixUsage) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *FuelMixUsage) GetFuelMix() uint32 {
+	if x != nil {
+		return x.FuelMix
+	}
+	return 0
+}
+
+func (x *FuelMixUsage) GetFuelMixName() string {
+	if x != nil {
+		return x.FuelMixName
+	}
+	return ""
+}
+
+func (x *FuelMixUsage) GetLaps() float32 {
+	if x != nil {
+		return x.Laps
+	}
+	return 0
+}
+
+func (x *FuelMixUsage) GetFuelUsed() float32 {
+	if x != nil {
+		return x.FuelUsed
+	}
+	return 0
+}
+
+func (x *FuelMixUsage) GetFuelPerLap() float32 {
+	if x != nil {
+		return x.FuelPerLap
+	}
+	return 0
+}
+
+// PitStopTyres details the tyres fitted on a car
+type PitStopTyres struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActualTyreCompound     uint32 `protobuf:"varint,1,opt,name=actual_tyre_compound,json=ActualTyreCompound,proto3" json:"actual_tyre_compound,omitempty"`
+	ActualTyreCompoundName string `protobuf:"bytes,2,opt,name=actual_tyre_compound_name,json=ActualTyreCompoundName,proto3" json:"actual_tyre_compound_name,omitempty"`
+	VisualTyreCompound     uint32 `protobuf:"varint,3,opt,name=visual_tyre_compound,json=VisualTyreCompound,proto3" json:"visual_tyre_compound,omitempty"`
+	VisualTyreCompoundName string `protobuf:"bytes,4,opt,name=visual_tyre_compound_name,json=VisualTyreCompoundName,proto3" json:"visual_tyre_compound_name,omitempty"`
+	// Age in laps of the set of tyres
+	TyresAgeLaps uint32 `protobuf:"varint,5,opt,name=tyres_age_laps,json=TyresAgeLaps,proto3" json:"tyres_age_laps,omitempty"`
+}
+
+func (x *PitStopTyres) Reset() {
+	*x = PitStopTyres{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PitStopTyres) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PitStopTyres) ProtoMessage() {}
+
+func (x *PitStopTyres) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PitStopTyres.ProtoReflect.Descriptor instead.
+func (*PitStopTyres) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PitStopTyres) GetActualTyreCompound() uint32 {
+	if x != nil {
+		return x.ActualTyreCompound
+	}
+	return 0
+}
+
+func (x *PitStopTyres) GetActualTyreCompoundName() string {
+	if x != nil {
+		return x.ActualTyreCompoundName
+	}
+	return ""
+}
+
+func (x *PitStopTyres) GetVisualTyreCompound() uint32 {
+	if x != nil {
+		return x.VisualTyreCompound
+	}
+	return 0
+}
+
+func (x *PitStopTyres) GetVisualTyreCompoundName() string {
+	if x != nil {
+		return x.VisualTyreCompoundName
+	}
+	return ""
+}
+
+func (x *PitStopTyres) GetTyresAgeLaps() uint32 {
+	if x != nil {
+		return x.TyresAgeLaps
+	}
+	return 0
+}
+
+// TrackMapPoint is a point of the centreline of a track
+type TrackMapPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Distance from the start line in metres
+	Distance float32 `protobuf:"fixed32,1,opt,name=distance,json=Distance,proto3" json:"distance,omitempty"`
+	// World space X position
+	X float32 `protobuf:"fixed32,2,opt,name=x,json=X,proto3" json:"x,omitempty"`
+	// World space Y position
+	Y float32 `protobuf:"fixed32,3,opt,name=y,json=Y,proto3" json:"y,omitempty"`
+	// World space Z position
+	Z float32 `protobuf:"fixed32,4,opt,name=z,json=Z,proto3" json:"z,omitempty"`
+}
+
+func (x *TrackMapPoint) Reset() {
+	*x = TrackMapPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackMapPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackMapPoint) ProtoMessage() {}
+
+func (x *TrackMapPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackMapPoint.ProtoReflect.Descriptor instead.
+func (*TrackMapPoint) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TrackMapPoint) GetDistance() float32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *TrackMapPoint) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *TrackMapPoint) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *TrackMapPoint) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+// CarOnTrack places a car on the map of the track
+type CarOnTrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,1,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// Car race position
+	RacePosition uint32 `protobuf:"varint,2,opt,name=race_position,json=RacePosition,proto3" json:"race_position,omitempty"`
+	// Current lap number
+	LapNum uint32 `protobuf:"varint,3,opt,name=lap_num,json=LapNum,proto3" json:"lap_num,omitempty"`
+	// Distance around the current lap in metres
+	LapDistance float32 `protobuf:"fixed32,4,opt,name=lap_distance,json=LapDistance,proto3" json:"lap_distance,omitempty"`
+	// Fraction (0..1) of the lap completed
+	LapFraction float32 `protobuf:"fixed32,5,opt,name=lap_fraction,json=LapFraction,proto3" json:"lap_fraction,omitempty"`
+	// 0 = none, 1 = pitting, 2 = in pit area
+	PitStatus uint32 `protobuf:"varint,6,opt,name=pit_status,json=PitStatus,proto3" json:"pit_status,omitempty"`
+	// World space X position
+	WorldPositionX float32 `protobuf:"fixed32,7,opt,name=world_position_x,json=WorldPositionX,proto3" json:"world_position_x,omitempty"`
+	// World space Y position
+	WorldPositionY float32 `protobuf:"fixed32,8,opt,name=world_position_y,json=WorldPositionY,proto3" json:"world_position_y,omitempty"`
+	// World space Z position
+	WorldPositionZ float32 `protobuf:"fixed32,9,opt,name=world_position_z,json=WorldPositionZ,proto3" json:"world_position_z,omitempty"`
+	// Position on the map, as a fraction (0..1) of its width and height, see trackmap.Map.SVG
+	// Only set once a map of the track is available.
+	OnMap bool    `protobuf:"varint,10,opt,name=on_map,json=OnMap,proto3" json:"on_map,omitempty"`
+	MapX  float32 `protobuf:"fixed32,11,opt,name=map_x,json=MapX,proto3" json:"map_x,omitempty"`
+	MapY  float32 `protobuf:"fixed32,12,opt,name=map_y,json=MapY,proto3" json:"map_y,omitempty"`
+}
+
+func (x *CarOnTrack) Reset() {
+	*x = CarOnTrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarOnTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarOnTrack) ProtoMessage() {}
+
+func (x *CarOnTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarOnTrack.ProtoReflect.Descriptor instead.
+func (*CarOnTrack) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CarOnTrack) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *CarOnTrack) GetRacePosition() uint32 {
+	if x != nil {
+		return x.RacePosition
+	}
+	return 0
+}
+
+func (x *CarOnTrack) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *CarOnTrack) GetLapDistance() float32 {
+	if x != nil {
+		return x.LapDistance
+	}
+	return 0
+}
+
+func (x *CarOnTrack) GetLapFraction() float32 {
+	if x != nil {
+		return x.LapFraction
+	}
+	return 0
+}
+
+func (x *CarOnTrack) GetPitStatus() uint32 {
+	if x != nil {
+		return x.PitStatus
+	}
+	return 0
+}
+
+func (x *CarOnTrack) GetWorldPositionX() float32 {
+	if x != nil {
+		return x.WorldPositionX
+	}
+	return 0
+}
+
+func (x *CarOnTrack) GetWorldPositionY() float32 {
+	if x != nil {
+		return x.WorldPositionY
+	}
+	return 0
+}
+
+func (x *CarOnTrack) GetWorldPositionZ() float32 {
+	if x != nil {
+		return x.WorldPositionZ
+	}
+	return 0
+}
+
+func (x *CarOnTrack) GetOnMap() bool {
+	if x != nil {
+		return x.OnMap
+	}
+	return false
+}
+
+func (x *CarOnTrack) GetMapX() float32 {
+	if x != nil {
+		return x.MapX
+	}
+	return 0
+}
+
+func (x *CarOnTrack) GetMapY() float32 {
+	if x != nil {
+		return x.MapY
+	}
+	return 0
+}
+
+// TimingEntry is the line of a car in the timing tower
+type TimingEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Race position
+	Position uint32 `protobuf:"varint,1,opt,name=position,json=Position,proto3" json:"position,omitempty"`
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,2,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// Current lap number
+	LapNum uint32 `protobuf:"varint,3,opt,name=lap_num,json=LapNum,proto3" json:"lap_num,omitempty"`
+	// Distance around the current lap in metres
+	LapDistance float32 `protobuf:"fixed32,4,opt,name=lap_distance,json=LapDistance,proto3" json:"lap_distance,omitempty"`
+	// Total distance travelled in the session in metres
+	TotalDistance float32 `protobuf:"fixed32,5,opt,name=total_distance,json=TotalDistance,proto3" json:"total_distance,omitempty"`
+	// Time in seconds behind the leader, 0 for the leader
+	GapToLeader float32 `protobuf:"fixed32,6,opt,name=gap_to_leader,json=GapToLeader,proto3" json:"gap_to_leader,omitempty"`
+	// Number of laps behind the leader
+	LapsDown int32 `protobuf:"varint,7,opt,name=laps_down,json=LapsDown,proto3" json:"laps_down,omitempty"`
+	// Time in seconds behind the car ahead, 0 for the leader
+	Interval float32 `protobuf:"fixed32,8,opt,name=interval,json=Interval,proto3" json:"interval,omitempty"`
+	// Number of laps behind the car ahead
+	IntervalLapsDown int32 `protobuf:"varint,9,opt,name=interval_laps_down,json=IntervalLapsDown,proto3" json:"interval_laps_down,omitempty"`
+	// Whether the car is in the pit lane
+	InPit bool `protobuf:"varint,10,opt,name=in_pit,json=InPit,proto3" json:"in_pit,omitempty"`
+	// Last lap time in seconds
+	LastLapTime float32 `protobuf:"fixed32,11,opt,name=last_lap_time,json=LastLapTime,proto3" json:"last_lap_time,omitempty"`
+	// Best lap time of the session in seconds
+	BestLapTime float32 `protobuf:"fixed32,12,opt,name=best_lap_time,json=BestLapTime,proto3" json:"best_lap_time,omitempty"`
+	// 2 = active, 3 = finished, 4 = disqualified, 5 = not classified, 6 = retired
+	ResultStatus uint32 `protobuf:"varint,13,opt,name=result_status,json=ResultStatus,proto3" json:"result_status,omitempty"`
+}
+
+func (x *TimingEntry) Reset() {
+	*x = TimingEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimingEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimingEntry) ProtoMessage() {}
+
+func (x *TimingEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimingEntry.ProtoReflect.Descriptor instead.
+func (*TimingEntry) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TimingEntry) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *TimingEntry) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *TimingEntry) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *TimingEntry) GetLapDistance() float32 {
+	if x != nil {
+		return x.LapDistance
+	}
+	return 0
+}
+
+func (x *TimingEntry) GetTotalDistance() float32 {
+	if x != nil {
+		return x.TotalDistance
+	}
+	return 0
+}
+
+func (x *TimingEntry) GetGapToLeader() float32 {
+	if x != nil {
+		return x.GapToLeader
+	}
+	return 0
+}
+
+func (x *TimingEntry) GetLapsDown() int32 {
+	if x != nil {
+		return x.LapsDown
+	}
+	return 0
+}
+
+func (x *TimingEntry) GetInterval() float32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *TimingEntry) GetIntervalLapsDown() int32 {
+	if x != nil {
+		return x.IntervalLapsDown
+	}
+	return 0
+}
+
+func (x *TimingEntry) GetInPit() bool {
+	if x != nil {
+		return x.InPit
+	}
+	return false
+}
+
+func (x *TimingEntry) GetLastLapTime() float32 {
+	if x != nil {
+		return x.LastLapTime
+	}
+	return 0
+}
+
+func (x *TimingEntry) GetBestLapTime() float32 {
+	if x != nil {
+		return x.BestLapTime
+	}
+	return 0
+}
+
+func (x *TimingEntry) GetResultStatus() uint32 {
+	if x != nil {
+		return x.ResultStatus
+	}
+	return 0
+}
+
+// IncidentSample is the state of a car around an incident
+type IncidentSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Session timestamp
+	SessionTime float32 `protobuf:"fixed32,1,opt,name=session_time,json=SessionTime,proto3" json:"session_time,omitempty"`
+	// Distance around the lap in metres
+	LapDistance float32 `protobuf:"fixed32,2,opt,name=lap_distance,json=LapDistance,proto3" json:"lap_distance,omitempty"`
+	// Speed of car in kilometres per hour
+	Speed float32 `protobuf:"fixed32,3,opt,name=speed,json=Speed,proto3" json:"speed,omitempty"`
+	// Amount of throttle applied (0.0 to 1.0)
+	Throttle float32 `protobuf:"fixed32,4,opt,name=throttle,json=Throttle,proto3" json:"throttle,omitempty"`
+	// Amount of brake applied (0.0 to 1.0)
+	Brake float32 `protobuf:"fixed32,5,opt,name=brake,json=Brake,proto3" json:"brake,omitempty"`
+	// Steering (-1.0 (full lock left) to 1.0 (full lock right))
+	Steer float32 `protobuf:"fixed32,6,opt,name=steer,json=Steer,proto3" json:"steer,omitempty"`
+	// Gear selected (1-8, N=0, R=-1)
+	Gear int32 `protobuf:"varint,7,opt,name=gear,json=Gear,proto3" json:"gear,omitempty"`
+	// Yaw angle in radians
+	Yaw float32 `protobuf:"fixed32,8,opt,name=yaw,json=Yaw,proto3" json:"yaw,omitempty"`
+	// Angle between the heading and the direction of travel in degrees
+	SlipAngle float32 `protobuf:"fixed32,9,opt,name=slip_angle,json=SlipAngle,proto3" json:"slip_angle,omitempty"`
+	// Lateral G-Force component
+	GForceLateral float32 `protobuf:"fixed32,10,opt,name=g_force_lateral,json=GForceLateral,proto3" json:"g_force_lateral,omitempty"`
+	// Longitudinal G-Force component
+	GForceLongitudinal float32 `protobuf:"fixed32,11,opt,name=g_force_longitudinal,json=GForceLongitudinal,proto3" json:"g_force_longitudinal,omitempty"`
+	// World space X position
+	WorldPositionX float32 `protobuf:"fixed32,12,opt,name=world_position_x,json=WorldPositionX,proto3" json:"world_position_x,omitempty"`
+	// World space Z position
+	WorldPositionZ float32 `protobuf:"fixed32,13,opt,name=world_position_z,json=WorldPositionZ,proto3" json:"world_position_z,omitempty"`
+	// Driving surface of each wheel: RL, RR, FL, FR
+	//
+	// 4 values
+	Surfaces []uint32 `protobuf:"varint,14,rep,packed,name=surfaces,json=Surfaces,proto3" json:"surfaces,omitempty"`
+}
+
+func (x *IncidentSample) Reset() {
+	*x = IncidentSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncidentSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncidentSample) ProtoMessage() {}
+
+func (x *IncidentSample) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncidentSample.ProtoReflect.Descriptor instead.
+func (*IncidentSample) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *IncidentSample) GetSessionTime() float32 {
+	if x != nil {
+		return x.SessionTime
+	}
+	return 0
+}
+
+func (x *IncidentSample) GetLapDistance() float32 {
+	if x != nil {
+		return x.LapDistance
+	}
+	return 0
+}
+
+func (x *IncidentSample) GetSpeed() float32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *IncidentSample) GetThrottle() float32 {
+	if x != nil {
+		return x.Throttle
+	}
+	return 0
+}
+
+func (x *IncidentSample) GetBrake() float32 {
+	if x != nil {
+		return x.Brake
+	}
+	return 0
+}
+
+func (x *IncidentSample) GetSteer() float32 {
+	if x != nil {
+		return x.Steer
+	}
+	return 0
+}
+
+func (x *IncidentSample) GetGear() int32 {
+	if x != nil {
+		return x.Gear
+	}
+	return 0
+}
+
+func (x *IncidentSample) GetYaw() float32 {
+	if x != nil {
+		return x.Yaw
+	}
+	return 0
+}
+
+func (x *IncidentSample) GetSlipAngle() float32 {
+	if x != nil {
+		return x.SlipAngle
+	}
+	return 0
+}
+
+func (x *IncidentSample) GetGForceLateral() float32 {
+	if x != nil {
+		return x.GForceLateral
+	}
+	return 0
+}
+
+func (x *IncidentSample) GetGForceLongitudinal() float32 {
+	if x != nil {
+		return x.GForceLongitudinal
+	}
+	return 0
+}
+
+func (x *IncidentSample) GetWorldPositionX() float32 {
+	if x != nil {
+		return x.WorldPositionX
+	}
+	return 0
+}
+
+func (x *IncidentSample) GetWorldPositionZ() float32 {
+	if x != nil {
+		return x.WorldPositionZ
+	}
+	return 0
+}
+
+func (x *IncidentSample) GetSurfaces() []uint32 {
+	if x != nil {
+		return x.Surfaces
+	}
+	return nil
+}
+
+// WeatherSample is the weather of a session from a point in time
+type WeatherSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Session time the weather changed at
+	SessionTime float32 `protobuf:"fixed32,1,opt,name=session_time,json=SessionTime,proto3" json:"session_time,omitempty"`
+	// 0 = clear, 1 = light cloud, 2 = overcast, 3 = light rain, 4 = heavy rain, 5 = storm
+	Weather     uint32 `protobuf:"varint,2,opt,name=weather,json=Weather,proto3" json:"weather,omitempty"`
+	WeatherName string `protobuf:"bytes,3,opt,name=weather_name,json=WeatherName,proto3" json:"weather_name,omitempty"`
+	// Track temp. in degrees celsius
+	TrackTemperature int32 `protobuf:"varint,4,opt,name=track_temperature,json=TrackTemperature,proto3" json:"track_temperature,omitempty"`
+	// Air temp. in degrees celsius
+	AirTemperature int32 `protobuf:"varint,5,opt,name=air_temperature,json=AirTemperature,proto3" json:"air_temperature,omitempty"`
+}
+
+func (x *WeatherSample) Reset() {
+	*x = WeatherSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeatherSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeatherSample) ProtoMessage() {}
+
+func (x *WeatherSample) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeatherSample.ProtoReflect.Descriptor instead.
+func (*WeatherSample) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *WeatherSample) GetSessionTime() float32 {
+	if x != nil {
+		return x.SessionTime
+	}
+	return 0
+}
+
+func (x *WeatherSample) GetWeather() uint32 {
+	if x != nil {
+		return x.Weather
+	}
+	return 0
+}
+
+func (x *WeatherSample) GetWeatherName() string {
+	if x != nil {
+		return x.WeatherName
+	}
+	return ""
+}
+
+func (x *WeatherSample) GetTrackTemperature() int32 {
+	if x != nil {
+		return x.TrackTemperature
+	}
+	return 0
+}
+
+func (x *WeatherSample) GetAirTemperature() int32 {
+	if x != nil {
+		return x.AirTemperature
+	}
+	return 0
+}
+
+// DriverResult is the result of a car at the end of a session
+type DriverResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Index of the car in the session
+	CarIndex     uint32 `protobuf:"varint,1,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,json=Name,proto3" json:"name,omitempty"`
+	Team         string `protobuf:"bytes,3,opt,name=team,json=Team,proto3" json:"team,omitempty"`
+	RaceNumber   uint32 `protobuf:"varint,4,opt,name=race_number,json=RaceNumber,proto3" json:"race_number,omitempty"`
+	AiControlled bool   `protobuf:"varint,5,opt,name=ai_controlled,json=AiControlled,proto3" json:"ai_controlled,omitempty"`
+	// Finishing position
+	Position uint32 `protobuf:"varint,6,opt,name=position,json=Position,proto3" json:"position,omitempty"`
+	// Grid position of the car, 0 if unknown
+	GridPosition uint32 `protobuf:"varint,7,opt,name=grid_position,json=GridPosition,proto3" json:"grid_position,omitempty"`
+	// Number of laps completed
+	Laps   uint32 `protobuf:"varint,8,opt,name=laps,json=Laps,proto3" json:"laps,omitempty"`
+	Status string `protobuf:"bytes,9,opt,name=status,json=Status,proto3" json:"status,omitempty"`
+	Points uint32 `protobuf:"varint,10,opt,name=points,json=Points,proto3" json:"points,omitempty"`
+	// Total race time in seconds without penalties, 0 if unknown
+	TotalRaceTime float32 `protobuf:"fixed32,11,opt,name=total_race_time,json=TotalRaceTime,proto3" json:"total_race_time,omitempty"`
+	// Best lap time of the session in seconds
+	BestLapTime float32 `protobuf:"fixed32,12,opt,name=best_lap_time,json=BestLapTime,proto3" json:"best_lap_time,omitempty"`
+	// Lap number the best lap was set on, 0 if unknown
+	BestLapNum uint32 `protobuf:"varint,13,opt,name=best_lap_num,json=BestLapNum,proto3" json:"best_lap_num,omitempty"`
+	// Number of pit stops
+	PitStops int32 `protobuf:"varint,14,opt,name=pit_stops,json=PitStops,proto3" json:"pit_stops,omitempty"`
+	// Laps the car entered the pit lane on
+	PitStopLaps []byte `protobuf:"bytes,15,opt,name=pit_stop_laps,json=PitStopLaps,proto3" json:"pit_stop_laps,omitempty"`
+	// Number of penalties
+	Penalties uint32 `protobuf:"varint,16,opt,name=penalties,json=Penalties,proto3" json:"penalties,omitempty"`
+	// Time penalties in seconds
+	PenaltiesTime uint32 `protobuf:"varint,17,opt,name=penalties_time,json=PenaltiesTime,proto3" json:"penalties_time,omitempty"`
+	// Visual compound of every tyre stint
+	TyreStints []string `protobuf:"bytes,18,rep,name=tyre_stints,json=TyreStints,proto3" json:"tyre_stints,omitempty"`
+	// Actual compound of every tyre stint
+	TyreStintsActual []string `protobuf:"bytes,19,rep,name=tyre_stints_actual,json=TyreStintsActual,proto3" json:"tyre_stints_actual,omitempty"`
+}
+
+func (x *DriverResult) Reset() {
+	*x = DriverResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DriverResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverResult) ProtoMessage() {}
+
+func (x *DriverResult) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverResult.ProtoReflect.Descriptor instead.
+func (*DriverResult) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DriverResult) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *DriverResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DriverResult) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *DriverResult) GetRaceNumber() uint32 {
+	if x != nil {
+		return x.RaceNumber
+	}
+	return 0
+}
+
+func (x *DriverResult) GetAiControlled() bool {
+	if x != nil {
+		return x.AiControlled
+	}
+	return false
+}
+
+func (x *DriverResult) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *DriverResult) GetGridPosition() uint32 {
+	if x != nil {
+		return x.GridPosition
+	}
+	return 0
+}
+
+func (x *DriverResult) GetLaps() uint32 {
+	if x != nil {
+		return x.Laps
+	}
+	return 0
+}
+
+func (x *DriverResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DriverResult) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *DriverResult) GetTotalRaceTime() float32 {
+	if x != nil {
+		return x.TotalRaceTime
+	}
+	return 0
+}
+
+func (x *DriverResult) GetBestLapTime() float32 {
+	if x != nil {
+		return x.BestLapTime
+	}
+	return 0
+}
+
+func (x *DriverResult) GetBestLapNum() uint32 {
+	if x != nil {
+		return x.BestLapNum
+	}
+	return 0
+}
+
+func (x *DriverResult) GetPitStops() int32 {
+	if x != nil {
+		return x.PitStops
+	}
+	return 0
+}
+
+func (x *DriverResult) GetPitStopLaps() []byte {
+	if x != nil {
+		return x.PitStopLaps
+	}
+	return nil
+}
+
+func (x *DriverResult) GetPenalties() uint32 {
+	if x != nil {
+		return x.Penalties
+	}
+	return 0
+}
+
+func (x *DriverResult) GetPenaltiesTime() uint32 {
+	if x != nil {
+		return x.PenaltiesTime
+	}
+	return 0
+}
+
+func (x *DriverResult) GetTyreStints() []string {
+	if x != nil {
+		return x.TyreStints
+	}
+	return nil
+}
+
+func (x *DriverResult) GetTyreStintsActual() []string {
+	if x != nil {
+		return x.TyreStintsActual
+	}
+	return nil
+}
+
+// Corner is a corner of a track, distances are from the start line in metres
+type Corner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Corner number, starting at 1 from the start line
+	Number int32 `protobuf:"varint,1,opt,name=number,json=Number,proto3" json:"number,omitempty"`
+	// left or right
+	Direction string `protobuf:"bytes,2,opt,name=direction,json=Direction,proto3" json:"direction,omitempty"`
+	// Distance the corner starts at
+	Entry float32 `protobuf:"fixed32,3,opt,name=entry,json=Entry,proto3" json:"entry,omitempty"`
+	// Distance of the minimum speed
+	Apex float32 `protobuf:"fixed32,4,opt,name=apex,json=Apex,proto3" json:"apex,omitempty"`
+	// Distance the corner ends at
+	Exit float32 `protobuf:"fixed32,5,opt,name=exit,json=Exit,proto3" json:"exit,omitempty"`
+}
+
+func (x *Corner) Reset() {
+	*x = Corner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Corner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Corner) ProtoMessage() {}
+
+func (x *Corner) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Corner.ProtoReflect.Descriptor instead.
+func (*Corner) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Corner) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Corner) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *Corner) GetEntry() float32 {
+	if x != nil {
+		return x.Entry
+	}
+	return 0
+}
+
+func (x *Corner) GetApex() float32 {
+	if x != nil {
+		return x.Apex
+	}
+	return 0
+}
+
+func (x *Corner) GetExit() float32 {
+	if x != nil {
+		return x.Exit
+	}
+	return 0
+}
+
+// CornerReport details how a car went through a corner during a lap
+type CornerReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number    int32  `protobuf:"varint,1,opt,name=number,json=Number,proto3" json:"number,omitempty"`
+	Direction string `protobuf:"bytes,2,opt,name=direction,json=Direction,proto3" json:"direction,omitempty"`
+	// Whether the car braked for the corner
+	Braking bool `protobuf:"varint,3,opt,name=braking,json=Braking,proto3" json:"braking,omitempty"`
+	// Distance the car started braking at, in metres from the start line
+	BrakingPoint float32 `protobuf:"fixed32,4,opt,name=braking_point,json=BrakingPoint,proto3" json:"braking_point,omitempty"`
+	// Speed at the corner entry in kilometres per hour
+	EntrySpeed float32 `protobuf:"fixed32,5,opt,name=entry_speed,json=EntrySpeed,proto3" json:"entry_speed,omitempty"`
+	// Minimum speed in the corner in kilometres per hour
+	MinimumSpeed float32 `protobuf:"fixed32,6,opt,name=minimum_speed,json=MinimumSpeed,proto3" json:"minimum_speed,omitempty"`
+	// Distance of the minimum speed
+	MinimumSpeedDistance float32 `protobuf:"fixed32,7,opt,name=minimum_speed_distance,json=MinimumSpeedDistance,proto3" json:"minimum_speed_distance,omitempty"`
+	// Gear at the minimum speed
+	Gear int32 `protobuf:"varint,8,opt,name=gear,json=Gear,proto3" json:"gear,omitempty"`
+	// Speed at the corner exit in kilometres per hour
+	ExitSpeed float32 `protobuf:"fixed32,9,opt,name=exit_speed,json=ExitSpeed,proto3" json:"exit_speed,omitempty"`
+	// Time spent from the corner entry to its exit in seconds
+	Time float32 `protobuf:"fixed32,10,opt,name=time,json=Time,proto3" json:"time,omitempty"`
+	// Time lost compared to the best time of the car in the corner this session
+	TimeLost float32 `protobuf:"fixed32,11,opt,name=time_lost,json=TimeLost,proto3" json:"time_lost,omitempty"`
+	// Time lost compared to the best time of any car in the corner this session
+	TimeLostToSessionBest float32 `protobuf:"fixed32,12,opt,name=time_lost_to_session_best,json=TimeLostToSessionBest,proto3" json:"time_lost_to_session_best,omitempty"`
+}
+
+func (x *CornerReport) Reset() {
+	*x = CornerReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CornerReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CornerReport) ProtoMessage() {}
+
+func (x *CornerReport) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CornerReport.ProtoReflect.Descriptor instead.
+func (*CornerReport) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CornerReport) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *CornerReport) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *CornerReport) GetBraking() bool {
+	if x != nil {
+		return x.Braking
+	}
+	return false
+}
+
+func (x *CornerReport) GetBrakingPoint() float32 {
+	if x != nil {
+		return x.BrakingPoint
+	}
+	return 0
+}
+
+func (x *CornerReport) GetEntrySpeed() float32 {
+	if x != nil {
+		return x.EntrySpeed
+	}
+	return 0
+}
+
+func (x *CornerReport) GetMinimumSpeed() float32 {
+	if x != nil {
+		return x.MinimumSpeed
+	}
+	return 0
+}
+
+func (x *CornerReport) GetMinimumSpeedDistance() float32 {
+	if x != nil {
+		return x.MinimumSpeedDistance
+	}
+	return 0
+}
+
+func (x *CornerReport) GetGear() int32 {
+	if x != nil {
+		return x.Gear
+	}
+	return 0
+}
+
+func (x *CornerReport) GetExitSpeed() float32 {
+	if x != nil {
+		return x.ExitSpeed
+	}
+	return 0
+}
+
+func (x *CornerReport) GetTime() float32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *CornerReport) GetTimeLost() float32 {
+	if x != nil {
+		return x.TimeLost
+	}
+	return 0
+}
+
+func (x *CornerReport) GetTimeLostToSessionBest() float32 {
+	if x != nil {
+		return x.TimeLostToSessionBest
+	}
+	return 0
+}
+
+// DriverBest compares the best lap of a car with its theoretical best lap, the sum of its best sectors.
+type DriverBest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Index of the car in the session
+	CarIndex uint32 `protobuf:"varint,1,opt,name=car_index,json=CarIndex,proto3" json:"car_index,omitempty"`
+	// Number of valid laps
+	Laps int32 `protobuf:"varint,2,opt,name=laps,json=Laps,proto3" json:"laps,omitempty"`
+	// Best valid lap time in seconds
+	BestLapTime float32 `protobuf:"fixed32,3,opt,name=best_lap_time,json=BestLapTime,proto3" json:"best_lap_time,omitempty"`
+	// Lap number the best lap was set on
+	BestLapNum uint32 `protobuf:"varint,4,opt,name=best_lap_num,json=BestLapNum,proto3" json:"best_lap_num,omitempty"`
+	// Sector times of the best lap in seconds
+	BestLapSectors []float32 `protobuf:"fixed32,5,rep,packed,name=best_lap_sectors,json=BestLapSectors,proto3" json:"best_lap_sectors,omitempty"`
+	// Best time in every sector
+	BestSectors []*SectorBest `protobuf:"bytes,6,rep,name=best_sectors,json=BestSectors,proto3" json:"best_sectors,omitempty"`
+	// Sum of the best sectors in seconds
+	TheoreticalBestTime float32 `protobuf:"fixed32,7,opt,name=theoretical_best_time,json=TheoreticalBestTime,proto3" json:"theoretical_best_time,omitempty"`
+	// Time in seconds between the best lap and the theoretical best
+	Gap float32 `protobuf:"fixed32,8,opt,name=gap,json=Gap,proto3" json:"gap,omitempty"`
+	// Mini-sectors are timed on the complete laps only, the reference lap is the best complete lap.
+	// Empty when no complete lap was recorded.
+	MiniSectorsReferenceLapNum uint32 `protobuf:"varint,9,opt,name=mini_sectors_reference_lap_num,json=MiniSectorsReferenceLapNum,proto3" json:"mini_sectors_reference_lap_num,omitempty"`
+	// Reference lap time minus the time lost in every mini-sector
+	MiniSectorsTheoreticalBestTime float32 `protobuf:"fixed32,10,opt,name=mini_sectors_theoretical_best_time,json=MiniSectorsTheoreticalBestTime,proto3" json:"mini_sectors_theoretical_best_time,omitempty"`
+	// Time in seconds lost in the mini-sectors during the reference lap
+	MiniSectorsGap float32       `protobuf:"fixed32,11,opt,name=mini_sectors_gap,json=MiniSectorsGap,proto3" json:"mini_sectors_gap,omitempty"`
+	MiniSectors    []*MiniSector `protobuf:"bytes,12,rep,name=mini_sectors,json=MiniSectors,proto3" json:"mini_sectors,omitempty"`
+}
+
+func (x *DriverBest) Reset() {
+	*x = DriverBest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DriverBest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverBest) ProtoMessage() {}
+
+func (x *DriverBest) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverBest.ProtoReflect.Descriptor instead.
+func (*DriverBest) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DriverBest) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *DriverBest) GetLaps() int32 {
+	if x != nil {
+		return x.Laps
+	}
+	return 0
+}
+
+func (x *DriverBest) GetBestLapTime() float32 {
+	if x != nil {
+		return x.BestLapTime
+	}
+	return 0
+}
+
+func (x *DriverBest) GetBestLapNum() uint32 {
+	if x != nil {
+		return x.BestLapNum
+	}
+	return 0
+}
+
+func (x *DriverBest) GetBestLapSectors() []float32 {
+	if x != nil {
+		return x.BestLapSectors
+	}
+	return nil
+}
+
+func (x *DriverBest) GetBestSectors() []*SectorBest {
+	if x != nil {
+		return x.BestSectors
+	}
+	return nil
+}
+
+func (x *DriverBest) GetTheoreticalBestTime() float32 {
+	if x != nil {
+		return x.TheoreticalBestTime
+	}
+	return 0
+}
+
+func (x *DriverBest) GetGap() float32 {
+	if x != nil {
+		return x.Gap
+	}
+	return 0
+}
+
+func (x *DriverBest) GetMiniSectorsReferenceLapNum() uint32 {
+	if x != nil {
+		return x.MiniSectorsReferenceLapNum
+	}
+	return 0
+}
+
+func (x *DriverBest) GetMiniSectorsTheoreticalBestTime() float32 {
+	if x != nil {
+		return x.MiniSectorsTheoreticalBestTime
+	}
+	return 0
+}
+
+func (x *DriverBest) GetMiniSectorsGap() float32 {
+	if x != nil {
+		return x.MiniSectorsGap
+	}
+	return 0
+}
+
+func (x *DriverBest) GetMiniSectors() []*MiniSector {
+	if x != nil {
+		return x.MiniSectors
+	}
+	return nil
+}
+
+// Source identifies where a packet was received from.
+// When several rigs send to the same ingester, it is used to tell their documents apart.
+type Source struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address of the sender, ip:port
+	Address string `protobuf:"bytes,1,opt,name=address,json=Address,proto3" json:"address,omitempty"`
+	// Local UDP port the packet was received on
+	Port int32 `protobuf:"varint,2,opt,name=port,json=Port,proto3" json:"port,omitempty"`
+	// Rig name, empty if the address is not configured
+	Rig string `protobuf:"bytes,3,opt,name=rig,json=Rig,proto3" json:"rig,omitempty"`
+	// Driver name, empty if the address is not configured
+	Driver string `protobuf:"bytes,4,opt,name=driver,json=Driver,proto3" json:"driver,omitempty"`
+}
+
+func (x *Source) Reset() {
+	*x = Source{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Source) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Source) ProtoMessage() {}
+
+func (x *Source) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Source.ProtoReflect.Descriptor instead.
+func (*Source) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Source) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Source) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Source) GetRig() string {
+	if x != nil {
+		return x.Rig
+	}
+	return ""
+}
+
+func (x *Source) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+// SectorBest is the best time of a car in a sector
+type SectorBest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sector time in seconds
+	Time float32 `protobuf:"fixed32,1,opt,name=time,json=Time,proto3" json:"time,omitempty"`
+	// Lap number the time was set on
+	LapNum uint32 `protobuf:"varint,2,opt,name=lap_num,json=LapNum,proto3" json:"lap_num,omitempty"`
+}
+
+func (x *SectorBest) Reset() {
+	*x = SectorBest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SectorBest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectorBest) ProtoMessage() {}
+
+func (x *SectorBest) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectorBest.ProtoReflect.Descriptor instead.
+func (*SectorBest) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SectorBest) GetTime() float32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *SectorBest) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+// MiniSector is a part of the lap of equal length, timed from the lap distance
+type MiniSector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Mini-sector number, starting at 1
+	Number int32 `protobuf:"varint,1,opt,name=number,json=Number,proto3" json:"number,omitempty"`
+	// Start of the mini-sector in metres from the start line
+	From float32 `protobuf:"fixed32,2,opt,name=from,json=From,proto3" json:"from,omitempty"`
+	// End of the mini-sector in metres from the start line
+	To float32 `protobuf:"fixed32,3,opt,name=to,json=To,proto3" json:"to,omitempty"`
+	// Best time in seconds
+	BestTime float32 `protobuf:"fixed32,4,opt,name=best_time,json=BestTime,proto3" json:"best_time,omitempty"`
+	// Lap number the best time was set on
+	BestLapNum uint32 `protobuf:"varint,5,opt,name=best_lap_num,json=BestLapNum,proto3" json:"best_lap_num,omitempty"`
+	// Time in seconds during the reference lap, see DriverBest
+	LapTime float32 `protobuf:"fixed32,6,opt,name=lap_time,json=LapTime,proto3" json:"lap_time,omitempty"`
+	// Time in seconds lost compared to the best time during the reference lap
+	TimeToBest float32 `protobuf:"fixed32,7,opt,name=time_to_best,json=TimeToBest,proto3" json:"time_to_best,omitempty"`
+}
+
+func (x *MiniSector) Reset() {
+	*x = MiniSector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MiniSector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MiniSector) ProtoMessage() {}
+
+func (x *MiniSector) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MiniSector.ProtoReflect.Descriptor instead.
+func (*MiniSector) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MiniSector) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *MiniSector) GetFrom() float32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *MiniSector) GetTo() float32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *MiniSector) GetBestTime() float32 {
+	if x != nil {
+		return x.BestTime
+	}
+	return 0
+}
+
+func (x *MiniSector) GetBestLapNum() uint32 {
+	if x != nil {
+		return x.BestLapNum
+	}
+	return 0
+}
+
+func (x *MiniSector) GetLapTime() float32 {
+	if x != nil {
+		return x.LapTime
+	}
+	return 0
+}
+
+func (x *MiniSector) GetTimeToBest() float32 {
+	if x != nil {
+		return x.TimeToBest
+	}
+	return 0
+}
+
+var File_telemetry_proto protoreflect.FileDescriptor
+
+var file_telemetry_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0b, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6f, 0x0a,
+	0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x61, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x72, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x69, 0x67, 0x22, 0xb4,
+	0x0f, 0x0a, 0x08, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x69, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x4d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x48, 0x00, 0x52, 0x06, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x31,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x31, 0x0a, 0x08, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79,
+	0x2e, 0x4c, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x61, 0x70, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x31, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x5f,
+	0x73, 0x65, 0x74, 0x75, 0x70, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66,
+	0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x61, 0x72, 0x53, 0x65,
+	0x74, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x72, 0x53, 0x65,
+	0x74, 0x75, 0x70, 0x73, 0x12, 0x44, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x31,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x61, 0x72, 0x54, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x61,
+	0x72, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x61,
+	0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x61, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x59, 0x0a, 0x14, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x13, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x24, 0x0a, 0x03, 0x6c, 0x61, 0x70, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66,
+	0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x4c, 0x61, 0x70, 0x48, 0x00,
+	0x52, 0x03, 0x6c, 0x61, 0x70, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x69, 0x6e, 0x74, 0x18, 0x65,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x2e, 0x53, 0x74, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x69, 0x6e,
+	0x74, 0x12, 0x31, 0x0a, 0x08, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x66, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x2e, 0x46, 0x75, 0x65, 0x6c, 0x4c, 0x61, 0x70, 0x48, 0x00, 0x52, 0x07, 0x66, 0x75, 0x65,
+	0x6c, 0x4c, 0x61, 0x70, 0x12, 0x40, 0x0a, 0x0d, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x31,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x46, 0x75, 0x65, 0x6c, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x75, 0x65, 0x6c, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x69, 0x74, 0x5f, 0x73, 0x74,
+	0x6f, 0x70, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x69, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x48, 0x00,
+	0x52, 0x07, 0x70, 0x69, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66,
+	0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x4d, 0x61, 0x70, 0x48, 0x00, 0x52, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x12,
+	0x40, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x6a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x61, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x61, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x77, 0x65,
+	0x72, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x77, 0x65,
+	0x72, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x77, 0x65, 0x72,
+	0x12, 0x46, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x31, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x31, 0x74,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a,
+	0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x18, 0x6e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x6c, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66,
+	0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x6c, 0x42, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x6c, 0x42, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x70, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x48, 0x00,
+	0x52, 0x07, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x6f, 0x72,
+	0x6e, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x71, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x72, 0x6e,
+	0x65, 0x72, 0x4c, 0x61, 0x70, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x4c,
+	0x61, 0x70, 0x12, 0x40, 0x0a, 0x0d, 0x62, 0x72, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x72, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x31, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x42, 0x72, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x72, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x62, 0x72, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x6c, 0x61, 0x70, 0x18, 0x73, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x31, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x42, 0x72, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4c,
+	0x61, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x72, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x61, 0x70,
+	0x12, 0x46, 0x0a, 0x0f, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x73, 0x70, 0x69, 0x6e, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x74, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x31, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x57, 0x68, 0x65, 0x65, 0x6c, 0x73, 0x70, 0x69,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x73,
+	0x70, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x75, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x70, 0x12, 0x49, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x76, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48,
+	0x00, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x49, 0x0a, 0x10, 0x74, 0x68, 0x65, 0x6f, 0x72, 0x65, 0x74, 0x69, 0x63, 0x61,
+	0x6c, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x18, 0x77, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66,
+	0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x54, 0x68, 0x65, 0x6f, 0x72,
+	0x65, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x68,
+	0x65, 0x6f, 0x72, 0x65, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x65, 0x73, 0x74, 0x42, 0x06, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2b, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x4e, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66,
+	0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x87, 0x04, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x72, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72,
+	0x6d, 0x75, 0x6c, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x70, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x61, 0x70,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x77,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x6c, 0x61, 0x70, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x65, 0x73, 0x74,
+	0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0b, 0x62, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x69, 0x64,
+	0x12, 0x15, 0x0a, 0x03, 0x63, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
+	0x03, 0x63, 0x61, 0x72, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x63, 0x61, 0x72, 0x22,
+	0x3e, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x70, 0x52, 0x04, 0x6c, 0x61, 0x70, 0x73, 0x22,
+	0xed, 0x03, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x70, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x6c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x70, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x6c, 0x61, 0x70, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x31, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x73, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x32, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x33, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b,
+	0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x69, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x70, 0x69, 0x74, 0x4c, 0x61, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c,
+	0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x79, 0x72, 0x65,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x69, 0x73, 0x75,
+	0x61, 0x6c, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x54, 0x79,
+	0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x79,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x74, 0x79, 0x72, 0x65, 0x73, 0x41, 0x67, 0x65, 0x4c, 0x61, 0x70, 0x73,
+	0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x9c, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x61, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x63, 0x61, 0x72, 0x12, 0x17, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x48,
+	0x01, 0x52, 0x02, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x6f, 0x22, 0x4e,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x87,
+	0x02, 0x0a, 0x0f, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x74,
+	0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x74, 0x65, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x62, 0x72,
+	0x61, 0x6b, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x65, 0x61, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x67, 0x65, 0x61, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x72, 0x70, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x52, 0x70, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x72, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x03, 0x64, 0x72, 0x73, 0x22, 0x8b, 0x0f, 0x0a, 0x0a, 0x4d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x42,
+	0x0a, 0x0f, 0x63, 0x61, 0x72, 0x5f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x61, 0x72, 0x4d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0d, 0x43, 0x61, 0x72, 0x4d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x41, 0x0a, 0x1d, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f,
+	0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1a, 0x52, 0x65, 0x61, 0x72, 0x4c,
+	0x65, 0x66, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x1e, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x5f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1b, 0x52,
+	0x65, 0x61, 0x72, 0x52, 0x69, 0x67, 0x68, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x1e, 0x66, 0x72,
+	0x6f, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x1b, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x53, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x45, 0x0a, 0x1f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1c, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x52,
+	0x69, 0x67, 0x68, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x1d, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x6c,
+	0x65, 0x66, 0x74, 0x5f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x76,
+	0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1a, 0x52,
+	0x65, 0x61, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x1e, 0x72, 0x65, 0x61,
+	0x72, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x1b, 0x52, 0x65, 0x61, 0x72, 0x52, 0x69, 0x67, 0x68, 0x74, 0x53, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x43,
+	0x0a, 0x1e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x73, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1b, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x4c, 0x65, 0x66,
+	0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x6c, 0x6f, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x1f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65,
+	0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1c, 0x46, 0x72,
+	0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x21, 0x72, 0x65,
+	0x61, 0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1e, 0x52, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x53,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x22, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x5f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
+	0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x1f, 0x52, 0x65, 0x61, 0x72, 0x52, 0x69, 0x67, 0x68, 0x74, 0x53, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x22, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74,
+	0x5f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1f,
+	0x46, 0x72, 0x6f, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x4d, 0x0a, 0x23, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x20, 0x46, 0x72,
+	0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31,
+	0x0a, 0x15, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x77, 0x68, 0x65, 0x65,
+	0x6c, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x52,
+	0x65, 0x61, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x57, 0x68, 0x65, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x65,
+	0x64, 0x12, 0x33, 0x0a, 0x16, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f,
+	0x77, 0x68, 0x65, 0x65, 0x6c, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x13, 0x52, 0x65, 0x61, 0x72, 0x52, 0x69, 0x67, 0x68, 0x74, 0x57, 0x68, 0x65, 0x65,
+	0x6c, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f,
+	0x6c, 0x65, 0x66, 0x74, 0x5f, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x4c, 0x65, 0x66,
+	0x74, 0x57, 0x68, 0x65, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x66,
+	0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x77, 0x68, 0x65, 0x65, 0x6c,
+	0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x46, 0x72,
+	0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x57, 0x68, 0x65, 0x65, 0x6c, 0x53, 0x70, 0x65,
+	0x65, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f,
+	0x77, 0x68, 0x65, 0x65, 0x6c, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x18, 0x14, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x11, 0x52, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x57, 0x68, 0x65, 0x65, 0x6c, 0x53,
+	0x6c, 0x69, 0x70, 0x12, 0x31, 0x0a, 0x15, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x72, 0x69, 0x67, 0x68,
+	0x74, 0x5f, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x12, 0x52, 0x65, 0x61, 0x72, 0x52, 0x69, 0x67, 0x68, 0x74, 0x57, 0x68, 0x65,
+	0x65, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x31, 0x0a, 0x15, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f,
+	0x6c, 0x65, 0x66, 0x74, 0x5f, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74,
+	0x57, 0x68, 0x65, 0x65, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x33, 0x0a, 0x16, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x5f, 0x73,
+	0x6c, 0x69, 0x70, 0x18, 0x17, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x46, 0x72, 0x6f, 0x6e, 0x74,
+	0x52, 0x69, 0x67, 0x68, 0x74, 0x57, 0x68, 0x65, 0x65, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x28,
+	0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79,
+	0x5f, 0x78, 0x18, 0x18, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x56,
+	0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x58, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x79, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74,
+	0x79, 0x59, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x76, 0x65, 0x6c, 0x6f,
+	0x63, 0x69, 0x74, 0x79, 0x5f, 0x7a, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5a, 0x12, 0x2c, 0x0a, 0x12,
+	0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79,
+	0x5f, 0x78, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x41, 0x6e, 0x67, 0x75, 0x6c, 0x61,
+	0x72, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x58, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x6e,
+	0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x79,
+	0x18, 0x1c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x41, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x56,
+	0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x59, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x6e, 0x67, 0x75,
+	0x6c, 0x61, 0x72, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x7a, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x41, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x56, 0x65, 0x6c,
+	0x6f, 0x63, 0x69, 0x74, 0x79, 0x5a, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61,
+	0x72, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x78,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x41, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x41,
+	0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x34, 0x0a, 0x16,
+	0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x41, 0x6e,
+	0x67, 0x75, 0x6c, 0x61, 0x72, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x59, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x61, 0x63,
+	0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x7a, 0x18, 0x20, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x14, 0x41, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x41, 0x63, 0x63, 0x65, 0x6c,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5a, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x72, 0x6f, 0x6e,
+	0x74, 0x5f, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x73, 0x5f, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x21,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x57, 0x68, 0x65, 0x65, 0x6c,
+	0x73, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x22, 0x81, 0x08, 0x0a, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a,
+	0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x69, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x41,
+	0x69, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x61, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4c,
+	0x65, 0x66, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
+	0x0a, 0x0f, 0x70, 0x69, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x50, 0x69, 0x74, 0x53, 0x70, 0x65, 0x65,
+	0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x47, 0x61, 0x6d,
+	0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x49, 0x73, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x13,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x53, 0x70, 0x65, 0x63, 0x74,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x33, 0x0a, 0x16,
+	0x73, 0x6c, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x53, 0x6c,
+	0x69, 0x50, 0x72, 0x6f, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x5f, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c,
+	0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x4e, 0x75,
+	0x6d, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x3d, 0x0a,
+	0x0d, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x0c,
+	0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11,
+	0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x43,
+	0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x1c, 0x6e,
+	0x75, 0x6d, 0x5f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x19, 0x4e, 0x75, 0x6d, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72,
+	0x65, 0x63, 0x61, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x18,
+	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x57, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x52, 0x16, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x07, 0x4c,
+	0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x35, 0x0a,
+	0x08, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x4c, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x4c, 0x61, 0x70,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0xdb, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x22, 0xe3, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x61, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x4e, 0x75, 0x6d, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x43, 0x61, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66,
+	0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x72,
+	0x53, 0x65, 0x74, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x75, 0x70, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x72, 0x53, 0x65, 0x74, 0x75,
+	0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x43, 0x61, 0x72, 0x53, 0x65, 0x74, 0x75, 0x70, 0x73,
+	0x22, 0x89, 0x03, 0x0a, 0x10, 0x43, 0x61, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x52, 0x0a, 0x12,
+	0x63, 0x61, 0x72, 0x5f, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x61, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x10,
+	0x43, 0x61, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x66, 0x64, 0x5f, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x4d, 0x66, 0x64, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x46, 0x0a,
+	0x20, 0x6d, 0x66, 0x64, 0x5f, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1c, 0x4d, 0x66, 0x64, 0x50, 0x61, 0x6e, 0x65,
+	0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x67, 0x65, 0x61, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x47, 0x65, 0x61, 0x72, 0x22, 0xc0, 0x01, 0x0a,
+	0x0d, 0x43, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x48, 0x0a, 0x0f, 0x63, 0x61, 0x72, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x61, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x52, 0x0d, 0x43, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22,
+	0xf8, 0x01, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x61, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x4e, 0x75, 0x6d, 0x43, 0x61, 0x72, 0x73, 0x12, 0x5b, 0x0a,
+	0x13, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x66, 0x31, 0x74,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x12, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0xde, 0x01, 0x0a, 0x0d, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66,
+	0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x4e, 0x75, 0x6d, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x31,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x85, 0x05, 0x0a, 0x03,
+	0x4c, 0x61, 0x70, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x43, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x6c,
+	0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4c, 0x61,
+	0x70, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x32, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x53, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x33, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x69, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x50, 0x69, 0x74, 0x4c, 0x61, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x79,
+	0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x12, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x79, 0x72, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f,
+	0x74, 0x79, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c,
+	0x54, 0x79, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x30, 0x0a, 0x14, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12,
+	0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x54, 0x79, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x72,
+	0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x54, 0x79, 0x72,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a,
+	0x0e, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x54, 0x79, 0x72, 0x65, 0x73, 0x41, 0x67, 0x65, 0x4c,
+	0x61, 0x70, 0x73, 0x22, 0xe4, 0x05, 0x0a, 0x05, 0x53, 0x74, 0x69, 0x6e, 0x74, 0x12, 0x2b, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x43, 0x61, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x69, 0x6e, 0x74, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x53, 0x74, 0x69,
+	0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x4c, 0x61, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x61, 0x70,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x45, 0x6e, 0x64, 0x4c, 0x61, 0x70, 0x12, 0x19,
+	0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x4e, 0x75, 0x6d, 0x4c, 0x61, 0x70, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x74,
+	0x75, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x54,
+	0x79, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x61,
+	0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16,
+	0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x79, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75,
+	0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c,
+	0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x54, 0x79, 0x72, 0x65,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x76, 0x69, 0x73, 0x75,
+	0x61, 0x6c, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x56, 0x69, 0x73,
+	0x75, 0x61, 0x6c, 0x54, 0x79, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x67, 0x65,
+	0x5f, 0x61, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x54, 0x79, 0x72, 0x65, 0x73, 0x41, 0x67, 0x65, 0x41, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x29, 0x0a, 0x04, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x69,
+	0x6e, 0x74, 0x4c, 0x61, 0x70, 0x52, 0x04, 0x4c, 0x61, 0x70, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x64,
+	0x65, 0x67, 0x72, 0x61, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c,
+	0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0d,
+	0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0b, 0x42, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x61, 0x70, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x41, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xdf, 0x04, 0x0a, 0x07, 0x46,
+	0x75, 0x65, 0x6c, 0x4c, 0x61, 0x70, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x43, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17,
+	0x0a, 0x07, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x69, 0x74, 0x5f, 0x6c,
+	0x61, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x50, 0x69, 0x74, 0x4c, 0x61, 0x70,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x08, 0x46, 0x75, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x64, 0x12, 0x38, 0x0a,
+	0x0a, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x6d, 0x69, 0x78, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x46, 0x75, 0x65, 0x6c, 0x4d, 0x69, 0x78, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09, 0x46, 0x75,
+	0x65, 0x6c, 0x4d, 0x69, 0x78, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x75, 0x65, 0x6c, 0x5f,
+	0x69, 0x6e, 0x5f, 0x74, 0x61, 0x6e, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x46,
+	0x75, 0x65, 0x6c, 0x49, 0x6e, 0x54, 0x61, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x75, 0x65,
+	0x6c, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0c, 0x46, 0x75, 0x65, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x2e,
+	0x0a, 0x13, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x46, 0x75, 0x65,
+	0x6c, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4c, 0x61, 0x70, 0x73, 0x12, 0x2f,
+	0x0a, 0x14, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x41, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x46, 0x75, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x4c, 0x61, 0x70, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x61, 0x70,
+	0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x4c, 0x61, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x46, 0x75, 0x65, 0x6c, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x46, 0x75, 0x65, 0x6c, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0xd8, 0x03, 0x0a,
+	0x0c, 0x46, 0x75, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2b, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x43, 0x61, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a,
+	0x0a, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x6d, 0x69, 0x78, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x46, 0x75, 0x65, 0x6c, 0x4d, 0x69, 0x78, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09, 0x46, 0x75,
+	0x65, 0x6c, 0x4d, 0x69, 0x78, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x6c, 0x61, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x46, 0x75, 0x65, 0x6c,
+	0x50, 0x65, 0x72, 0x4c, 0x61, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x6c,
+	0x61, 0x70, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x52, 0x61, 0x63, 0x65, 0x4c,
+	0x61, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x5f, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x46,
+	0x75, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x22, 0xf7, 0x05, 0x0a, 0x07, 0x50, 0x69, 0x74, 0x53,
+	0x74, 0x6f, 0x70, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x43, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x6c,
+	0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4c, 0x61,
+	0x70, 0x4e, 0x75, 0x6d, 0x12, 0x2d, 0x0a, 0x13, 0x70, 0x69, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x65,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x10, 0x50, 0x69, 0x74, 0x4c, 0x61, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x69, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x65, 0x5f,
+	0x65, 0x78, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0f, 0x50, 0x69, 0x74, 0x4c, 0x61, 0x6e, 0x65, 0x45, 0x78, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x70, 0x69, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x50, 0x69, 0x74, 0x4c, 0x61, 0x6e, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3c, 0x0a,
+	0x1b, 0x70, 0x69, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f,
+	0x6c, 0x61, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x17, 0x50, 0x69, 0x74, 0x4c, 0x61, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x4c, 0x61, 0x70, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x1a, 0x70,
+	0x69, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x6c, 0x61, 0x70,
+	0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x16, 0x50, 0x69, 0x74, 0x4c, 0x61, 0x6e, 0x65, 0x45, 0x78, 0x69, 0x74, 0x4c, 0x61, 0x70, 0x44,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x74, 0x79, 0x72, 0x65, 0x73,
+	0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x69, 0x74, 0x53,
+	0x74, 0x6f, 0x70, 0x54, 0x79, 0x72, 0x65, 0x73, 0x52, 0x0b, 0x54, 0x79, 0x72, 0x65, 0x73, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x31, 0x74,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x69, 0x74, 0x53, 0x74, 0x6f, 0x70,
+	0x54, 0x79, 0x72, 0x65, 0x73, 0x52, 0x0a, 0x54, 0x79, 0x72, 0x65, 0x73, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x54, 0x79, 0x72, 0x65, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x67, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x61, 0x69, 0x6e, 0x65,
+	0x64, 0x22, 0x8b, 0x02, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x12, 0x2b,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x32, 0x0a, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x31,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d,
+	0x61, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22,
+	0xd1, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x2e, 0x43, 0x61, 0x72, 0x4f, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x04, 0x43,
+	0x61, 0x72, 0x73, 0x22, 0x88, 0x02, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x54, 0x6f,
+	0x77, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x52, 0x61, 0x63, 0x65,
+	0x12, 0x2c, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x54, 0x69, 0x6d,
+	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x43, 0x61, 0x72, 0x73, 0x22, 0xb0,
+	0x05, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x43, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a,
+	0x0f, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x43, 0x61, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25,
+	0x0a, 0x0e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x70, 0x5f,
+	0x6e, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4c, 0x61, 0x70, 0x4e, 0x75,
+	0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x4c, 0x61, 0x70, 0x44, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x43, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10,
+	0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x78,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x7a, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5a,
+	0x12, 0x10, 0x0a, 0x03, 0x64, 0x72, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x44,
+	0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x64, 0x72, 0x73, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x44, 0x72, 0x73, 0x12,
+	0x15, 0x0a, 0x06, 0x69, 0x6e, 0x5f, 0x70, 0x69, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x49, 0x6e, 0x50, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f,
+	0x69, 0x6e, 0x5f, 0x70, 0x69, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x4f, 0x74,
+	0x68, 0x65, 0x72, 0x49, 0x6e, 0x50, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x74, 0x68, 0x65,
+	0x72, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65,
+	0x73, 0x22, 0xc9, 0x05, 0x0a, 0x08, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x2b,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x43, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x43, 0x61, 0x72, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
+	0x61, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0b, 0x4c, 0x61, 0x70, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x53, 0x70,
+	0x65, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x79, 0x61, 0x77, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x4d, 0x61, 0x78, 0x59, 0x61,
+	0x77, 0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6c, 0x69,
+	0x70, 0x5f, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x4d,
+	0x61, 0x78, 0x53, 0x6c, 0x69, 0x70, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x53,
+	0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x66, 0x72, 0x6f, 0x6e, 0x74,
+	0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x4c, 0x65,
+	0x66, 0x74, 0x57, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x17,
+	0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x67,
+	0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x46,
+	0x72, 0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x57, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x67,
+	0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x52,
+	0x65, 0x61, 0x72, 0x57, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x6e,
+	0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x22, 0xce, 0x03,
+	0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x61, 0x70, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x46, 0x69,
+	0x6e, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x52, 0x07, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x07, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x31, 0x74,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x22, 0x81,
+	0x06, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x65, 0x73, 0x74, 0x12,
+	0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x43, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x17, 0x0a, 0x07, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2a, 0x0a, 0x11, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x4c,
+	0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x02, 0x52, 0x07, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x70, 0x5f, 0x69, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x4c, 0x61, 0x70, 0x49, 0x6d, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x69,
+	0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x08, 0x52, 0x0f, 0x53,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x49, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x2a,
+	0x0a, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6d,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0b, 0x49, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x16,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61,
+	0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x02, 0x52, 0x0b, 0x42, 0x65, 0x73, 0x74, 0x53, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x68, 0x65, 0x6f, 0x72, 0x65, 0x74, 0x69,
+	0x63, 0x61, 0x6c, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f,
+	0x54, 0x68, 0x65, 0x6f, 0x72, 0x65, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x65, 0x73, 0x74, 0x12,
+	0x31, 0x0a, 0x14, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xce, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x2b,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x52, 0x07, 0x43, 0x6f, 0x72, 0x6e,
+	0x65, 0x72, 0x73, 0x22, 0xd6, 0x02, 0x0a, 0x09, 0x43, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x4c, 0x61,
+	0x70, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x43, 0x61, 0x72, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a,
+	0x08, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x07, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x69, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x50, 0x69, 0x74, 0x4c, 0x61, 0x70, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x9e, 0x07, 0x0a,
+	0x0c, 0x42, 0x72, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x43, 0x61, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x64, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b,
+	0x45, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73,
+	0x70, 0x65, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x45, 0x6e, 0x64, 0x53, 0x70,
+	0x65, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x75, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x50, 0x65, 0x61, 0x6b,
+	0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x65,
+	0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c,
+	0x44, 0x65, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11,
+	0x70, 0x65, 0x61, 0x6b, 0x5f, 0x64, 0x65, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x50, 0x65, 0x61, 0x6b, 0x44, 0x65, 0x63,
+	0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x1c, 0x72, 0x65, 0x61,
+	0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x73, 0x5f, 0x74, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x19, 0x52, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x42, 0x72, 0x61, 0x6b, 0x65, 0x73, 0x54,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x1d, 0x72, 0x65,
+	0x61, 0x72, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x73, 0x5f,
+	0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x1a, 0x52, 0x65, 0x61, 0x72, 0x52, 0x69, 0x67, 0x68, 0x74, 0x42, 0x72, 0x61, 0x6b,
+	0x65, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x41, 0x0a,
+	0x1d, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6b,
+	0x65, 0x73, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x42,
+	0x72, 0x61, 0x6b, 0x65, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x43, 0x0a, 0x1e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f,
+	0x62, 0x72, 0x61, 0x6b, 0x65, 0x73, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x52,
+	0x69, 0x67, 0x68, 0x74, 0x42, 0x72, 0x61, 0x6b, 0x65, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x75, 0x70,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x70, 0x12, 0x23,
+	0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x73, 0x18,
+	0x15, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x57, 0x68, 0x65,
+	0x65, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x75, 0x70, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x4c,
+	0x6f, 0x63, 0x6b, 0x55, 0x70, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xee, 0x03,
+	0x0a, 0x0a, 0x42, 0x72, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x61, 0x70, 0x12, 0x2b, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66,
+	0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x43, 0x61, 0x72, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a,
+	0x08, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x07, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x75,
+	0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x70,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x72, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x42, 0x72, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x32, 0x0a, 0x15, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x61, 0x6b, 0x5f,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13,
+	0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x65, 0x61, 0x6b, 0x50, 0x72, 0x65, 0x73, 0x73,
+	0x75, 0x72, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64,
+	0x65, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x13, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x63, 0x65, 0x6c, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x62, 0x72, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x15, 0x42, 0x72, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd0,
+	0x04, 0x0a, 0x0e, 0x57, 0x68, 0x65, 0x65, 0x6c, 0x73, 0x70, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x43, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x70,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4c, 0x61, 0x70, 0x4e,
+	0x75, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0b, 0x45, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x61,
+	0x6b, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x50, 0x65,
+	0x61, 0x6b, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x41, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0f, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x54, 0x68, 0x72, 0x6f,
+	0x74, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x05, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x65,
+	0x61, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x47, 0x65, 0x61, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x43, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x54, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x22, 0x86, 0x03, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61,
+	0x70, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x43, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x70,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4c, 0x61, 0x70, 0x4e,
+	0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x54,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x73, 0x70, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x70, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a,
+	0x0a, 0x11, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x73,
+	0x6c, 0x69, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x41, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x50, 0x65, 0x61, 0x6b, 0x53, 0x6c, 0x69, 0x70, 0x22, 0xb3, 0x03, 0x0a, 0x0f, 0x54,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2b,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x72,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x43, 0x61,
+	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x54, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x4c, 0x61, 0x70, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61,
+	0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x42, 0x65,
+	0x73, 0x74, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0e, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x61, 0x70, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x4c, 0x61, 0x70, 0x12, 0x29, 0x0a, 0x11, 0x73, 0x70, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x53, 0x70, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65,
+	0x72, 0x4c, 0x61, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x70, 0x65, 0x61, 0x6b, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0f, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x65, 0x61, 0x6b, 0x53, 0x6c, 0x69, 0x70,
+	0x22, 0xfd, 0x01, 0x0a, 0x0f, 0x54, 0x68, 0x65, 0x6f, 0x72, 0x65, 0x74, 0x69, 0x63, 0x61, 0x6c,
+	0x42, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a,
+	0x07, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x42, 0x65, 0x73, 0x74, 0x52, 0x07, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x22, 0xd0, 0x03, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x2c, 0x0a, 0x12, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x47, 0x61,
+	0x6d, 0x65, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
+	0x0a, 0x12, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x47, 0x61, 0x6d, 0x65,
+	0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x49,
+	0x44, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x28, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x43, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3b, 0x0a, 0x1a, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x61,
+	0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x61,
+	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x22, 0xd9, 0x05, 0x0a, 0x0d, 0x43, 0x61, 0x72, 0x4d, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x12,
+	0x28, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x57, 0x6f, 0x72, 0x6c, 0x64,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f, 0x72,
+	0x6c, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x7a, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x5a, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x76, 0x65, 0x6c,
+	0x6f, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x57,
+	0x6f, 0x72, 0x6c, 0x64, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x58, 0x12, 0x28, 0x0a,
+	0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5f,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x65,
+	0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x59, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64,
+	0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x7a, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79,
+	0x5a, 0x12, 0x2d, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x5f, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
+	0x57, 0x6f, 0x72, 0x6c, 0x64, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x72, 0x58,
+	0x12, 0x2d, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x64, 0x69, 0x72, 0x5f, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x57,
+	0x6f, 0x72, 0x6c, 0x64, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x72, 0x59, 0x12,
+	0x2d, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x64, 0x69, 0x72, 0x5f, 0x7a, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x57, 0x6f,
+	0x72, 0x6c, 0x64, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x72, 0x5a, 0x12, 0x29,
+	0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x64, 0x69,
+	0x72, 0x5f, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x57, 0x6f, 0x72, 0x6c, 0x64,
+	0x52, 0x69, 0x67, 0x68, 0x74, 0x44, 0x69, 0x72, 0x58, 0x12, 0x29, 0x0a, 0x11, 0x77, 0x6f, 0x72,
+	0x6c, 0x64, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x5f, 0x79, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x69, 0x67, 0x68, 0x74,
+	0x44, 0x69, 0x72, 0x59, 0x12, 0x29, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x5f, 0x7a, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x69, 0x67, 0x68, 0x74, 0x44, 0x69, 0x72, 0x5a, 0x12,
+	0x26, 0x0a, 0x0f, 0x67, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x47, 0x46, 0x6f, 0x72, 0x63, 0x65,
+	0x4c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x5f, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x47, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x5f, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0e, 0x47, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x74, 0x69,
+	0x63, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x79, 0x61, 0x77, 0x18, 0x10, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x03, 0x59, 0x61, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x69, 0x74, 0x63, 0x68, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x50, 0x69, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x52, 0x6f, 0x6c, 0x6c, 0x22,
+	0x49, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x09, 0x5a, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x5a, 0x6f, 0x6e, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x22, 0xcb, 0x01, 0x0a, 0x15, 0x57,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x54, 0x69,
+	0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x57, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x61, 0x69, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x41, 0x69, 0x72, 0x54, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xf6, 0x09, 0x0a, 0x0d, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x4c, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28,
+	0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x31, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x54, 0x69, 0x6d,
+	0x65, 0x49, 0x6e, 0x4d, 0x53, 0x12, 0x2b, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e,
+	0x4d, 0x53, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x42, 0x65, 0x73, 0x74, 0x4c,
+	0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c,
+	0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x42, 0x65,
+	0x73, 0x74, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x3b, 0x0a, 0x1b, 0x62, 0x65, 0x73, 0x74,
+	0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x42,
+	0x65, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x54, 0x69, 0x6d,
+	0x65, 0x49, 0x6e, 0x4d, 0x53, 0x12, 0x3b, 0x0a, 0x1b, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61,
+	0x70, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x42, 0x65, 0x73, 0x74,
+	0x4c, 0x61, 0x70, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e,
+	0x4d, 0x53, 0x12, 0x3b, 0x0a, 0x1b, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x73,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x42, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x70,
+	0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x53, 0x12,
+	0x43, 0x0a, 0x1f, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f,
+	0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a, 0x42, 0x65, 0x73, 0x74, 0x4f, 0x76,
+	0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x54, 0x69, 0x6d, 0x65,
+	0x49, 0x6e, 0x4d, 0x53, 0x12, 0x3e, 0x0a, 0x1c, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x76, 0x65,
+	0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x5f, 0x6c, 0x61, 0x70,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x42, 0x65, 0x73, 0x74,
+	0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x4c, 0x61,
+	0x70, 0x4e, 0x75, 0x6d, 0x12, 0x43, 0x0a, 0x1f, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x76, 0x65,
+	0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a, 0x42,
+	0x65, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x32, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x53, 0x12, 0x3e, 0x0a, 0x1c, 0x62, 0x65, 0x73,
+	0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x32, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x18, 0x42, 0x65, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x32, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x43, 0x0a, 0x1f, 0x62, 0x65, 0x73,
+	0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x33, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x1a, 0x42, 0x65, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x53, 0x12, 0x3e,
+	0x0a, 0x1c, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x42, 0x65, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c,
+	0x6c, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x21,
+	0x0a, 0x0c, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x4c, 0x61, 0x70, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x61, 0x66, 0x65,
+	0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0e, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x43, 0x61, 0x72, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x72, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x43, 0x61, 0x72, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x50, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x53, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x6c, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x70, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x47, 0x72, 0x69, 0x64, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0xea, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x41, 0x69,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x44,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x44,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x52, 0x61, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x4e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x79, 0x6f, 0x75, 0x72, 0x5f,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x59, 0x6f, 0x75, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x22, 0x8f,
+	0x07, 0x0a, 0x12, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x72, 0x53, 0x65, 0x74, 0x75,
+	0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x77,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x46, 0x72, 0x6f, 0x6e, 0x74,
+	0x57, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x77, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x52, 0x65, 0x61, 0x72, 0x57, 0x69, 0x6e,
+	0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x4f, 0x6e, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74,
+	0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x66, 0x66, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x4f, 0x66, 0x66, 0x54, 0x68, 0x72,
+	0x6f, 0x74, 0x74, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x63,
+	0x61, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x46, 0x72, 0x6f,
+	0x6e, 0x74, 0x43, 0x61, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x72,
+	0x5f, 0x63, 0x61, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x52,
+	0x65, 0x61, 0x72, 0x43, 0x61, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x46, 0x72,
+	0x6f, 0x6e, 0x74, 0x54, 0x6f, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x74,
+	0x6f, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x52, 0x65, 0x61, 0x72, 0x54, 0x6f,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x46, 0x72, 0x6f,
+	0x6e, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x61, 0x72, 0x5f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x52, 0x65, 0x61, 0x72, 0x53, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x13, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x61,
+	0x6e, 0x74, 0x69, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x5f, 0x62, 0x61, 0x72, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x41, 0x6e, 0x74, 0x69, 0x52, 0x6f, 0x6c,
+	0x6c, 0x42, 0x61, 0x72, 0x12, 0x2b, 0x0a, 0x12, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x61, 0x6e, 0x74,
+	0x69, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x5f, 0x62, 0x61, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x52, 0x65, 0x61, 0x72, 0x41, 0x6e, 0x74, 0x69, 0x52, 0x6f, 0x6c, 0x6c, 0x42, 0x61,
+	0x72, 0x12, 0x36, 0x0a, 0x17, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x15, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x61,
+	0x72, 0x5f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x52, 0x65, 0x61, 0x72, 0x53,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x42, 0x72, 0x61, 0x6b, 0x65, 0x50, 0x72,
+	0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x5f,
+	0x62, 0x69, 0x61, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x42, 0x72, 0x61, 0x6b,
+	0x65, 0x42, 0x69, 0x61, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65,
+	0x66, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x52, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x66, 0x74,
+	0x54, 0x79, 0x72, 0x65, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x18,
+	0x72, 0x65, 0x61, 0x72, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x15,
+	0x52, 0x65, 0x61, 0x72, 0x52, 0x69, 0x67, 0x68, 0x74, 0x54, 0x79, 0x72, 0x65, 0x50, 0x72, 0x65,
+	0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x6c,
+	0x65, 0x66, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72,
+	0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x02, 0x52, 0x15, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x4c, 0x65,
+	0x66, 0x74, 0x54, 0x79, 0x72, 0x65, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x39,
+	0x0a, 0x19, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x79,
+	0x72, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x16, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x54, 0x79, 0x72,
+	0x65, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c,
+	0x6c, 0x61, 0x73, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x6c,
+	0x61, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x46, 0x75, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64,
+	0x22, 0xf5, 0x0c, 0x0a, 0x17, 0x43, 0x61, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x53, 0x70, 0x65,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x53,
+	0x74, 0x65, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x05, 0x42, 0x72, 0x61, 0x6b, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x43, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x65, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x47, 0x65, 0x61, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x5f, 0x72, 0x70, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x52, 0x50, 0x4d, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x44, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x76, 0x5f, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x52, 0x65, 0x76, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x1c, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65,
+	0x66, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x73, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x52, 0x65, 0x61,
+	0x72, 0x4c, 0x65, 0x66, 0x74, 0x42, 0x72, 0x61, 0x6b, 0x65, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x1d, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x72,
+	0x69, 0x67, 0x68, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x73, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a, 0x52,
+	0x65, 0x61, 0x72, 0x52, 0x69, 0x67, 0x68, 0x74, 0x42, 0x72, 0x61, 0x6b, 0x65, 0x73, 0x54, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x1d, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x73, 0x5f, 0x74,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x1a, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x42, 0x72, 0x61, 0x6b, 0x65,
+	0x73, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x1e,
+	0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6b,
+	0x65, 0x73, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74,
+	0x42, 0x72, 0x61, 0x6b, 0x65, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x4c, 0x0a, 0x23, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74,
+	0x79, 0x72, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1f,
+	0x52, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x53, 0x75, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x4e, 0x0a, 0x24, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x79,
+	0x72, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x20, 0x52,
+	0x65, 0x61, 0x72, 0x52, 0x69, 0x67, 0x68, 0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x53, 0x75, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x4e, 0x0a, 0x24, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x79,
+	0x72, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x20, 0x46,
+	0x72, 0x6f, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x53, 0x75, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x50, 0x0a, 0x25, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74,
+	0x79, 0x72, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x21,
+	0x46, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x53,
+	0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x48, 0x0a, 0x21, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74,
+	0x79, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d, 0x52, 0x65,
+	0x61, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x6e, 0x65, 0x72,
+	0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x4a, 0x0a, 0x22, 0x72,
+	0x65, 0x61, 0x72, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1e, 0x52, 0x65, 0x61, 0x72, 0x52, 0x69, 0x67,
+	0x68, 0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x4a, 0x0a, 0x22, 0x66, 0x72, 0x6f, 0x6e, 0x74,
+	0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x1e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x54, 0x79,
+	0x72, 0x65, 0x73, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x4c, 0x0a, 0x23, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x74,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x1f, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x54, 0x79, 0x72, 0x65,
+	0x73, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x37, 0x0a, 0x18, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x79,
+	0x72, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x15, 0x52, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x54, 0x79, 0x72, 0x65,
+	0x73, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x61,
+	0x72, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x02, 0x52, 0x16, 0x52, 0x65,
+	0x61, 0x72, 0x52, 0x69, 0x67, 0x68, 0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x50, 0x72, 0x65, 0x73,
+	0x73, 0x75, 0x72, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x6c, 0x65,
+	0x66, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72,
+	0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x02, 0x52, 0x16, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x4c, 0x65,
+	0x66, 0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12,
+	0x3b, 0x0a, 0x1a, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74,
+	0x79, 0x72, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x17, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x54,
+	0x79, 0x72, 0x65, 0x73, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x33, 0x0a, 0x16,
+	0x72, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x52, 0x65,
+	0x61, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f,
+	0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x1c, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x14, 0x52, 0x65, 0x61, 0x72, 0x52, 0x69, 0x67, 0x68, 0x74, 0x53, 0x75, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x66, 0x72, 0x6f, 0x6e,
+	0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x46, 0x72, 0x6f, 0x6e, 0x74,
+	0x4c, 0x65, 0x66, 0x74, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x37, 0x0a, 0x18, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73,
+	0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x15, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x53, 0x75, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xdd, 0x0c, 0x0a, 0x13, 0x43, 0x61, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x54, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x61,
+	0x6e, 0x74, 0x69, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x41, 0x6e, 0x74, 0x69, 0x4c, 0x6f, 0x63, 0x6b, 0x42,
+	0x72, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x6d, 0x69,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x46, 0x75, 0x65, 0x6c, 0x4d, 0x69, 0x78,
+	0x12, 0x28, 0x0a, 0x10, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x5f,
+	0x62, 0x69, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x46, 0x72, 0x6f, 0x6e,
+	0x74, 0x42, 0x72, 0x61, 0x6b, 0x65, 0x42, 0x69, 0x61, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x69,
+	0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x50, 0x69, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x75, 0x65, 0x6c,
+	0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a,
+	0x46, 0x75, 0x65, 0x6c, 0x49, 0x6e, 0x54, 0x61, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x75,
+	0x65, 0x6c, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0c, 0x46, 0x75, 0x65, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12,
+	0x2e, 0x0a, 0x13, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x46, 0x75,
+	0x65, 0x6c, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4c, 0x61, 0x70, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x70, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x4d, 0x61, 0x78, 0x52, 0x50, 0x4d, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x6c, 0x65,
+	0x5f, 0x72, 0x70, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x49, 0x64, 0x6c, 0x65,
+	0x52, 0x50, 0x4d, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x65, 0x61, 0x72, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x47, 0x65, 0x61, 0x72, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x44, 0x72, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x72, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x15, 0x44, 0x72, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x72, 0x65, 0x61,
+	0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x77, 0x65, 0x61,
+	0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x52, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x66,
+	0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x57, 0x65, 0x61, 0x72, 0x12, 0x31, 0x0a, 0x15, 0x72, 0x65,
+	0x61, 0x72, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x77,
+	0x65, 0x61, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x52, 0x65, 0x61, 0x72, 0x52,
+	0x69, 0x67, 0x68, 0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x57, 0x65, 0x61, 0x72, 0x12, 0x31, 0x0a,
+	0x15, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65,
+	0x73, 0x5f, 0x77, 0x65, 0x61, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x46, 0x72,
+	0x6f, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x57, 0x65, 0x61, 0x72,
+	0x12, 0x33, 0x0a, 0x16, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f,
+	0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x77, 0x65, 0x61, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x13, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x54, 0x79, 0x72, 0x65,
+	0x73, 0x57, 0x65, 0x61, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f,
+	0x74, 0x79, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x12, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x79, 0x72, 0x65, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x69, 0x73, 0x75, 0x61,
+	0x6c, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x54, 0x79, 0x72,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x79, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x54, 0x79, 0x72, 0x65, 0x73, 0x41, 0x67, 0x65, 0x4c, 0x61, 0x70, 0x73, 0x12,
+	0x33, 0x0a, 0x16, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x79, 0x72,
+	0x65, 0x73, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x13, 0x52, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x44, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x72, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x52, 0x65, 0x61, 0x72, 0x52, 0x69, 0x67, 0x68, 0x74,
+	0x54, 0x79, 0x72, 0x65, 0x73, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x66,
+	0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f,
+	0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x46, 0x72,
+	0x6f, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x44, 0x61, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68,
+	0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74,
+	0x54, 0x79, 0x72, 0x65, 0x73, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x66,
+	0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x64,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x46, 0x72, 0x6f,
+	0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x57, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x35, 0x0a, 0x17, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f,
+	0x77, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x14, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x57, 0x69, 0x6e,
+	0x67, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x72, 0x5f,
+	0x77, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x52, 0x65, 0x61, 0x72, 0x57, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x73, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x1c,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x44, 0x72, 0x73, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x1d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x44, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x65, 0x61, 0x72, 0x5f, 0x62, 0x6f, 0x78, 0x5f,
+	0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x47, 0x65,
+	0x61, 0x72, 0x42, 0x6f, 0x78, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x76,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x61, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x1f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x46,
+	0x69, 0x61, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x72, 0x73, 0x5f, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x18, 0x20, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0e, 0x45, 0x72, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x65, 0x72, 0x67,
+	0x79, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x72, 0x73, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x45, 0x72, 0x73, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x1b, 0x65, 0x72, 0x73,
+	0x5f, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x68, 0x69, 0x73, 0x5f,
+	0x6c, 0x61, 0x70, 0x5f, 0x6d, 0x67, 0x75, 0x6b, 0x18, 0x22, 0x20, 0x01, 0x28, 0x02, 0x52, 0x17,
+	0x45, 0x72, 0x73, 0x48, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x54, 0x68, 0x69, 0x73,
+	0x4c, 0x61, 0x70, 0x4d, 0x47, 0x55, 0x4b, 0x12, 0x3c, 0x0a, 0x1b, 0x65, 0x72, 0x73, 0x5f, 0x68,
+	0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x68, 0x69, 0x73, 0x5f, 0x6c, 0x61,
+	0x70, 0x5f, 0x6d, 0x67, 0x75, 0x68, 0x18, 0x23, 0x20, 0x01, 0x28, 0x02, 0x52, 0x17, 0x45, 0x72,
+	0x73, 0x48, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x54, 0x68, 0x69, 0x73, 0x4c, 0x61,
+	0x70, 0x4d, 0x47, 0x55, 0x48, 0x12, 0x31, 0x0a, 0x15, 0x65, 0x72, 0x73, 0x5f, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x74, 0x68, 0x69, 0x73, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x24,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x45, 0x72, 0x73, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x64, 0x54, 0x68, 0x69, 0x73, 0x4c, 0x61, 0x70, 0x22, 0xf8, 0x03, 0x0a, 0x1d, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x61,
+	0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x4e, 0x75, 0x6d, 0x4c, 0x61, 0x70,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x47, 0x72, 0x69, 0x64, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x22,
+	0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x4e, 0x75, 0x6d, 0x50, 0x69, 0x74, 0x53, 0x74, 0x6f,
+	0x70, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x65, 0x73, 0x74, 0x5f,
+	0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b,
+	0x42, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x61, 0x63, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x50, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75,
+	0x6d, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x4e, 0x75, 0x6d, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x4e, 0x75, 0x6d, 0x54, 0x79, 0x72,
+	0x65, 0x53, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x79, 0x72, 0x65, 0x5f,
+	0x73, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x10, 0x54, 0x79, 0x72, 0x65, 0x53, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x41,
+	0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x73, 0x74,
+	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0d, 0x52, 0x10, 0x54, 0x79, 0x72, 0x65, 0x53, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x56, 0x69, 0x73,
+	0x75, 0x61, 0x6c, 0x22, 0xac, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x41, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x4e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xd1, 0x02, 0x0a, 0x08, 0x53, 0x74, 0x69, 0x6e, 0x74, 0x4c, 0x61, 0x70, 0x12,
+	0x17, 0x0a, 0x07, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x70, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x4c, 0x61, 0x70, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x69, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x50, 0x69, 0x74, 0x4c, 0x61, 0x70, 0x12, 0x2f, 0x0a, 0x14,
+	0x72, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f,
+	0x77, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x52, 0x65, 0x61, 0x72,
+	0x4c, 0x65, 0x66, 0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x57, 0x65, 0x61, 0x72, 0x12, 0x31, 0x0a,
+	0x15, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65,
+	0x73, 0x5f, 0x77, 0x65, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x52, 0x65,
+	0x61, 0x72, 0x52, 0x69, 0x67, 0x68, 0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x57, 0x65, 0x61, 0x72,
+	0x12, 0x31, 0x0a, 0x15, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74,
+	0x79, 0x72, 0x65, 0x73, 0x5f, 0x77, 0x65, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x12, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x54, 0x79, 0x72, 0x65, 0x73, 0x57,
+	0x65, 0x61, 0x72, 0x12, 0x33, 0x0a, 0x16, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x77, 0x65, 0x61, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x13, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x54,
+	0x79, 0x72, 0x65, 0x73, 0x57, 0x65, 0x61, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x77, 0x65, 0x61, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x72,
+	0x65, 0x73, 0x57, 0x65, 0x61, 0x72, 0x22, 0xa0, 0x01, 0x0a, 0x0c, 0x46, 0x75, 0x65, 0x6c, 0x4d,
+	0x69, 0x78, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x75, 0x65, 0x6c, 0x5f,
+	0x6d, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x46, 0x75, 0x65, 0x6c, 0x4d,
+	0x69, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x6d, 0x69, 0x78, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x75, 0x65, 0x6c, 0x4d,
+	0x69, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x4c, 0x61, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75,
+	0x65, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x46,
+	0x75, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x75, 0x65, 0x6c, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x46,
+	0x75, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x4c, 0x61, 0x70, 0x22, 0x8e, 0x02, 0x0a, 0x0c, 0x50, 0x69,
+	0x74, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x79, 0x72, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63,
+	0x74, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c,
+	0x54, 0x79, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x19,
+	0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x16, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x79, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x75, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x69, 0x73, 0x75, 0x61,
+	0x6c, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x54, 0x79, 0x72,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x76, 0x69, 0x73,
+	0x75, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x56, 0x69,
+	0x73, 0x75, 0x61, 0x6c, 0x54, 0x79, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x67,
+	0x65, 0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x54, 0x79,
+	0x72, 0x65, 0x73, 0x41, 0x67, 0x65, 0x4c, 0x61, 0x70, 0x73, 0x22, 0x55, 0x0a, 0x0d, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x44,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x01, 0x58, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x01, 0x59, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01,
+	0x5a, 0x22, 0x8b, 0x03, 0x0a, 0x0a, 0x43, 0x61, 0x72, 0x4f, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x43, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x52, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
+	0x61, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0b, 0x4c, 0x61, 0x70, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x6c, 0x61, 0x70, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x4c, 0x61, 0x70, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x50, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x57, 0x6f, 0x72, 0x6c,
+	0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f,
+	0x72, 0x6c, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x59, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x7a, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e,
+	0x57, 0x6f, 0x72, 0x6c, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5a, 0x12, 0x15,
+	0x0a, 0x06, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x4f, 0x6e, 0x4d, 0x61, 0x70, 0x12, 0x13, 0x0a, 0x05, 0x6d, 0x61, 0x70, 0x5f, 0x78, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x4d, 0x61, 0x70, 0x58, 0x12, 0x13, 0x0a, 0x05, 0x6d, 0x61,
+	0x70, 0x5f, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x4d, 0x61, 0x70, 0x59, 0x22,
+	0xb8, 0x03, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x43, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x70, 0x5f,
+	0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4c, 0x61, 0x70, 0x4e, 0x75,
+	0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x4c, 0x61, 0x70, 0x44, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x67,
+	0x61, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0b, 0x47, 0x61, 0x70, 0x54, 0x6f, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x70, 0x73, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x4c, 0x61, 0x70, 0x73, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x70, 0x73, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4c, 0x61,
+	0x70, 0x73, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x6e, 0x5f, 0x70, 0x69, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x6e, 0x50, 0x69, 0x74, 0x12, 0x22, 0x0a,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x42, 0x65, 0x73, 0x74, 0x4c, 0x61,
+	0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc3, 0x03, 0x0a, 0x0e, 0x49,
+	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x4c, 0x61, 0x70, 0x44, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x05, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72,
+	0x6f, 0x74, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x54, 0x68, 0x72,
+	0x6f, 0x74, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x42, 0x72, 0x61, 0x6b, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x65, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x53, 0x74, 0x65, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x65, 0x61, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x47, 0x65, 0x61, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x79, 0x61, 0x77, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x03, 0x59, 0x61, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6c, 0x69, 0x70, 0x5f,
+	0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x53, 0x6c, 0x69,
+	0x70, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x5f, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0d, 0x47, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x30,
+	0x0a, 0x14, 0x67, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x47, 0x46,
+	0x6f, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x69, 0x6e, 0x61, 0x6c,
+	0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x57, 0x6f, 0x72, 0x6c,
+	0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f,
+	0x72, 0x6c, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x7a, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5a, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x0e, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x22, 0xc5, 0x01, 0x0a, 0x0d, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x61, 0x69, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x41, 0x69, 0x72, 0x54, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xe1, 0x04, 0x0a, 0x0c, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x72,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x43, 0x61,
+	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x52, 0x61, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x41, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x47, 0x72, 0x69, 0x64, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x4c, 0x61, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x61, 0x63, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x42, 0x65, 0x73, 0x74, 0x4c, 0x61,
+	0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61,
+	0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x42, 0x65, 0x73,
+	0x74, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x74, 0x5f, 0x73,
+	0x74, 0x6f, 0x70, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x69, 0x74, 0x53,
+	0x74, 0x6f, 0x70, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x70,
+	0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x50, 0x69, 0x74,
+	0x53, 0x74, 0x6f, 0x70, 0x4c, 0x61, 0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x50, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x69, 0x65, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x54, 0x79, 0x72, 0x65, 0x53, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x63,
+	0x74, 0x75, 0x61, 0x6c, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x54, 0x79, 0x72, 0x65,
+	0x53, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x22, 0x7c, 0x0a, 0x06,
+	0x43, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x70, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x04, 0x41, 0x70, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x69, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x45, 0x78, 0x69, 0x74, 0x22, 0x9d, 0x03, 0x0a, 0x0c, 0x43,
+	0x6f, 0x72, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x72, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x42, 0x72, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x62,
+	0x72, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0c, 0x42, 0x72, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x70, 0x65, 0x65,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x53,
+	0x70, 0x65, 0x65, 0x64, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x67, 0x65, 0x61, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x47, 0x65, 0x61, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x45, 0x78, 0x69, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x6f, 0x73, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x6f, 0x73, 0x74,
+	0x12, 0x38, 0x0a, 0x19, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x6f,
+	0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x73, 0x74, 0x22, 0xa5, 0x04, 0x0a, 0x0a, 0x44,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x42, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x72,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x43, 0x61,
+	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x4c, 0x61, 0x70, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x65,
+	0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0b, 0x42, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0c, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x42, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d,
+	0x12, 0x28, 0x0a, 0x10, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x73, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x02, 0x52, 0x0e, 0x42, 0x65, 0x73, 0x74,
+	0x4c, 0x61, 0x70, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x62, 0x65,
+	0x73, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x53,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x42, 0x65, 0x73, 0x74, 0x53,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x68, 0x65, 0x6f, 0x72, 0x65,
+	0x74, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x54, 0x68, 0x65, 0x6f, 0x72, 0x65, 0x74, 0x69, 0x63,
+	0x61, 0x6c, 0x42, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61,
+	0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x47, 0x61, 0x70, 0x12, 0x42, 0x0a, 0x1e,
+	0x6d, 0x69, 0x6e, 0x69, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a, 0x4d, 0x69, 0x6e, 0x69, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d,
+	0x12, 0x4a, 0x0a, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x5f, 0x74, 0x68, 0x65, 0x6f, 0x72, 0x65, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x65, 0x73,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1e, 0x4d, 0x69,
+	0x6e, 0x69, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x54, 0x68, 0x65, 0x6f, 0x72, 0x65, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x42, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10,
+	0x6d, 0x69, 0x6e, 0x69, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x67, 0x61, 0x70,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x4d, 0x69, 0x6e, 0x69, 0x53, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x47, 0x61, 0x70, 0x12, 0x3a, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x5f, 0x73,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66,
+	0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x53,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x4d, 0x69, 0x6e, 0x69, 0x53, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x22, 0x60, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x69,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x52, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x22, 0x39, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x22,
+	0xc4, 0x01, 0x0a, 0x0a, 0x4d, 0x69, 0x6e, 0x69, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x54, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x65,
+	0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x42,
+	0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x65, 0x73, 0x74, 0x5f,
+	0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x42,
+	0x65, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x70,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x4c, 0x61, 0x70,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f,
+	0x62, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x54, 0x69, 0x6d, 0x65,
+	0x54, 0x6f, 0x42, 0x65, 0x73, 0x74, 0x32, 0xc3, 0x02, 0x0a, 0x09, 0x54, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x12, 0x43, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x12, 0x1d, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x66, 0x31, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x66, 0x31,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x73, 0x12, 0x1c, 0x2e, 0x66, 0x31, 0x74,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x66, 0x31, 0x74, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x66, 0x31, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x40, 0x5a, 0x30,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x6f, 0x6d, 0x6d, 0x79,
+	0x2d, 0x34, 0x32, 0x2f, 0x66, 0x31, 0x2d, 0x32, 0x30, 0x32, 0x30, 0x2d, 0x67, 0x6f, 0x2d, 0x74,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x70, 0x63,
+	0xaa, 0x02, 0x0b, 0x46, 0x31, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_telemetry_proto_rawDescOnce sync.Once
+	file_telemetry_proto_rawDescData = file_telemetry_proto_rawDesc
+)
+
+func file_telemetry_proto_rawDescGZIP() []byte {
+	file_telemetry_proto_rawDescOnce.Do(func() {
+		file_telemetry_proto_rawDescData = protoimpl.X.CompressGZIP(file_telemetry_proto_rawDescData)
+	})
+	return file_telemetry_proto_rawDescData
+}
+
+var file_telemetry_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
+var file_telemetry_proto_goTypes = []interface{}{
+	(*SubscribeRequest)(nil),              // 0: f1telemetry.SubscribeRequest
+	(*Document)(nil),                      // 1: f1telemetry.Document
+	(*ListSessionsRequest)(nil),           // 2: f1telemetry.ListSessionsRequest
+	(*ListSessionsResponse)(nil),          // 3: f1telemetry.ListSessionsResponse
+	(*StoredSession)(nil),                 // 4: f1telemetry.StoredSession
+	(*ListLapsRequest)(nil),               // 5: f1telemetry.ListLapsRequest
+	(*ListLapsResponse)(nil),              // 6: f1telemetry.ListLapsResponse
+	(*StoredLap)(nil),                     // 7: f1telemetry.StoredLap
+	(*GetTelemetryRequest)(nil),           // 8: f1telemetry.GetTelemetryRequest
+	(*GetTelemetryResponse)(nil),          // 9: f1telemetry.GetTelemetryResponse
+	(*TelemetrySample)(nil),               // 10: f1telemetry.TelemetrySample
+	(*MotionData)(nil),                    // 11: f1telemetry.MotionData
+	(*SessionData)(nil),                   // 12: f1telemetry.SessionData
+	(*LapData)(nil),                       // 13: f1telemetry.LapData
+	(*EventData)(nil),                     // 14: f1telemetry.EventData
+	(*ParticipantsData)(nil),              // 15: f1telemetry.ParticipantsData
+	(*CarSetupData)(nil),                  // 16: f1telemetry.CarSetupData
+	(*CarTelemetryData)(nil),              // 17: f1telemetry.CarTelemetryData
+	(*CarStatusData)(nil),                 // 18: f1telemetry.CarStatusData
+	(*FinalClassificationData)(nil),       // 19: f1telemetry.FinalClassificationData
+	(*LobbyInfoData)(nil),                 // 20: f1telemetry.LobbyInfoData
+	(*Lap)(nil),                           // 21: f1telemetry.Lap
+	(*Stint)(nil),                         // 22: f1telemetry.Stint
+	(*FuelLap)(nil),                       // 23: f1telemetry.FuelLap
+	(*FuelStrategy)(nil),                  // 24: f1telemetry.FuelStrategy
+	(*PitStop)(nil),                       // 25: f1telemetry.PitStop
+	(*TrackMap)(nil),                      // 26: f1telemetry.TrackMap
+	(*CarPositions)(nil),                  // 27: f1telemetry.CarPositions
+	(*TimingTower)(nil),                   // 28: f1telemetry.TimingTower
+	(*PositionChange)(nil),                // 29: f1telemetry.PositionChange
+	(*Incident)(nil),                      // 30: f1telemetry.Incident
+	(*SessionSummary)(nil),                // 31: f1telemetry.SessionSummary
+	(*PersonalBest)(nil),                  // 32: f1telemetry.PersonalBest
+	(*Corners)(nil),                       // 33: f1telemetry.Corners
+	(*CornerLap)(nil),                     // 34: f1telemetry.CornerLap
+	(*BrakingEvent)(nil),                  // 35: f1telemetry.BrakingEvent
+	(*BrakingLap)(nil),                    // 36: f1telemetry.BrakingLap
+	(*WheelspinEvent)(nil),                // 37: f1telemetry.WheelspinEvent
+	(*TractionLap)(nil),                   // 38: f1telemetry.TractionLap
+	(*TractionSetting)(nil),               // 39: f1telemetry.TractionSetting
+	(*TheoreticalBest)(nil),               // 40: f1telemetry.TheoreticalBest
+	(*Header)(nil),                        // 41: f1telemetry.Header
+	(*CarMotionData)(nil),                 // 42: f1telemetry.CarMotionData
+	(*MarshalZone)(nil),                   // 43: f1telemetry.MarshalZone
+	(*WeatherForecastSample)(nil),         // 44: f1telemetry.WeatherForecastSample
+	(*PacketLapData)(nil),                 // 45: f1telemetry.PacketLapData
+	(*ParticipantData)(nil),               // 46: f1telemetry.ParticipantData
+	(*PacketCarSetupData)(nil),            // 47: f1telemetry.PacketCarSetupData
+	(*CarTelemetryDataDetails)(nil),       // 48: f1telemetry.CarTelemetryDataDetails
+	(*CarStatusDataDetail)(nil),           // 49: f1telemetry.CarStatusDataDetail
+	(*PacketFinalClassificationData)(nil), // 50: f1telemetry.PacketFinalClassificationData
+	(*PacketLobbyInfoData)(nil),           // 51: f1telemetry.PacketLobbyInfoData
+	(*StintLap)(nil),                      // 52: f1telemetry.StintLap
+	(*FuelMixUsage)(nil),                  // 53: f1telemetry.FuelMixUsage
+	(*PitStopTyres)(nil),                  // 54: f1telemetry.PitStopTyres
+	(*TrackMapPoint)(nil),                 // 55: f1telemetry.TrackMapPoint
+	(*CarOnTrack)(nil),                    // 56: f1telemetry.CarOnTrack
+	(*TimingEntry)(nil),                   // 57: f1telemetry.TimingEntry
+	(*IncidentSample)(nil),                // 58: f1telemetry.IncidentSample
+	(*WeatherSample)(nil),                 // 59: f1telemetry.WeatherSample
+	(*DriverResult)(nil),                  // 60: f1telemetry.DriverResult
+	(*Corner)(nil),                        // 61: f1telemetry.Corner
+	(*CornerReport)(nil),                  // 62: f1telemetry.CornerReport
+	(*DriverBest)(nil),                    // 63: f1telemetry.DriverBest
+	(*Source)(nil),                        // 64: f1telemetry.Source
+	(*SectorBest)(nil),                    // 65: f1telemetry.SectorBest
+	(*MiniSector)(nil),                    // 66: f1telemetry.MiniSector
+	(*timestamppb.Timestamp)(nil),         // 67: google.protobuf.Timestamp
+	(*structpb.Value)(nil),                // 68: google.protobuf.Value
+}
+var file_telemetry_proto_depIdxs = []int32{
+	11,  // 0: f1telemetry.Document.motion:type_name -> f1telemetry.MotionData
+	12,  // 1: f1telemetry.Document.session:type_name -> f1telemetry.SessionData
+	13,  // 2: f1telemetry.Document.lap_data:type_name -> f1telemetry.LapData
+	14,  // 3: f1telemetry.Document.event:type_name -> f1telemetry.EventData
+	15,  // 4: f1telemetry.Document.participants:type_name -> f1telemetry.ParticipantsData
+	16,  // 5: f1telemetry.Document.car_setups:type_name -> f1telemetry.CarSetupData
+	17,  // 6: f1telemetry.Document.car_telemetry:type_name -> f1telemetry.CarTelemetryData
+	18,  // 7: f1telemetry.Document.car_status:type_name -> f1telemetry.CarStatusData
+	19,  // 8: f1telemetry.Document.final_classification:type_name -> f1telemetry.FinalClassificationData
+	20,  // 9: f1telemetry.Document.lobby_info:type_name -> f1telemetry.LobbyInfoData
+	21,  // 10: f1telemetry.Document.lap:type_name -> f1telemetry.Lap
+	22,  // 11: f1telemetry.Document.stint:type_name -> f1telemetry.Stint
+	23,  // 12: f1telemetry.Document.fuel_lap:type_name -> f1telemetry.FuelLap
+	24,  // 13: f1telemetry.Document.fuel_strategy:type_name -> f1telemetry.FuelStrategy
+	25,  // 14: f1telemetry.Document.pit_stop:type_name -> f1telemetry.PitStop
+	26,  // 15: f1telemetry.Document.track_map:type_name -> f1telemetry.TrackMap
+	27,  // 16: f1telemetry.Document.car_positions:type_name -> f1telemetry.CarPositions
+	28,  // 17: f1telemetry.Document.timing_tower:type_name -> f1telemetry.TimingTower
+	29,  // 18: f1telemetry.Document.position_change:type_name -> f1telemetry.PositionChange
+	30,  // 19: f1telemetry.Document.incident:type_name -> f1telemetry.Incident
+	31,  // 20: f1telemetry.Document.session_summary:type_name -> f1telemetry.SessionSummary
+	32,  // 21: f1telemetry.Document.personal_best:type_name -> f1telemetry.PersonalBest
+	33,  // 22: f1telemetry.Document.corners:type_name -> f1telemetry.Corners
+	34,  // 23: f1telemetry.Document.corner_lap:type_name -> f1telemetry.CornerLap
+	35,  // 24: f1telemetry.Document.braking_event:type_name -> f1telemetry.BrakingEvent
+	36,  // 25: f1telemetry.Document.braking_lap:type_name -> f1telemetry.BrakingLap
+	37,  // 26: f1telemetry.Document.wheelspin_event:type_name -> f1telemetry.WheelspinEvent
+	38,  // 27: f1telemetry.Document.traction_lap:type_name -> f1telemetry.TractionLap
+	39,  // 28: f1telemetry.Document.traction_setting:type_name -> f1telemetry.TractionSetting
+	40,  // 29: f1telemetry.Document.theoretical_best:type_name -> f1telemetry.TheoreticalBest
+	4,   // 30: f1telemetry.ListSessionsResponse.sessions:type_name -> f1telemetry.StoredSession
+	67,  // 31: f1telemetry.StoredSession.created_at:type_name -> google.protobuf.Timestamp
+	67,  // 32: f1telemetry.StoredSession.updated_at:type_name -> google.protobuf.Timestamp
+	7,   // 33: f1telemetry.ListLapsResponse.laps:type_name -> f1telemetry.StoredLap
+	67,  // 34: f1telemetry.StoredLap.completed_at:type_name -> google.protobuf.Timestamp
+	10,  // 35: f1telemetry.GetTelemetryResponse.samples:type_name -> f1telemetry.TelemetrySample
+	67,  // 36: f1telemetry.TelemetrySample.time:type_name -> google.protobuf.Timestamp
+	41,  // 37: f1telemetry.MotionData.header:type_name -> f1telemetry.Header
+	67,  // 38: f1telemetry.MotionData.timestamp:type_name -> google.protobuf.Timestamp
+	42,  // 39: f1telemetry.MotionData.car_motion_data:type_name -> f1telemetry.CarMotionData
+	41,  // 40: f1telemetry.SessionData.header:type_name -> f1telemetry.Header
+	67,  // 41: f1telemetry.SessionData.timestamp:type_name -> google.protobuf.Timestamp
+	43,  // 42: f1telemetry.SessionData.marshal_zones:type_name -> f1telemetry.MarshalZone
+	44,  // 43: f1telemetry.SessionData.weather_forecast_samples:type_name -> f1telemetry.WeatherForecastSample
+	41,  // 44: f1telemetry.LapData.header:type_name -> f1telemetry.Header
+	67,  // 45: f1telemetry.LapData.timestamp:type_name -> google.protobuf.Timestamp
+	45,  // 46: f1telemetry.LapData.lap_data:type_name -> f1telemetry.PacketLapData
+	41,  // 47: f1telemetry.EventData.header:type_name -> f1telemetry.Header
+	67,  // 48: f1telemetry.EventData.timestamp:type_name -> google.protobuf.Timestamp
+	68,  // 49: f1telemetry.EventData.event_details:type_name -> google.protobuf.Value
+	41,  // 50: f1telemetry.ParticipantsData.header:type_name -> f1telemetry.Header
+	67,  // 51: f1telemetry.ParticipantsData.timestamp:type_name -> google.protobuf.Timestamp
+	46,  // 52: f1telemetry.ParticipantsData.participants:type_name -> f1telemetry.ParticipantData
+	41,  // 53: f1telemetry.CarSetupData.header:type_name -> f1telemetry.Header
+	67,  // 54: f1telemetry.CarSetupData.timestamp:type_name -> google.protobuf.Timestamp
+	47,  // 55: f1telemetry.CarSetupData.car_setups:type_name -> f1telemetry.PacketCarSetupData
+	41,  // 56: f1telemetry.CarTelemetryData.header:type_name -> f1telemetry.Header
+	67,  // 57: f1telemetry.CarTelemetryData.timestamp:type_name -> google.protobuf.Timestamp
+	48,  // 58: f1telemetry.CarTelemetryData.car_telemetry_data:type_name -> f1telemetry.CarTelemetryDataDetails
+	41,  // 59: f1telemetry.CarStatusData.header:type_name -> f1telemetry.Header
+	67,  // 60: f1telemetry.CarStatusData.timestamp:type_name -> google.protobuf.Timestamp
+	49,  // 61: f1telemetry.CarStatusData.car_status_data:type_name -> f1telemetry.CarStatusDataDetail
+	41,  // 62: f1telemetry.FinalClassificationData.header:type_name -> f1telemetry.Header
+	67,  // 63: f1telemetry.FinalClassificationData.timestamp:type_name -> google.protobuf.Timestamp
+	50,  // 64: f1telemetry.FinalClassificationData.classification_data:type_name -> f1telemetry.PacketFinalClassificationData
+	41,  // 65: f1telemetry.LobbyInfoData.header:type_name -> f1telemetry.Header
+	67,  // 66: f1telemetry.LobbyInfoData.timestamp:type_name -> google.protobuf.Timestamp
+	51,  // 67: f1telemetry.LobbyInfoData.lobby_players:type_name -> f1telemetry.PacketLobbyInfoData
+	41,  // 68: f1telemetry.Lap.header:type_name -> f1telemetry.Header
+	67,  // 69: f1telemetry.Lap.timestamp:type_name -> google.protobuf.Timestamp
+	41,  // 70: f1telemetry.Stint.header:type_name -> f1telemetry.Header
+	67,  // 71: f1telemetry.Stint.timestamp:type_name -> google.protobuf.Timestamp
+	52,  // 72: f1telemetry.Stint.laps:type_name -> f1telemetry.StintLap
+	41,  // 73: f1telemetry.FuelLap.header:type_name -> f1telemetry.Header
+	67,  // 74: f1telemetry.FuelLap.timestamp:type_name -> google.protobuf.Timestamp
+	53,  // 75: f1telemetry.FuelLap.fuel_mixes:type_name -> f1telemetry.FuelMixUsage
+	41,  // 76: f1telemetry.FuelStrategy.header:type_name -> f1telemetry.Header
+	67,  // 77: f1telemetry.FuelStrategy.timestamp:type_name -> google.protobuf.Timestamp
+	53,  // 78: f1telemetry.FuelStrategy.fuel_mixes:type_name -> f1telemetry.FuelMixUsage
+	41,  // 79: f1telemetry.PitStop.header:type_name -> f1telemetry.Header
+	67,  // 80: f1telemetry.PitStop.timestamp:type_name -> google.protobuf.Timestamp
+	54,  // 81: f1telemetry.PitStop.tyres_before:type_name -> f1telemetry.PitStopTyres
+	54,  // 82: f1telemetry.PitStop.tyres_after:type_name -> f1telemetry.PitStopTyres
+	41,  // 83: f1telemetry.TrackMap.header:type_name -> f1telemetry.Header
+	67,  // 84: f1telemetry.TrackMap.timestamp:type_name -> google.protobuf.Timestamp
+	55,  // 85: f1telemetry.TrackMap.points:type_name -> f1telemetry.TrackMapPoint
+	41,  // 86: f1telemetry.CarPositions.header:type_name -> f1telemetry.Header
+	67,  // 87: f1telemetry.CarPositions.timestamp:type_name -> google.protobuf.Timestamp
+	56,  // 88: f1telemetry.CarPositions.cars:type_name -> f1telemetry.CarOnTrack
+	41,  // 89: f1telemetry.TimingTower.header:type_name -> f1telemetry.Header
+	67,  // 90: f1telemetry.TimingTower.timestamp:type_name -> google.protobuf.Timestamp
+	57,  // 91: f1telemetry.TimingTower.cars:type_name -> f1telemetry.TimingEntry
+	41,  // 92: f1telemetry.PositionChange.header:type_name -> f1telemetry.Header
+	67,  // 93: f1telemetry.PositionChange.timestamp:type_name -> google.protobuf.Timestamp
+	41,  // 94: f1telemetry.Incident.header:type_name -> f1telemetry.Header
+	67,  // 95: f1telemetry.Incident.timestamp:type_name -> google.protobuf.Timestamp
+	58,  // 96: f1telemetry.Incident.snippet:type_name -> f1telemetry.IncidentSample
+	41,  // 97: f1telemetry.SessionSummary.header:type_name -> f1telemetry.Header
+	67,  // 98: f1telemetry.SessionSummary.timestamp:type_name -> google.protobuf.Timestamp
+	59,  // 99: f1telemetry.SessionSummary.weather:type_name -> f1telemetry.WeatherSample
+	60,  // 100: f1telemetry.SessionSummary.drivers:type_name -> f1telemetry.DriverResult
+	41,  // 101: f1telemetry.PersonalBest.header:type_name -> f1telemetry.Header
+	67,  // 102: f1telemetry.PersonalBest.timestamp:type_name -> google.protobuf.Timestamp
+	41,  // 103: f1telemetry.Corners.header:type_name -> f1telemetry.Header
+	67,  // 104: f1telemetry.Corners.timestamp:type_name -> google.protobuf.Timestamp
+	61,  // 105: f1telemetry.Corners.corners:type_name -> f1telemetry.Corner
+	41,  // 106: f1telemetry.CornerLap.header:type_name -> f1telemetry.Header
+	67,  // 107: f1telemetry.CornerLap.timestamp:type_name -> google.protobuf.Timestamp
+	62,  // 108: f1telemetry.CornerLap.corners:type_name -> f1telemetry.CornerReport
+	41,  // 109: f1telemetry.BrakingEvent.header:type_name -> f1telemetry.Header
+	67,  // 110: f1telemetry.BrakingEvent.timestamp:type_name -> google.protobuf.Timestamp
+	41,  // 111: f1telemetry.BrakingLap.header:type_name -> f1telemetry.Header
+	67,  // 112: f1telemetry.BrakingLap.timestamp:type_name -> google.protobuf.Timestamp
+	41,  // 113: f1telemetry.WheelspinEvent.header:type_name -> f1telemetry.Header
+	67,  // 114: f1telemetry.WheelspinEvent.timestamp:type_name -> google.protobuf.Timestamp
+	41,  // 115: f1telemetry.TractionLap.header:type_name -> f1telemetry.Header
+	67,  // 116: f1telemetry.TractionLap.timestamp:type_name -> google.protobuf.Timestamp
+	41,  // 117: f1telemetry.TractionSetting.header:type_name -> f1telemetry.Header
+	67,  // 118: f1telemetry.TractionSetting.timestamp:type_name -> google.protobuf.Timestamp
+	41,  // 119: f1telemetry.TheoreticalBest.header:type_name -> f1telemetry.Header
+	67,  // 120: f1telemetry.TheoreticalBest.timestamp:type_name -> google.protobuf.Timestamp
+	63,  // 121: f1telemetry.TheoreticalBest.drivers:type_name -> f1telemetry.DriverBest
+	64,  // 122: f1telemetry.Header.source:type_name -> f1telemetry.Source
+	65,  // 123: f1telemetry.DriverBest.best_sectors:type_name -> f1telemetry.SectorBest
+	66,  // 124: f1telemetry.DriverBest.mini_sectors:type_name -> f1telemetry.MiniSector
+	0,   // 125: f1telemetry.Telemetry.Subscribe:input_type -> f1telemetry.SubscribeRequest
+	2,   // 126: f1telemetry.Telemetry.ListSessions:input_type -> f1telemetry.ListSessionsRequest
+	5,   // 127: f1telemetry.Telemetry.ListLaps:input_type -> f1telemetry.ListLapsRequest
+	8,   // 128: f1telemetry.Telemetry.GetTelemetry:input_type -> f1telemetry.GetTelemetryRequest
+	1,   // 129: f1telemetry.Telemetry.Subscribe:output_type -> f1telemetry.Document
+	3,   // 130: f1telemetry.Telemetry.ListSessions:output_type -> f1telemetry.ListSessionsResponse
+	6,   // 131: f1telemetry.Telemetry.ListLaps:output_type -> f1telemetry.ListLapsResponse
+	9,   // 132: f1telemetry.Telemetry.GetTelemetry:output_type -> f1telemetry.GetTelemetryResponse
+	129, // [129:133] is the sub-list for method output_type
+	125, // [125:129] is the sub-list for method input_type
+	125, // [125:125] is the sub-list for extension type_name
+	125, // [125:125] is the sub-list for extension extendee
+	0,   // [0:125] is the sub-list for field type_name
+}
+
+func init() { file_telemetry_proto_init() }
+func file_telemetry_proto_init() {
+	if File_telemetry_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_telemetry_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Document); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoredSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoredLap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTelemetryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTelemetryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TelemetrySample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MotionData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LapData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantsData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarSetupData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarTelemetryData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarStatusData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalClassificationData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyInfoData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FuelLap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FuelStrategy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PitStop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackMap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarPositions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimingTower); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PositionChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Incident); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonalBest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Corners); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CornerLap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrakingEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrakingLap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WheelspinEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TractionLap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TractionSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TheoreticalBest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Header); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarMotionData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarshalZone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeatherForecastSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketLapData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketCarSetupData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarTelemetryDataDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarStatusDataDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketFinalClassificationData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketLobbyInfoData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StintLap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FuelMixUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PitStopTyres); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackMapPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarOnTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimingEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncidentSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeatherSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DriverResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Corner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CornerReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DriverBest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Source); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SectorBest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MiniSector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_telemetry_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*Document_Motion)(nil),
+		(*Document_Session)(nil),
+		(*Document_LapData)(nil),
+		(*Document_Event)(nil),
+		(*Document_Participants)(nil),
+		(*Document_CarSetups)(nil),
+		(*Document_CarTelemetry)(nil),
+		(*Document_CarStatus)(nil),
+		(*Document_FinalClassification)(nil),
+		(*Document_LobbyInfo)(nil),
+		(*Document_Lap)(nil),
+		(*Document_Stint)(nil),
+		(*Document_FuelLap)(nil),
+		(*Document_FuelStrategy)(nil),
+		(*Document_PitStop)(nil),
+		(*Document_TrackMap)(nil),
+		(*Document_CarPositions)(nil),
+		(*Document_TimingTower)(nil),
+		(*Document_PositionChange)(nil),
+		(*Document_Incident)(nil),
+		(*Document_SessionSummary)(nil),
+		(*Document_PersonalBest)(nil),
+		(*Document_Corners)(nil),
+		(*Document_CornerLap)(nil),
+		(*Document_BrakingEvent)(nil),
+		(*Document_BrakingLap)(nil),
+		(*Document_WheelspinEvent)(nil),
+		(*Document_TractionLap)(nil),
+		(*Document_TractionSetting)(nil),
+		(*Document_TheoreticalBest)(nil),
+	}
+	file_telemetry_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_telemetry_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_telemetry_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   67,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_telemetry_proto_goTypes,
+		DependencyIndexes: file_telemetry_proto_depIdxs,
+		MessageInfos:      file_telemetry_proto_msgTypes,
+	}.Build()
+	File_telemetry_proto = out.File
+	file_telemetry_proto_rawDesc = nil
+	file_telemetry_proto_goTypes = nil
+	file_telemetry_proto_depIdxs = nil
+}
@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: telemetry.proto
+
+package rpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TelemetryClient is the client API for Telemetry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TelemetryClient interface {
+	// Subscribe streams the documents produced from now on, filtered by the request.
+	// A subscriber too slow to keep up misses documents.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Telemetry_SubscribeClient, error)
+	// ListSessions returns the latest sessions stored, the latest updated first
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// ListLaps returns the laps stored of a session ordered by car and lap number
+	ListLaps(ctx context.Context, in *ListLapsRequest, opts ...grpc.CallOption) (*ListLapsResponse, error)
+	// GetTelemetry returns the telemetry stored of a car between two session times
+	GetTelemetry(ctx context.Context, in *GetTelemetryRequest, opts ...grpc.CallOption) (*GetTelemetryResponse, error)
+}
+
+type telemetryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTelemetryClient(cc grpc.ClientConnInterface) TelemetryClient {
+	return &telemetryClient{cc}
+}
+
+func (c *telemetryClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Telemetry_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Telemetry_ServiceDesc.Streams[0], "/f1telemetry.Telemetry/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &telemetrySubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Telemetry_SubscribeClient interface {
+	Recv() (*Document, error)
+	grpc.ClientStream
+}
+
+type telemetrySubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *telemetrySubscribeClient) Recv() (*Document, error) {
+	m := new(Document)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *telemetryClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/f1telemetry.Telemetry/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryClient) ListLaps(ctx context.Context, in *ListLapsRequest, opts ...grpc.CallOption) (*ListLapsResponse, error) {
+	out := new(ListLapsResponse)
+	err := c.cc.Invoke(ctx, "/f1telemetry.Telemetry/ListLaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryClient) GetTelemetry(ctx context.Context, in *GetTelemetryRequest, opts ...grpc.CallOption) (*GetTelemetryResponse, error) {
+	out := new(GetTelemetryResponse)
+	err := c.cc.Invoke(ctx, "/f1telemetry.Telemetry/GetTelemetry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TelemetryServer is the server API for Telemetry service.
+// All implementations must embed UnimplementedTelemetryServer
+// for forward compatibility
+type TelemetryServer interface {
+	// Subscribe streams the documents produced from now on, filtered by the request.
+	// A subscriber too slow to keep up misses documents.
+	Subscribe(*SubscribeRequest, Telemetry_SubscribeServer) error
+	// ListSessions returns the latest sessions stored, the latest updated first
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// ListLaps returns the laps stored of a session ordered by car and lap number
+	ListLaps(context.Context, *ListLapsRequest) (*ListLapsResponse, error)
+	// GetTelemetry returns the telemetry stored of a car between two session times
+	GetTelemetry(context.Context, *GetTelemetryRequest) (*GetTelemetryResponse, error)
+	mustEmbedUnimplementedTelemetryServer()
+}
+
+// UnimplementedTelemetryServer must be embedded to have forward compatible implementations.
+type UnimplementedTelemetryServer struct {
+}
+
+func (UnimplementedTelemetryServer) Subscribe(*SubscribeRequest, Telemetry_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedTelemetryServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedTelemetryServer) ListLaps(context.Context, *ListLapsRequest) (*ListLapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLaps not implemented")
+}
+func (UnimplementedTelemetryServer) GetTelemetry(context.Context, *GetTelemetryRequest) (*GetTelemetryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTelemetry not implemented")
+}
+func (UnimplementedTelemetryServer) mustEmbedUnimplementedTelemetryServer() {}
+
+// UnsafeTelemetryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TelemetryServer will
+// result in compilation errors.
+type UnsafeTelemetryServer interface {
+	mustEmbedUnimplementedTelemetryServer()
+}
+
+func RegisterTelemetryServer(s grpc.ServiceRegistrar, srv TelemetryServer) {
+	s.RegisterService(&Telemetry_ServiceDesc, srv)
+}
+
+func _Telemetry_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TelemetryServer).Subscribe(m, &telemetrySubscribeServer{stream})
+}
+
+type Telemetry_SubscribeServer interface {
+	Send(*Document) error
+	grpc.ServerStream
+}
+
+type telemetrySubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *telemetrySubscribeServer) Send(m *Document) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Telemetry_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/f1telemetry.Telemetry/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Telemetry_ListLaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServer).ListLaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/f1telemetry.Telemetry/ListLaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServer).ListLaps(ctx, req.(*ListLapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Telemetry_GetTelemetry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTelemetryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServer).GetTelemetry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/f1telemetry.Telemetry/GetTelemetry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServer).GetTelemetry(ctx, req.(*GetTelemetryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Telemetry_ServiceDesc is the grpc.ServiceDesc for Telemetry service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Telemetry_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "f1telemetry.Telemetry",
+	HandlerType: (*TelemetryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSessions",
+			Handler:    _Telemetry_ListSessions_Handler,
+		},
+		{
+			MethodName: "ListLaps",
+			Handler:    _Telemetry_ListLaps_Handler,
+		},
+		{
+			MethodName: "GetTelemetry",
+			Handler:    _Telemetry_GetTelemetry_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Telemetry_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "telemetry.proto",
+}
@@ -0,0 +1,152 @@
+package capture
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+// Magic starts every capture
+const Magic = "F1CAPTURE1"
+
+// A capture is Magic followed by a record per packet received:
+// the time it was received in nanoseconds since the epoch ( int64 ), the local port it was received on ( uint16 ),
+// the address, rig and driver of its source, each as a length ( uint8 ) and a string,
+// the length of the packet ( uint16 ) and the packet, little endian like the packets themselves.
+
+// Record is a packet of a capture
+type Record struct {
+	Time   time.Time // Time the packet was received
+	Source models.Source
+	Packet []byte
+}
+
+// From returns whether the packet was sent by a rig, given by name or by address, every rig matches an empty rig
+func (r Record) From(rig string) bool {
+	return rig == "" || r.Source.Rig == rig || r.Source.Address == rig
+}
+
+// Writer appends the packets received to a capture, it is safe for concurrent use
+type Writer struct {
+	mu     sync.Mutex
+	w      *bufio.Writer
+	closer io.Closer
+}
+
+// NewWriter starts a capture, w is closed with the writer when it is an io.Closer
+func NewWriter(w io.Writer) (*Writer, error) {
+	c := &Writer{w: bufio.NewWriter(w)}
+	if closer, ok := w.(io.Closer); ok {
+		c.closer = closer
+	}
+	if _, err := c.w.WriteString(Magic); err != nil {
+		return nil, errors.Wrap(err, "could not write capture header")
+	}
+	return c, nil
+}
+
+// Write appends a packet received at t from a source
+func (c *Writer) Write(t time.Time, source models.Source, packet []byte) error {
+	if len(packet) > 0xFFFF {
+		return errors.Errorf("packet too large to be captured: %d bytes", len(packet))
+	}
+	record := make([]byte, 10, 10+3+len(source.Address)+len(source.Rig)+len(source.Driver)+2+len(packet))
+	binary.LittleEndian.PutUint64(record[:8], uint64(t.UnixNano()))
+	binary.LittleEndian.PutUint16(record[8:], uint16(source.Port))
+	for _, s := range []string{source.Address, source.Rig, source.Driver} {
+		if len(s) > 0xFF {
+			return errors.Errorf("source too long to be captured: %q", s)
+		}
+		record = append(record, uint8(len(s)))
+		record = append(record, s...)
+	}
+	record = append(record, 0, 0)
+	binary.LittleEndian.PutUint16(record[len(record)-2:], uint16(len(packet)))
+	record = append(record, packet...)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := c.w.Write(record); err != nil {
+		return errors.Wrap(err, "could not write packet to capture")
+	}
+	return nil
+}
+
+// Flush writes the packets buffered
+func (c *Writer) Flush() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return errors.Wrap(c.w.Flush(), "could not flush capture")
+}
+
+// Close flushes the capture and closes the underlying writer
+func (c *Writer) Close() error {
+	if err := c.Flush(); err != nil {
+		return err
+	}
+	if c.closer == nil {
+		return nil
+	}
+	return errors.Wrap(c.closer.Close(), "could not close capture")
+}
+
+// Reader reads the packets of a capture in the order they were received
+type Reader struct {
+	r *bufio.Reader
+}
+
+// NewReader checks the capture header and returns a reader of its packets
+func NewReader(r io.Reader) (*Reader, error) {
+	c := &Reader{r: bufio.NewReader(r)}
+	magic := make([]byte, len(Magic))
+	if _, err := io.ReadFull(c.r, magic); err != nil {
+		return nil, errors.Wrap(err, "could not read capture header")
+	}
+	if string(magic) != Magic {
+		return nil, errors.New("not a capture")
+	}
+	return c, nil
+}
+
+// Next returns the next packet, io.EOF at the end of the capture
+func (c *Reader) Next() (Record, error) {
+	header := [10]byte{}
+	if _, err := io.ReadFull(c.r, header[:]); err != nil {
+		if err == io.EOF {
+			return Record{}, io.EOF
+		}
+		return Record{}, errors.Wrap(err, "could not read capture record")
+	}
+	record := Record{
+		Time:   time.Unix(0, int64(binary.LittleEndian.Uint64(header[:8]))).UTC(),
+		Source: models.Source{Port: int(binary.LittleEndian.Uint16(header[8:]))},
+	}
+
+	for _, s := range []*string{&record.Source.Address, &record.Source.Rig, &record.Source.Driver} {
+		n, err := c.r.ReadByte()
+		if err != nil {
+			return Record{}, errors.Wrap(err, "could not read capture record source")
+		}
+		b := make([]byte, n)
+		if _, err := io.ReadFull(c.r, b); err != nil {
+			return Record{}, errors.Wrap(err, "could not read capture record source")
+		}
+		*s = string(b)
+	}
+
+	length := [2]byte{}
+	if _, err := io.ReadFull(c.r, length[:]); err != nil {
+		return Record{}, errors.Wrap(err, "could not read capture record")
+	}
+	record.Packet = make([]byte, binary.LittleEndian.Uint16(length[:]))
+	if _, err := io.ReadFull(c.r, record.Packet); err != nil {
+		return Record{}, errors.Wrap(err, "could not read captured packet")
+	}
+	return record, nil
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/compare"
+)
+
+// compare overlays two laps saved by the ingester and exports the comparison as CSV or JSON
+//
+//	go run ./cmd/compare -dir ./laps -session 123 -car 0 -with-car 1 -format csv -o compare.csv
+func main() {
+
+	dir := flag.String("dir", ".", "directory the laps are saved to ( F1_LAPS )")
+	session := flag.String("session", "", "session UID of the reference lap")
+	car := flag.Uint("car", 0, "car index of the reference lap")
+	lap := flag.Uint("lap", 0, "lap number of the reference lap, 0 for the best valid lap of the car")
+	withSession := flag.String("with-session", "", "session UID of the other lap, defaults to -session")
+	withCar := flag.Int("with-car", -1, "car index of the other lap, defaults to -car")
+	withLap := flag.Uint("with-lap", 0, "lap number of the other lap, 0 for the best valid lap of the car")
+	step := flag.Float64("step", compare.DefaultStep, "distance in metres between two points")
+	format := flag.String("format", "csv", "export format: csv or json")
+	output := flag.String("o", "", "output file, stdout if empty")
+	flag.Parse()
+
+	if *session == "" {
+		fmt.Printf("a session is required\n")
+		os.Exit(1)
+	}
+	if *withSession == "" {
+		*withSession = *session
+	}
+	if *withCar < 0 {
+		*withCar = int(*car)
+	}
+	if *car > 21 || *withCar > 21 || *lap > 255 || *withLap > 255 {
+		fmt.Printf("car indexes go from 0 to 21 and lap numbers up to 255\n")
+		os.Exit(1)
+	}
+	if *step < compare.MinStep {
+		fmt.Printf("the step is at least %v metres\n", compare.MinStep)
+		os.Exit(1)
+	}
+
+	store, err := compare.NewStore(*dir)
+	if err != nil {
+		fmt.Printf("could not open lap store: %v\n", err)
+		os.Exit(1)
+	}
+	a, err := store.Get(*session, uint8(*car), uint8(*lap))
+	if err != nil {
+		fmt.Printf("could not load reference lap: %v\n", err)
+		os.Exit(1)
+	}
+	b, err := store.Get(*withSession, uint8(*withCar), uint8(*withLap))
+	if err != nil {
+		fmt.Printf("could not load lap: %v\n", err)
+		os.Exit(1)
+	}
+	comparison := compare.Compare(a, b, float32(*step))
+
+	var out bytes.Buffer
+	switch *format {
+	case "csv":
+		if err := comparison.WriteCSV(&out); err != nil {
+			fmt.Printf("could not export comparison to csv: %v\n", err)
+			os.Exit(1)
+		}
+	case "json":
+		data, err := json.MarshalIndent(comparison, "", "  ")
+		if err != nil {
+			fmt.Printf("could not export comparison to json: %v\n", err)
+			os.Exit(1)
+		}
+		out.Write(data)
+	default:
+		fmt.Printf("unknown format %q\n", *format)
+		os.Exit(1)
+	}
+
+	if *output == "" {
+		os.Stdout.Write(out.Bytes())
+		return
+	}
+	if err := ioutil.WriteFile(*output, out.Bytes(), 0644); err != nil {
+		fmt.Printf("could not write %s: %v\n", *output, err)
+		os.Exit(1)
+	}
+}
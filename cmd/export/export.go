@@ -0,0 +1,242 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"strings"
+
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/export"
+	"github.com/Tommy-42/f1-2020-go-telemetry/export/motec"
+)
+
+// export writes a session as Parquet files partitioned by session and car,
+// from a capture of the raw packets ( F1_CAPTURE ) or from the postgres or sqlite repository,
+// or a lap or a session of a car from a capture as CSV or as a MoTeC i2 log file
+//
+//	go run ./cmd/export -capture session.f1cap -session 123 -o ./parquet
+//	go run ./cmd/export -sqlite f1.db -session 123 -o ./parquet
+//	go run ./cmd/export -format csv -capture session.f1cap -lap 3 -units speed=mph,temperature=F -o lap3.csv
+//	go run ./cmd/export -format motec -capture session.f1cap -lap 3 -o lap3.ld
+func main() {
+
+	format := flag.String("format", "parquet", "export format: parquet, csv or motec")
+	capturePath := flag.String("capture", "", "capture of the raw packets")
+	sqlitePath := flag.String("sqlite", "", "database of the sqlite repository ( SQLITE_PATH ), parquet only")
+	postgresURL := flag.String("postgres", "", "connection string of the postgres repository ( POSTGRES_URL ), parquet only")
+	session := flag.String("session", "", "session UID, every session of a capture if empty, the first one for csv and motec")
+	rig := flag.String("rig", "", "rig name or address of the sender of the packets of a capture, every rig if empty")
+	output := flag.String("o", "", "output directory for parquet, default export, output file for csv, stdout if empty, and for motec, default export.ld")
+
+	car := flag.Int("car", -1, "csv, motec: car index, the player car if negative")
+	lap := flag.Int("lap", 0, "csv, motec: lap number, the whole session if 0")
+	channels := flag.String("channels", "", "csv, motec: comma separated channels, default "+strings.Join(export.DefaultChannels, ",")+" for csv and every channel but time for motec")
+	units := flag.String("units", "", "csv, motec: comma separated units of the quantities, ie: speed=mph,temperature=F")
+	headers := flag.String("headers", "", "csv, motec: comma separated headers, or names, of the channels, ie: speed=Speed,rpm=RPM")
+	list := flag.Bool("list", false, "csv, motec: list the channels and units and exit")
+	unitsRow := flag.Bool("units-row", false, "csv: write the units on a second header line")
+	comma := flag.String("comma", ",", "csv: field delimiter")
+	frequency := flag.Int("frequency", motec.DefaultFrequency, "motec: frequency the channels are sampled at in Hz")
+	flag.Parse()
+
+	switch *format {
+	case "parquet":
+		parquet(*capturePath, *sqlitePath, *postgresURL, *session, *rig, *output)
+	case "csv", "motec":
+		if *list {
+			listChannels()
+			return
+		}
+		if *capturePath == "" {
+			fmt.Printf("a capture is required to export as %s\n", *format)
+			os.Exit(1)
+		}
+		ids := []string{}
+		if *channels != "" {
+			ids = strings.Split(*channels, ",")
+		}
+		unitsByQuantity, err := pairs(*units)
+		if err != nil {
+			fmt.Printf("invalid units: %v\n", err)
+			os.Exit(1)
+		}
+		headersByChannel, err := pairs(*headers)
+		if err != nil {
+			fmt.Printf("invalid headers: %v\n", err)
+			os.Exit(1)
+		}
+		if *format == "motec" {
+			if len(ids) == 0 {
+				ids = motec.DefaultChannels()
+			}
+			for quantity, unit := range motec.Units {
+				if _, ok := unitsByQuantity[quantity]; !ok {
+					unitsByQuantity[quantity] = unit
+				}
+			}
+		}
+		columns, err := export.Columns(ids, unitsByQuantity, headersByChannel)
+		if err != nil {
+			fmt.Printf("%v\n", err)
+			os.Exit(1)
+		}
+		log := readLog(*capturePath, *session, *rig, *car, *lap)
+
+		if *format == "motec" {
+			if *frequency <= 0 || *frequency > math.MaxUint16 {
+				fmt.Printf("invalid frequency %d\n", *frequency)
+				os.Exit(1)
+			}
+			path := *output
+			if path == "" {
+				path = "export.ld"
+			}
+			if err := motec.Write(path, log, columns, uint16(*frequency)); err != nil {
+				fmt.Printf("could not export: %v\n", err)
+				os.Exit(1)
+			}
+			return
+		}
+
+		delimiter := []rune(*comma)
+		if len(delimiter) != 1 {
+			fmt.Printf("the delimiter must be a single character\n")
+			os.Exit(1)
+		}
+		writeCSV(log, *output, export.CSVOptions{
+			Columns:  columns,
+			UnitsRow: *unitsRow,
+			Comma:    delimiter[0],
+		})
+	default:
+		fmt.Printf("unknown format %q\n", *format)
+		os.Exit(1)
+	}
+}
+
+func parquet(capturePath, sqlitePath, postgresURL, session, rig, output string) {
+	if output == "" {
+		output = "export"
+	}
+
+	var written map[string]int
+	var err error
+	switch {
+	case capturePath != "":
+		f, openErr := os.Open(capturePath)
+		if openErr != nil {
+			fmt.Printf("could not open capture: %v\n", openErr)
+			os.Exit(1)
+		}
+		defer f.Close()
+		written, err = export.Capture(f, output, session, rig)
+
+	case sqlitePath != "" || postgresURL != "":
+		if session == "" {
+			fmt.Printf("a session is required to export from a repository\n")
+			os.Exit(1)
+		}
+		driver, dsn, placeholder := "sqlite", "file:"+sqlitePath+"?mode=ro", export.SQLitePlaceholder
+		if postgresURL != "" {
+			driver, dsn, placeholder = "postgres", postgresURL, export.PostgresPlaceholder
+		}
+		db, openErr := sql.Open(driver, dsn)
+		if openErr != nil {
+			fmt.Printf("could not open database: %v\n", openErr)
+			os.Exit(1)
+		}
+		defer db.Close()
+		written, err = export.Database(context.Background(), db, placeholder, output, session)
+
+	default:
+		fmt.Printf("a capture, a sqlite database or a postgres connection string is required\n")
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Printf("could not export: %v\n", err)
+		os.Exit(1)
+	}
+
+	for _, path := range export.Paths(written) {
+		fmt.Printf("%s: %d rows\n", path, written[path])
+	}
+}
+
+// readLog reads a lap or a session of a car from a capture
+func readLog(capturePath, session, rig string, car, lap int) *export.Log {
+	f, err := os.Open(capturePath)
+	if err != nil {
+		fmt.Printf("could not open capture: %v\n", err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	log, err := export.ReadLog(f, session, rig, car)
+	if err != nil {
+		fmt.Printf("could not read capture: %v\n", err)
+		os.Exit(1)
+	}
+	if lap > 0 {
+		log, err = log.Lap(uint8(lap))
+		if err != nil {
+			fmt.Printf("could not export lap %d: %v\n", lap, err)
+			os.Exit(1)
+		}
+	}
+	return log
+}
+
+func writeCSV(log *export.Log, output string, options export.CSVOptions) {
+	var out bytes.Buffer
+	if err := export.WriteCSV(&out, log, options); err != nil {
+		fmt.Printf("could not export: %v\n", err)
+		os.Exit(1)
+	}
+
+	if output == "" {
+		os.Stdout.Write(out.Bytes())
+		return
+	}
+	if err := ioutil.WriteFile(output, out.Bytes(), 0644); err != nil {
+		fmt.Printf("could not write %s: %v\n", output, err)
+		os.Exit(1)
+	}
+}
+
+func listChannels() {
+	for _, c := range export.Channels {
+		quantity := c.Quantity
+		if quantity == "" {
+			quantity = "-"
+		}
+		fmt.Printf("%-22s %-20s %s\n", c.ID, c.Name, quantity)
+	}
+	fmt.Println()
+	for _, quantity := range []string{export.Time, export.Distance, export.Speed, export.Temperature, export.Pressure, export.Ratio, export.Acceleration, export.Angle, export.Mass, export.Energy} {
+		fmt.Printf("%-13s %s\n", quantity, strings.Join(export.Units[quantity], ", "))
+	}
+}
+
+// pairs parses a comma separated list of key=value
+func pairs(s string) (map[string]string, error) {
+	m := map[string]string{}
+	if s == "" {
+		return m, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("%q is not key=value", pair)
+		}
+		m[strings.TrimSpace(kv[0])] = kv[1]
+	}
+	return m, nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/summary"
+)
+
+// summary renders the summary of a session saved by the ingester as Markdown or HTML
+//
+//	go run ./cmd/summary -dir ./summaries -session 123 -format html -o report.html
+func main() {
+
+	dir := flag.String("dir", ".", "directory the summaries are saved to ( F1_SUMMARIES )")
+	session := flag.String("session", "", "session UID")
+	file := flag.String("file", "", "summary file, instead of -dir and -session")
+	format := flag.String("format", "markdown", "render format: markdown or html")
+	output := flag.String("o", "", "output file, stdout if empty")
+	flag.Parse()
+
+	path := *file
+	if path == "" {
+		if *session == "" {
+			fmt.Printf("a session or a file is required\n")
+			os.Exit(1)
+		}
+		path = summary.Path(*dir, *session)
+	}
+
+	s, err := summary.Load(path)
+	if err != nil {
+		fmt.Printf("could not load summary: %v\n", err)
+		os.Exit(1)
+	}
+
+	var out bytes.Buffer
+	switch *format {
+	case "markdown", "md":
+		err = summary.Markdown(&out, s)
+	case "html":
+		err = summary.HTML(&out, s)
+	default:
+		fmt.Printf("unknown format %q\n", *format)
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Printf("could not render summary: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *output == "" {
+		os.Stdout.Write(out.Bytes())
+		return
+	}
+	if err := ioutil.WriteFile(*output, out.Bytes(), 0644); err != nil {
+		fmt.Printf("could not write %s: %v\n", *output, err)
+		os.Exit(1)
+	}
+}
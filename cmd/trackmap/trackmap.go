@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/trackmap"
+)
+
+// trackmap exports a track map built by the ingester as GeoJSON or SVG
+//
+//	go run ./cmd/trackmap -dir ./maps -track 10 -format svg -o spa.svg
+func main() {
+
+	dir := flag.String("dir", ".", "directory the track maps are saved to ( F1_TRACK_MAPS )")
+	track := flag.Int("track", -1, "track id, see appendix")
+	format := flag.String("format", "geojson", "export format: geojson or svg")
+	size := flag.Int("size", 800, "width and height of the svg export")
+	output := flag.String("o", "", "output file, stdout if empty")
+	flag.Parse()
+
+	if *track < 0 || *track > 127 {
+		fmt.Printf("a track id is required\n")
+		os.Exit(1)
+	}
+
+	m, err := trackmap.Load(trackmap.Path(*dir, int8(*track)))
+	if err != nil {
+		fmt.Printf("could not load track map: %v\n", err)
+		os.Exit(1)
+	}
+
+	var out bytes.Buffer
+	switch *format {
+	case "geojson":
+		data, err := m.GeoJSON()
+		if err != nil {
+			fmt.Printf("could not export track map to geojson: %v\n", err)
+			os.Exit(1)
+		}
+		out.Write(data)
+	case "svg":
+		if err := m.SVG(&out, *size); err != nil {
+			fmt.Printf("could not export track map to svg: %v\n", err)
+			os.Exit(1)
+		}
+	default:
+		fmt.Printf("unknown format %q\n", *format)
+		os.Exit(1)
+	}
+
+	if *output == "" {
+		os.Stdout.Write(out.Bytes())
+		return
+	}
+	if err := ioutil.WriteFile(*output, out.Bytes(), 0644); err != nil {
+		fmt.Printf("could not write %s: %v\n", *output, err)
+		os.Exit(1)
+	}
+}
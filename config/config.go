@@ -1,7 +1,147 @@
 package config
 
-import "github.com/Tommy-42/f1-2020-go-telemetry/repository/elastic"
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/elastic"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/influx"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/kafka"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/mqtt"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/nats"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/postgres"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/sqlite"
+)
+
+// DefaultPort is the UDP port the game sends telemetry to by default
+const DefaultPort = 20777
+
+// Repositories supported by ParseRepositories
+const (
+	RepositoryElastic  = "elastic"
+	RepositoryInflux   = "influx"
+	RepositoryPostgres = "postgres"
+	RepositorySQLite   = "sqlite"
+	RepositoryKafka    = "kafka"
+	RepositoryMQTT     = "mqtt"
+	RepositoryNATS     = "nats"
+)
 
 type Config struct {
-	Elastic elastic.Config
+	Repositories []string // Repositories the documents are stored to
+	Elastic      elastic.Config
+	Influx       influx.Config
+	Postgres     postgres.Config
+	SQLite       sqlite.Config
+	Kafka        kafka.Config
+	MQTT         mqtt.Config
+	NATS         nats.Config
+
+	Ports []int // UDP ports to listen on
+	Rigs  []Rig // Known rigs, used to tag the documents with a rig and driver name
+
+	APIAddress  string // Address the HTTP API listens on, the API is disabled when empty
+	GRPCAddress string // Address the gRPC API listens on, the gRPC API is disabled when empty
+	CaptureDir  string // Directory the raw packets are captured to, nothing is captured when empty
+}
+
+// Rig maps a sender to a rig and driver name.
+type Rig struct {
+	// Address is either the IP of the sender ( 192.168.1.21 )
+	// or a local port prefixed by a colon ( :20778 ) when each rig sends to its own port.
+	Address string
+	Name    string
+	Driver  string
+}
+
+// RigFor returns the rig matching a sender IP or the local port the packet was received on.
+// A match on the IP takes precedence over a match on the port.
+func (c Config) RigFor(ip net.IP, port int) (Rig, bool) {
+	for _, rig := range c.Rigs {
+		if addr := net.ParseIP(rig.Address); addr != nil && addr.Equal(ip) {
+			return rig, true
+		}
+	}
+	local := ":" + strconv.Itoa(port)
+	for _, rig := range c.Rigs {
+		if rig.Address == local {
+			return rig, true
+		}
+	}
+	return Rig{}, false
+}
+
+// ParsePorts parses a comma separated list of ports, ie: "20777,20778"
+func ParsePorts(s string) ([]int, error) {
+	ports := []int{}
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		port, err := strconv.Atoi(field)
+		if err != nil || port <= 0 || port > 65535 {
+			return nil, errors.Errorf("invalid port: %q", field)
+		}
+		ports = append(ports, port)
+	}
+	if len(ports) == 0 {
+		return nil, errors.New("no port given")
+	}
+	return ports, nil
+}
+
+// ParseRigs parses a comma separated list of rigs formatted as address=name[:driver],
+// ie: "192.168.1.21=rig-1:Tommy,:20778=rig-2"
+func ParseRigs(s string) ([]Rig, error) {
+	rigs := []Rig{}
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		parts := strings.SplitN(field, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Errorf("invalid rig, expected address=name[:driver]: %q", field)
+		}
+
+		rig := Rig{Address: parts[0]}
+		if net.ParseIP(rig.Address) == nil {
+			port, err := strconv.Atoi(strings.TrimPrefix(rig.Address, ":"))
+			if err != nil || !strings.HasPrefix(rig.Address, ":") || port <= 0 || port > 65535 {
+				return nil, errors.Errorf("invalid rig address, expected an ip or :port: %q", rig.Address)
+			}
+		}
+
+		names := strings.SplitN(parts[1], ":", 2)
+		rig.Name = names[0]
+		if len(names) == 2 {
+			rig.Driver = names[1]
+		}
+		rigs = append(rigs, rig)
+	}
+	return rigs, nil
+}
+
+// ParseRepositories parses a comma separated list of repositories, ie: "elastic,influx"
+func ParseRepositories(s string) ([]string, error) {
+	repositories := []string{}
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		switch field {
+		case "":
+			continue
+		case RepositoryElastic, RepositoryInflux, RepositoryPostgres, RepositorySQLite, RepositoryKafka, RepositoryMQTT, RepositoryNATS:
+			repositories = append(repositories, field)
+		default:
+			return nil, errors.Errorf("unknown repository: %q", field)
+		}
+	}
+	if len(repositories) == 0 {
+		return nil, errors.New("no repository given")
+	}
+	return repositories, nil
 }
@@ -0,0 +1,216 @@
+package export
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Quantities of the channels, their unit can be chosen when exporting
+const (
+	Time         = "time"
+	Distance     = "distance"
+	Speed        = "speed"
+	Temperature  = "temperature"
+	Pressure     = "pressure"
+	Ratio        = "ratio"
+	Acceleration = "acceleration"
+	Angle        = "angle"
+	Mass         = "mass"
+	Energy       = "energy"
+)
+
+// Units are the units a quantity can be exported in, the first one is the unit of the game
+var Units = map[string][]string{
+	Time:         {"s", "ms"},
+	Distance:     {"m", "km", "ft", "mi"},
+	Speed:        {"km/h", "mph", "m/s"},
+	Temperature:  {"C", "F", "K"},
+	Pressure:     {"psi", "bar", "kPa"},
+	Ratio:        {"ratio", "%"},
+	Acceleration: {"g", "m/s2"},
+	Angle:        {"rad", "deg"},
+	Mass:         {"kg", "lb"},
+	Energy:       {"J", "kJ", "MJ"},
+}
+
+// conversions from the unit of the game of a quantity to the other units
+var conversions = map[string]func(v float64) float64{
+	"ms":   func(v float64) float64 { return v * 1000 },
+	"km":   func(v float64) float64 { return v / 1000 },
+	"ft":   func(v float64) float64 { return v / 0.3048 },
+	"mi":   func(v float64) float64 { return v / 1609.344 },
+	"mph":  func(v float64) float64 { return v / 1.609344 },
+	"m/s":  func(v float64) float64 { return v / 3.6 },
+	"F":    func(v float64) float64 { return v*9/5 + 32 },
+	"K":    func(v float64) float64 { return v + 273.15 },
+	"bar":  func(v float64) float64 { return v * 0.0689475729 },
+	"kPa":  func(v float64) float64 { return v * 6.89475729 },
+	"%":    func(v float64) float64 { return v * 100 },
+	"m/s2": func(v float64) float64 { return v * 9.80665 },
+	"deg":  func(v float64) float64 { return v * 180 / math.Pi },
+	"lb":   func(v float64) float64 { return v / 0.45359237 },
+	"kJ":   func(v float64) float64 { return v / 1000 },
+	"MJ":   func(v float64) float64 { return v / 1000000 },
+}
+
+// Channel is a value of the samples exported to analysis tools
+type Channel struct {
+	ID       string // Identifier used to select the channel
+	Name     string // Default header of the channel
+	Quantity string // Quantity of the value, empty when it has no unit
+	Value    func(s *Sample) float64
+}
+
+// Unit returns the unit of the game of the channel, empty when it has none
+func (c Channel) Unit() string {
+	if c.Quantity == "" {
+		return ""
+	}
+	return Units[c.Quantity][0]
+}
+
+// Channels are the channels that can be exported
+var Channels = []Channel{
+	{ID: "time", Name: "Time", Quantity: Time, Value: func(s *Sample) float64 { return float64(s.Time) }},
+	{ID: "distance", Name: "Distance", Quantity: Distance, Value: func(s *Sample) float64 { return float64(s.Distance) }},
+	{ID: "lap", Name: "Lap Number", Value: func(s *Sample) float64 { return float64(s.LapNum) }},
+	{ID: "lap_time", Name: "Running Lap Time", Quantity: Time, Value: func(s *Sample) float64 { return float64(s.CurrentLapTime) }},
+	{ID: "lap_distance", Name: "Lap Distance", Quantity: Distance, Value: func(s *Sample) float64 { return float64(s.LapDistance) }},
+	{ID: "speed", Name: "Ground Speed", Quantity: Speed, Value: func(s *Sample) float64 { return float64(s.Telemetry.Speed) }},
+	{ID: "throttle", Name: "Throttle Pos", Quantity: Ratio, Value: func(s *Sample) float64 { return float64(s.Telemetry.Throttle) }},
+	{ID: "brake", Name: "Brake Pos", Quantity: Ratio, Value: func(s *Sample) float64 { return float64(s.Telemetry.Brake) }},
+	{ID: "clutch", Name: "Clutch Pos", Quantity: Ratio, Value: func(s *Sample) float64 { return float64(s.Telemetry.Clutch) / 100 }},
+	{ID: "steer", Name: "Steering Pos", Quantity: Ratio, Value: func(s *Sample) float64 { return float64(s.Telemetry.Steer) }},
+	{ID: "gear", Name: "Gear", Value: func(s *Sample) float64 { return float64(s.Telemetry.Gear) }},
+	{ID: "rpm", Name: "Engine RPM", Value: func(s *Sample) float64 { return float64(s.Telemetry.EngineRPM) }},
+	{ID: "drs", Name: "DRS", Value: func(s *Sample) float64 { return float64(s.Telemetry.Drs) }},
+	{ID: "engine_temp", Name: "Engine Temp", Quantity: Temperature, Value: func(s *Sample) float64 { return float64(s.Telemetry.EngineTemperature) }},
+	{ID: "position_x", Name: "Position X", Quantity: Distance, Value: func(s *Sample) float64 { return float64(s.Motion.WorldPositionX) }},
+	{ID: "position_y", Name: "Position Y", Quantity: Distance, Value: func(s *Sample) float64 { return float64(s.Motion.WorldPositionY) }},
+	{ID: "position_z", Name: "Position Z", Quantity: Distance, Value: func(s *Sample) float64 { return float64(s.Motion.WorldPositionZ) }},
+	{ID: "g_lat", Name: "G Force Lat", Quantity: Acceleration, Value: func(s *Sample) float64 { return float64(s.Motion.GForceLateral) }},
+	{ID: "g_long", Name: "G Force Long", Quantity: Acceleration, Value: func(s *Sample) float64 { return float64(s.Motion.GForceLongitudinal) }},
+	{ID: "g_vert", Name: "G Force Vert", Quantity: Acceleration, Value: func(s *Sample) float64 { return float64(s.Motion.GForceVertical) }},
+	{ID: "yaw", Name: "Yaw", Quantity: Angle, Value: func(s *Sample) float64 { return float64(s.Motion.Yaw) }},
+	{ID: "pitch", Name: "Pitch", Quantity: Angle, Value: func(s *Sample) float64 { return float64(s.Motion.Pitch) }},
+	{ID: "roll", Name: "Roll", Quantity: Angle, Value: func(s *Sample) float64 { return float64(s.Motion.Roll) }},
+	{ID: "fuel", Name: "Fuel Level", Quantity: Mass, Value: func(s *Sample) float64 { return float64(s.Status.FuelInTank) }},
+	{ID: "ers_store", Name: "ERS Store Energy", Quantity: Energy, Value: func(s *Sample) float64 { return float64(s.Status.ErsStoreEnergy) }},
+	{ID: "brake_bias", Name: "Brake Bias", Value: func(s *Sample) float64 { return float64(s.Status.FrontBrakeBias) }},
+}
+
+// wheel channels, in the order of the game
+func init() {
+	names := []string{"RL", "RR", "FL", "FR"}
+	for i, wheel := range wheels {
+		i, id, name := i, wheel, names[i]
+		Channels = append(Channels,
+			Channel{ID: "tyre_temp_surface_" + id, Name: "Tyre Temp Surface " + name, Quantity: Temperature, Value: func(s *Sample) float64 { return float64(s.Telemetry.TyresSurfaceTemperature[i]) }},
+			Channel{ID: "tyre_temp_inner_" + id, Name: "Tyre Temp Inner " + name, Quantity: Temperature, Value: func(s *Sample) float64 { return float64(s.Telemetry.TyresInnerTemperature[i]) }},
+			Channel{ID: "tyre_pressure_" + id, Name: "Tyre Pressure " + name, Quantity: Pressure, Value: func(s *Sample) float64 { return float64(s.Telemetry.TyresPressure[i]) }},
+			Channel{ID: "brake_temp_" + id, Name: "Brake Temp " + name, Quantity: Temperature, Value: func(s *Sample) float64 { return float64(s.Telemetry.BrakesTemperature[i]) }},
+			Channel{ID: "tyre_wear_" + id, Name: "Tyre Wear " + name, Value: func(s *Sample) float64 { return float64(s.Status.TyresWear[i]) }},
+		)
+	}
+}
+
+// DefaultChannels are the channels exported when none are chosen
+var DefaultChannels = []string{
+	"time", "distance", "lap", "speed", "throttle", "brake", "gear", "rpm", "steer",
+	"tyre_temp_surface_fl", "tyre_temp_surface_fr", "tyre_temp_surface_rl", "tyre_temp_surface_rr",
+	"tyre_temp_inner_fl", "tyre_temp_inner_fr", "tyre_temp_inner_rl", "tyre_temp_inner_rr",
+	"position_x", "position_y", "position_z",
+}
+
+// ChannelByID returns a channel by its identifier
+func ChannelByID(id string) (Channel, bool) {
+	for _, c := range Channels {
+		if c.ID == id {
+			return c, true
+		}
+	}
+	return Channel{}, false
+}
+
+// Column is a channel converted to the unit chosen for its quantity, under the header chosen
+type Column struct {
+	Channel
+	Header string
+	Unit   string // Unit the values are converted to, empty when the channel has none
+
+	convert func(v float64) float64
+}
+
+// Value returns the value of the channel in a sample, in the unit of the column
+func (c Column) Value(s *Sample) float64 {
+	v := c.Channel.Value(s)
+	if c.convert != nil {
+		v = c.convert(v)
+	}
+	return v
+}
+
+// Columns resolves the channels exported: ids, the DefaultChannels when empty,
+// units, the unit of every quantity, the unit of the game when not set,
+// and headers, the header of every channel, its name when not set.
+func Columns(ids []string, units map[string]string, headers map[string]string) ([]Column, error) {
+	if len(ids) == 0 {
+		ids = DefaultChannels
+	}
+	for quantity, unit := range units {
+		if _, ok := Units[quantity]; !ok {
+			return nil, errors.Errorf("unknown quantity %q, expected one of %s", quantity, strings.Join(quantities(), ", "))
+		}
+		if !validUnit(quantity, unit) {
+			return nil, errors.Errorf("unknown %s unit %q, expected one of %s", quantity, unit, strings.Join(Units[quantity], ", "))
+		}
+	}
+	for id := range headers {
+		if _, ok := ChannelByID(id); !ok {
+			return nil, errors.Errorf("unknown channel %q", id)
+		}
+	}
+
+	columns := make([]Column, 0, len(ids))
+	for _, id := range ids {
+		channel, ok := ChannelByID(id)
+		if !ok {
+			return nil, errors.Errorf("unknown channel %q", id)
+		}
+		column := Column{
+			Channel: channel,
+			Header:  channel.Name,
+			Unit:    channel.Unit(),
+		}
+		if header, ok := headers[id]; ok {
+			column.Header = header
+		}
+		if unit, ok := units[channel.Quantity]; ok && channel.Quantity != "" && unit != column.Unit {
+			column.Unit = unit
+			column.convert = conversions[unit]
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+func validUnit(quantity, unit string) bool {
+	for _, u := range Units[quantity] {
+		if u == unit {
+			return true
+		}
+	}
+	return false
+}
+
+func quantities() []string {
+	q := make([]string, 0, len(Units))
+	for quantity := range Units {
+		q = append(q, quantity)
+	}
+	sort.Strings(q)
+	return q
+}
@@ -0,0 +1,112 @@
+package export
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// wheels suffixes the columns of the arrays holding a value per wheel, in the order of the game
+var wheels = []string{"rl", "rr", "fl", "fr"}
+
+// column is a column of a Parquet file
+type column struct {
+	name     string
+	metadata string                            // Parquet schema of the column
+	value    func(v reflect.Value) interface{} // Value of the column in a packet or a car, nil when null
+}
+
+// flatten returns the columns of every number of a type, named after their field in snake case.
+// The arrays are suffixed by their index, or by the wheel when they hold 4 values, the names become strings.
+func flatten(t reflect.Type, name string, optional bool, get func(v reflect.Value) reflect.Value) []column {
+	switch t.Kind() {
+	case reflect.Struct:
+		columns := []column{}
+		for i := 0; i < t.NumField(); i++ {
+			i := i
+			f := t.Field(i)
+			columns = append(columns, flatten(f.Type, join(name, snake(f.Name)), optional, func(v reflect.Value) reflect.Value {
+				return get(v).Field(i)
+			})...)
+		}
+		return columns
+
+	case reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && strings.HasSuffix(name, "name") {
+			return []column{scalar(name, "type=BYTE_ARRAY, convertedtype=UTF8", optional, func(v reflect.Value) interface{} {
+				b := make([]byte, t.Len())
+				reflect.Copy(reflect.ValueOf(b), get(v))
+				return strings.TrimRight(string(b), "\x00")
+			})}
+		}
+		columns := []column{}
+		for i := 0; i < t.Len(); i++ {
+			i := i
+			suffix := fmt.Sprint(i)
+			if t.Len() == len(wheels) {
+				suffix = wheels[i]
+			}
+			columns = append(columns, flatten(t.Elem(), join(name, suffix), optional, func(v reflect.Value) reflect.Value {
+				return get(v).Index(i)
+			})...)
+		}
+		return columns
+	}
+
+	switch t.Kind() {
+	case reflect.Uint8:
+		return []column{scalar(name, "type=INT32, convertedtype=UINT_8", optional, func(v reflect.Value) interface{} { return int32(get(v).Uint()) })}
+	case reflect.Int8:
+		return []column{scalar(name, "type=INT32, convertedtype=INT_8", optional, func(v reflect.Value) interface{} { return int32(get(v).Int()) })}
+	case reflect.Uint16:
+		return []column{scalar(name, "type=INT32, convertedtype=UINT_16", optional, func(v reflect.Value) interface{} { return int32(get(v).Uint()) })}
+	case reflect.Int16:
+		return []column{scalar(name, "type=INT32, convertedtype=INT_16", optional, func(v reflect.Value) interface{} { return int32(get(v).Int()) })}
+	case reflect.Uint32:
+		return []column{scalar(name, "type=INT64", optional, func(v reflect.Value) interface{} { return int64(get(v).Uint()) })}
+	case reflect.Uint64:
+		return []column{scalar(name, "type=INT64, convertedtype=UINT_64", optional, func(v reflect.Value) interface{} { return int64(get(v).Uint()) })}
+	case reflect.Float32:
+		return []column{scalar(name, "type=FLOAT", optional, func(v reflect.Value) interface{} { return float32(get(v).Float()) })}
+	case reflect.Float64:
+		return []column{scalar(name, "type=DOUBLE", optional, func(v reflect.Value) interface{} { return get(v).Float() })}
+	}
+	panic("unsupported packet field type " + t.String())
+}
+
+func scalar(name string, schema string, optional bool, value func(v reflect.Value) interface{}) column {
+	repetition := "REQUIRED"
+	if optional {
+		repetition = "OPTIONAL"
+	}
+	return column{
+		name:     name,
+		metadata: fmt.Sprintf("name=%s, %s, repetitiontype=%s", name, schema, repetition),
+		value:    value,
+	}
+}
+
+func join(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "_" + name
+}
+
+// snake converts a field name to snake case, ie: ErsHarvestedThisLapMGUK is ers_harvested_this_lap_mguk
+func snake(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			previous := runes[i-1]
+			next := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && next) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,52 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// CSVOptions tells how a log is written as CSV
+type CSVOptions struct {
+	Columns  []Column // Columns written, see Columns
+	UnitsRow bool     // Whether the header line is followed by a line with the units
+	Comma    rune     // Field delimiter, ',' when not set
+}
+
+// WriteCSV writes the samples of a log as CSV, a line per sample after a header line
+func WriteCSV(w io.Writer, log *Log, options CSVOptions) error {
+	out := csv.NewWriter(w)
+	if options.Comma != 0 {
+		out.Comma = options.Comma
+	}
+
+	line := make([]string, len(options.Columns))
+	for i, c := range options.Columns {
+		line[i] = c.Header
+	}
+	if err := out.Write(line); err != nil {
+		return errors.Wrap(err, "could not write csv header")
+	}
+	if options.UnitsRow {
+		for i, c := range options.Columns {
+			line[i] = c.Unit
+		}
+		if err := out.Write(line); err != nil {
+			return errors.Wrap(err, "could not write csv units")
+		}
+	}
+
+	for i := range log.Samples {
+		for j, c := range options.Columns {
+			line[j] = strconv.FormatFloat(c.Value(&log.Samples[i]), 'f', -1, 32)
+		}
+		if err := out.Write(line); err != nil {
+			return errors.Wrap(err, "could not write csv line")
+		}
+	}
+
+	out.Flush()
+	return errors.Wrap(out.Error(), "could not write csv")
+}
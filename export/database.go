@@ -0,0 +1,158 @@
+package export
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/relational"
+)
+
+// Placeholder returns the query parameter placeholder of a database, ie: $1 for PostgreSQL, ? for SQLite
+type Placeholder func(n int) string
+
+// Placeholders of the databases of the repositories
+var (
+	PostgresPlaceholder Placeholder = func(n int) string { return "$" + strconv.Itoa(n) }
+	SQLitePlaceholder   Placeholder = func(n int) string { return "?" }
+)
+
+// Database exports a session stored by the postgres or sqlite repository to dir, a file per table,
+// partitioned by car for the tables with a car index. The telemetry exported is the one stored, decimated by sqlite.
+// It returns the paths of the files written and their number of rows.
+func Database(ctx context.Context, db *sql.DB, placeholder Placeholder, dir string, session string) (map[string]int, error) {
+	f := newFiles(dir)
+	for _, table := range relational.AllTables {
+		if err := exportTable(ctx, db, placeholder, f, table, session); err != nil {
+			f.close()
+			return nil, err
+		}
+	}
+	return f.close()
+}
+
+func exportTable(ctx context.Context, db *sql.DB, placeholder Placeholder, f *files, table *relational.Table, session string) error {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE session_uid = %s", strings.Join(table.Columns, ", "), table.Name, placeholder(1))
+	if contains(table.Columns, "session_time") {
+		query += " ORDER BY session_time"
+	}
+	rows, err := db.QueryContext(ctx, query, session)
+	if err != nil {
+		return errors.Wrapf(err, "could not query %s", table.Name)
+	}
+	defer rows.Close()
+
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		return errors.Wrapf(err, "could not read the columns of %s", table.Name)
+	}
+	metadata := make([]string, len(types))
+	kinds := make([]string, len(types))
+	car := -1
+	for i, t := range types {
+		kinds[i], metadata[i] = databaseColumn(t)
+		if t.Name() == "car_index" {
+			car = i
+		}
+	}
+
+	values := make([]interface{}, len(types))
+	pointers := make([]interface{}, len(types))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return errors.Wrapf(err, "could not read %s", table.Name)
+		}
+		row := make([]interface{}, len(values))
+		for i, value := range values {
+			if row[i], err = databaseValue(kinds[i], value); err != nil {
+				return errors.Wrapf(err, "could not convert column %s of %s", types[i].Name(), table.Name)
+			}
+		}
+
+		index := -1
+		if car >= 0 {
+			index = int(row[car].(int64))
+		}
+		if err := f.write(session, index, table.Name, metadata, row); err != nil {
+			return err
+		}
+	}
+	return errors.Wrapf(rows.Err(), "could not read %s", table.Name)
+}
+
+// databaseColumn returns the kind of value and the Parquet schema of a database column, every column is nullable
+func databaseColumn(t *sql.ColumnType) (string, string) {
+	schema := "type=BYTE_ARRAY, convertedtype=UTF8"
+	kind := "string"
+	switch name := strings.ToUpper(t.DatabaseTypeName()); {
+	case strings.Contains(name, "INT"):
+		kind, schema = "int", "type=INT64"
+	case strings.Contains(name, "REAL"), strings.Contains(name, "FLOAT"), strings.Contains(name, "DOUBLE"):
+		kind, schema = "float", "type=DOUBLE"
+	case strings.Contains(name, "BOOL"):
+		kind, schema = "bool", "type=BOOLEAN"
+	case strings.Contains(name, "TIME"):
+		kind, schema = "time", "type=INT64, convertedtype=TIMESTAMP_MICROS"
+	}
+	return kind, fmt.Sprintf("name=%s, %s, repetitiontype=OPTIONAL", t.Name(), schema)
+}
+
+// databaseValue converts a value read from the database to the type of its Parquet column
+func databaseValue(kind string, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if b, ok := value.([]byte); ok {
+		value = string(b)
+	}
+
+	switch kind {
+	case "int":
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case string:
+			return strconv.ParseInt(v, 10, 64)
+		}
+	case "float":
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case int64:
+			return float64(v), nil
+		case string:
+			return strconv.ParseFloat(v, 64)
+		}
+	case "bool":
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case int64:
+			return v != 0, nil
+		}
+	case "time":
+		if v, ok := value.(time.Time); ok {
+			return v.UnixNano() / int64(time.Microsecond), nil
+		}
+	case "string":
+		return fmt.Sprint(value), nil
+	}
+	return nil, errors.Errorf("unexpected %T value for a %s column", value, kind)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
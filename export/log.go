@@ -0,0 +1,273 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/capture"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+var (
+	// ErrNoSamples is returned when a capture holds no telemetry of the car and session exported
+	ErrNoSamples = errors.New("no telemetry of the car in the session")
+	// ErrLapNotFound is returned when the lap exported was not driven
+	ErrLapNotFound = errors.New("lap not found")
+)
+
+// Sample is the state of a car every time its telemetry is received
+type Sample struct {
+	Time     float32 // Time in seconds since the start of the log
+	Distance float32 // Distance in metres since the start of the log
+
+	SessionTime    float32 // Session timestamp
+	LapNum         uint8   // Lap the car is on
+	LapDistance    float32 // Distance around the lap in metres
+	TotalDistance  float32 // Distance travelled in the session in metres
+	CurrentLapTime float32 // Time around the lap in seconds
+
+	Telemetry f1packet.CarTelemetryData
+	Motion    f1packet.CarMotionData
+	Status    f1packet.CarStatusData
+}
+
+// LapMarker tells when a lap was started
+type LapMarker struct {
+	LapNum  uint8
+	Start   float32 // Session time the lap was started at
+	LapTime float32 // Lap time in seconds, 0 when the lap was not completed
+}
+
+// Log is the telemetry of a car during a session, or a lap of it
+type Log struct {
+	SessionUID  string
+	TrackID     int8
+	TrackLength uint16
+	SessionType uint8
+	Formula     uint8
+
+	LapNum   uint8 // Lap of the log, 0 for a whole session
+	CarIndex uint8
+	Driver   string    // Name of the participant
+	Team     string    // Team of the participant
+	Date     time.Time // Time the first sample was received
+
+	Samples []Sample    // Samples ordered by session time
+	Laps    []LapMarker // Laps ordered by number
+}
+
+// ReadLog reads the telemetry of a car from a capture, of the player car when car is negative.
+// Only the session given is read, the first session of the capture when empty,
+// as sent by the rig given by name or by address, every rig when empty.
+// The samples recorded after a flashback replace the ones they rewind.
+func ReadLog(r io.Reader, session string, rig string, car int) (*Log, error) {
+	reader, err := capture.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Log{SessionUID: session}
+	var (
+		lapData   f1packet.LapData
+		hasLap    bool
+		motion    f1packet.CarMotionData
+		status    f1packet.CarStatusData
+		player    = car < 0
+		startTime time.Time
+	)
+
+	for {
+		record, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !record.From(rig) {
+			continue
+		}
+		t, data := record.Time, record.Packet
+
+		header := f1packet.PacketHeader{}
+		if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, &header); err != nil {
+			return nil, errors.Wrap(err, "could not decode header")
+		}
+		if header.PacketFormat != 2020 {
+			continue
+		}
+		uid := strconv.FormatUint(header.SessionUID, 10)
+		if l.SessionUID == "" {
+			l.SessionUID = uid
+		}
+		if uid != l.SessionUID {
+			continue
+		}
+		if player {
+			car = int(header.PlayerCarIndex)
+		}
+		if car >= 22 {
+			return nil, errors.Errorf("invalid car index %d", car)
+		}
+		l.CarIndex = uint8(car)
+
+		packet, err := decodeLogPacket(header, data)
+		if err != nil {
+			return nil, err
+		}
+
+		switch p := packet.(type) {
+		case *f1packet.PacketSessionData:
+			l.TrackID = p.TrackID
+			l.TrackLength = p.TrackLength
+			l.SessionType = p.SessionType
+			l.Formula = p.Formula
+
+		case *f1packet.PacketParticipantsData:
+			l.Driver = models.ParticipantName(p.Participants[car].Name)
+			l.Team = models.TeamName(p.Participants[car].TeamID)
+
+		case *f1packet.PacketMotionData:
+			motion = p.CarMotionData[car]
+
+		case *f1packet.PacketCarStatusData:
+			status = p.CarStatusData[car]
+
+		case *f1packet.PacketLapData:
+			ld := p.LapData[car]
+			// 0 = invalid, 1 = inactive
+			if ld.ResultStatus < 2 || ld.CurrentLapNum == 0 {
+				continue
+			}
+			l.lap(header.SessionTime, lapData, hasLap, ld)
+			lapData = ld
+			hasLap = true
+
+		case *f1packet.PacketCarTelemetryData:
+			if !hasLap {
+				continue
+			}
+			// a flashback rewinds the session time, the samples rewound are dropped
+			n := len(l.Samples)
+			for n > 0 && l.Samples[n-1].SessionTime >= header.SessionTime {
+				n--
+			}
+			l.Samples = l.Samples[:n]
+			if n == 0 {
+				startTime = t
+			}
+			l.Samples = append(l.Samples, Sample{
+				SessionTime:    header.SessionTime,
+				LapNum:         lapData.CurrentLapNum,
+				LapDistance:    lapData.LapDistance,
+				TotalDistance:  lapData.TotalDistance,
+				CurrentLapTime: lapData.CurrentLapTime,
+				Telemetry:      p.CarTelemetryData[car],
+				Motion:         motion,
+				Status:         status,
+			})
+		}
+	}
+
+	if len(l.Samples) == 0 {
+		return nil, ErrNoSamples
+	}
+	l.Date = startTime
+	first := l.Samples[0]
+	for i := range l.Samples {
+		l.Samples[i].Time = l.Samples[i].SessionTime - first.SessionTime
+		l.Samples[i].Distance = l.Samples[i].TotalDistance - first.TotalDistance
+	}
+	return l, nil
+}
+
+// lap follows the laps of the car from its lap data, previous being the lap data received before ld
+func (l *Log) lap(sessionTime float32, previous f1packet.LapData, hasPrevious bool, ld f1packet.LapData) {
+	if hasPrevious && ld.CurrentLapNum == previous.CurrentLapNum {
+		// 3 = finished, the lap number does not change when crossing the line for the last time
+		if n := len(l.Laps); n > 0 && ld.ResultStatus == 3 && previous.ResultStatus != 3 {
+			l.Laps[n-1].LapTime = ld.LastLapTime
+		}
+		return
+	}
+
+	// the laps rewound by a flashback are dropped
+	n := len(l.Laps)
+	for n > 0 && l.Laps[n-1].LapNum >= ld.CurrentLapNum {
+		n--
+	}
+	l.Laps = l.Laps[:n]
+	if n > 0 && l.Laps[n-1].LapNum == ld.CurrentLapNum-1 {
+		l.Laps[n-1].LapTime = ld.LastLapTime
+	}
+	l.Laps = append(l.Laps, LapMarker{
+		LapNum: ld.CurrentLapNum,
+		Start:  sessionTime - ld.CurrentLapTime,
+	})
+}
+
+// Lap returns the log of a lap, its time and distance start from the line
+func (l *Log) Lap(lapNum uint8) (*Log, error) {
+	lap := *l
+	lap.LapNum = lapNum
+	lap.Samples = []Sample{}
+	lap.Laps = []LapMarker{}
+	for _, m := range l.Laps {
+		if m.LapNum == lapNum {
+			lap.Laps = append(lap.Laps, m)
+		}
+	}
+	if len(lap.Laps) == 0 {
+		return nil, ErrLapNotFound
+	}
+
+	start, lapTime := lap.Laps[0].Start, lap.Laps[0].LapTime
+	for _, s := range l.Samples {
+		if s.LapNum != lapNum {
+			continue
+		}
+		// the car keeps the number of its last lap once it finished
+		if lapTime > 0 && s.SessionTime >= start+lapTime {
+			break
+		}
+		s.Time = s.SessionTime - start
+		s.Distance = s.LapDistance
+		lap.Samples = append(lap.Samples, s)
+	}
+	if len(lap.Samples) == 0 {
+		return nil, ErrLapNotFound
+	}
+	lap.Date = l.Date.Add(time.Duration(float64(lap.Samples[0].SessionTime-l.Samples[0].SessionTime) * float64(time.Second)))
+	return &lap, nil
+}
+
+// decodeLogPacket decodes the packets a log is read from, it returns nil for the other packets
+func decodeLogPacket(header f1packet.PacketHeader, data []byte) (interface{}, error) {
+	var packet interface{}
+	switch f1packet.PacketType(header.PacketID) {
+	case f1packet.SessionPacket:
+		packet = &f1packet.PacketSessionData{}
+	case f1packet.ParticipantsPacket:
+		packet = &f1packet.PacketParticipantsData{}
+	case f1packet.MotionPacket:
+		packet = &f1packet.PacketMotionData{}
+	case f1packet.CarStatusPacket:
+		packet = &f1packet.PacketCarStatusData{}
+	case f1packet.LapDataPacket:
+		packet = &f1packet.PacketLapData{}
+	case f1packet.CarTelemetryPacket:
+		packet = &f1packet.PacketCarTelemetryData{}
+	default:
+		return nil, nil
+	}
+	if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, packet); err != nil {
+		return nil, errors.Wrapf(err, "could not decode packet %d", header.PacketID)
+	}
+	return packet, nil
+}
@@ -0,0 +1,192 @@
+package motec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Channel is a channel of a log file, sampled at a fixed frequency
+type Channel struct {
+	Name      string // Up to 31 characters
+	ShortName string // Up to 7 characters
+	Unit      string // Up to 11 characters
+	Frequency uint16 // Samples per second
+	Data      []float32
+}
+
+// File is a MoTeC i2 log file
+type File struct {
+	Date time.Time
+
+	Driver       string
+	Vehicle      string
+	Venue        string
+	Event        string
+	Session      string
+	Comment      string
+	ShortComment string
+
+	Channels []Channel
+}
+
+// The layout of the file: the header, the event, its venue and vehicle,
+// a linked list of channel descriptions and the data of every channel.
+
+type header struct {
+	Marker         uint32 // 0x40
+	_              [4]byte
+	ChannelMetaPtr uint32 // Offset of the first channel description
+	ChannelDataPtr uint32 // Offset of the first channel data
+	_              [20]byte
+	EventPtr       uint32
+	_              [24]byte
+	Unknown        [3]uint16 // 1, 0x4240, 0xf
+	DeviceSerial   uint32
+	DeviceType     [8]byte
+	DeviceVersion  uint16
+	Unknown2       uint16 // 0xadb0
+	NumChannels    uint32
+	_              [4]byte
+	Date           [16]byte // dd/mm/yyyy
+	_              [16]byte
+	Time           [16]byte // hh:mm:ss
+	_              [16]byte
+	Driver         [64]byte
+	Vehicle        [64]byte
+	_              [64]byte
+	Venue          [64]byte
+	_              [64]byte
+	_              [1024]byte
+	ProLogging     uint32 // 0xc81a4
+	_              [66]byte
+	ShortComment   [64]byte
+	_              [126]byte
+}
+
+type event struct {
+	Name     [64]byte
+	Session  [64]byte
+	Comment  [1024]byte
+	VenuePtr uint16
+}
+
+type venue struct {
+	Name       [64]byte
+	_          [1034]byte
+	VehiclePtr uint16
+}
+
+type vehicle struct {
+	ID      [64]byte
+	_       [128]byte
+	Weight  uint32
+	Type    [32]byte
+	Comment [32]byte
+}
+
+type channelMeta struct {
+	PreviousPtr   uint32 // Offset of the previous channel description, 0 for the first one
+	NextPtr       uint32 // Offset of the next channel description, 0 for the last one
+	DataPtr       uint32
+	DataLen       uint32 // Number of samples
+	Counter       uint16
+	DataTypeKind  uint16 // 0x07 for floats
+	DataTypeSize  uint16 // Size of a sample in bytes
+	Frequency     uint16
+	Shift         int16 // The value is (data / scale * 10^-decimals + shift) * mul
+	Mul           int16
+	Scale         int16
+	DecimalPlaces int16
+	Name          [32]byte
+	ShortName     [8]byte
+	Unit          [12]byte
+	_             [40]byte
+}
+
+// WriteTo writes the log file
+func (f *File) WriteTo(w io.Writer) (int64, error) {
+	if len(f.Channels) == 0 {
+		return 0, errors.New("a log file needs at least a channel")
+	}
+
+	eventPtr := binary.Size(header{})
+	venuePtr := eventPtr + binary.Size(event{})
+	vehiclePtr := venuePtr + binary.Size(venue{})
+	metaPtr := vehiclePtr + binary.Size(vehicle{})
+	metaSize := binary.Size(channelMeta{})
+	dataPtr := metaPtr + metaSize*len(f.Channels)
+
+	buf := &bytes.Buffer{}
+	h := header{
+		Marker:         0x40,
+		ChannelMetaPtr: uint32(metaPtr),
+		ChannelDataPtr: uint32(dataPtr),
+		EventPtr:       uint32(eventPtr),
+		Unknown:        [3]uint16{1, 0x4240, 0xf},
+		DeviceSerial:   0x1f44,
+		DeviceVersion:  420,
+		Unknown2:       0xadb0,
+		NumChannels:    uint32(len(f.Channels)),
+		ProLogging:     0xc81a4,
+	}
+	copy(h.DeviceType[:], "ADL")
+	copy(h.Date[:], f.Date.Format("02/01/2006"))
+	copy(h.Time[:], f.Date.Format("15:04:05"))
+	copy(h.Driver[:], f.Driver)
+	copy(h.Vehicle[:], f.Vehicle)
+	copy(h.Venue[:], f.Venue)
+	copy(h.ShortComment[:], f.ShortComment)
+
+	e := event{VenuePtr: uint16(venuePtr)}
+	copy(e.Name[:], f.Event)
+	copy(e.Session[:], f.Session)
+	copy(e.Comment[:], f.Comment)
+
+	v := venue{VehiclePtr: uint16(vehiclePtr)}
+	copy(v.Name[:], f.Venue)
+
+	car := vehicle{}
+	copy(car.ID[:], f.Vehicle)
+
+	blocks := []interface{}{h, e, v, car}
+	ptr := dataPtr
+	for i, c := range f.Channels {
+		meta := channelMeta{
+			DataPtr:      uint32(ptr),
+			DataLen:      uint32(len(c.Data)),
+			Counter:      uint16(0x2ee1 + i),
+			DataTypeKind: 0x07,
+			DataTypeSize: 4,
+			Frequency:    c.Frequency,
+			Mul:          1,
+			Scale:        1,
+		}
+		if i > 0 {
+			meta.PreviousPtr = uint32(metaPtr + metaSize*(i-1))
+		}
+		if i < len(f.Channels)-1 {
+			meta.NextPtr = uint32(metaPtr + metaSize*(i+1))
+		}
+		copy(meta.Name[:len(meta.Name)-1], c.Name)
+		copy(meta.ShortName[:len(meta.ShortName)-1], c.ShortName)
+		copy(meta.Unit[:len(meta.Unit)-1], c.Unit)
+		blocks = append(blocks, meta)
+		ptr += 4 * len(c.Data)
+	}
+	for _, c := range f.Channels {
+		blocks = append(blocks, c.Data)
+	}
+
+	for _, b := range blocks {
+		if err := binary.Write(buf, binary.LittleEndian, b); err != nil {
+			return 0, errors.Wrap(err, "could not encode log file")
+		}
+	}
+
+	n, err := w.Write(buf.Bytes())
+	return int64(n), errors.Wrap(err, "could not write log file")
+}
@@ -0,0 +1,96 @@
+package motec
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Lap is a lap completed during a log, it tells MoTeC i2 where the beacon was crossed
+type Lap struct {
+	LapNum  uint8
+	End     time.Duration // Time since the start of the log the lap was completed at
+	LapTime time.Duration
+}
+
+type ldxFile struct {
+	XMLName       xml.Name    `xml:"LDXFile"`
+	Locale        string      `xml:"Locale,attr"`
+	DefaultLocale string      `xml:"DefaultLocale,attr"`
+	Version       string      `xml:"Version,attr"`
+	Markers       markerGroup `xml:"Layers>Layer>MarkerBlock>MarkerGroup"`
+	Details       []detail    `xml:"Layers>Details>String"`
+}
+
+type markerGroup struct {
+	Name    string   `xml:"Name,attr"`
+	Index   int      `xml:"Index,attr"`
+	Markers []marker `xml:"Marker"`
+}
+
+type marker struct {
+	Version   int    `xml:"Version,attr"`
+	ClassName string `xml:"ClassName,attr"`
+	Name      string `xml:"Name,attr"`
+	Flags     int    `xml:"Flags,attr"`
+	Time      string `xml:"Time,attr"` // Microseconds since the start of the log
+}
+
+type detail struct {
+	ID    string `xml:"Id,attr"`
+	Value string `xml:"Value,attr"`
+}
+
+// WriteLDX writes the file describing the laps of a log file, it is read by MoTeC i2 along with the log
+// file of the same name.
+func WriteLDX(w io.Writer, laps []Lap) error {
+	f := ldxFile{
+		Locale:        "English_United States.1252",
+		DefaultLocale: "C",
+		Version:       "1.6",
+		Markers:       markerGroup{Name: "Beacons", Index: 3},
+		Details: []detail{
+			{ID: "Total Laps", Value: strconv.Itoa(len(laps))},
+		},
+	}
+
+	var fastest *Lap
+	for i, lap := range laps {
+		f.Markers.Markers = append(f.Markers.Markers, marker{
+			Version:   100,
+			ClassName: "BCN",
+			Name:      fmt.Sprintf("Manual.%d", i+1),
+			Flags:     77,
+			Time:      strconv.FormatInt(lap.End.Microseconds(), 10),
+		})
+		if lap.LapTime > 0 && (fastest == nil || lap.LapTime < fastest.LapTime) {
+			fastest = &laps[i]
+		}
+	}
+	if fastest != nil {
+		f.Details = append(f.Details,
+			detail{ID: "Fastest Time", Value: lapTime(fastest.LapTime)},
+			detail{ID: "Fastest Lap", Value: strconv.Itoa(int(fastest.LapNum))},
+		)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return errors.Wrap(err, "could not write ldx file")
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", " ")
+	if err := enc.Encode(f); err != nil {
+		return errors.Wrap(err, "could not write ldx file")
+	}
+	return nil
+}
+
+// lapTime formats a lap time as m:ss.mmm
+func lapTime(d time.Duration) string {
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%d:%02d.%03d", ms/60000, ms/1000%60, ms%1000)
+}
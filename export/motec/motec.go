@@ -0,0 +1,215 @@
+package motec
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/export"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+const (
+	// DefaultFrequency is the frequency the channels are sampled at, the frequency of the car telemetry set in the game
+	DefaultFrequency = 20
+	// SlowFrequency is the highest frequency of the channels changing slowly: temperatures, pressures, fuel, energy and wear
+	SlowFrequency = 10
+)
+
+// Units are the units of the quantities MoTeC i2 expects
+var Units = map[string]string{
+	export.Ratio: "%",
+	export.Angle: "deg",
+}
+
+// units renames the units of the game as MoTeC i2 names them
+var units = map[string]string{
+	"g": "G",
+}
+
+// DefaultChannels returns the channels written when none are chosen: every channel but the time,
+// MoTeC i2 computes it from the frequency of the channels
+func DefaultChannels() []string {
+	ids := []string{}
+	for _, c := range export.Channels {
+		if c.ID != "time" {
+			ids = append(ids, c.ID)
+		}
+	}
+	return ids
+}
+
+// New converts a log to a MoTeC i2 log file, its columns are sampled at frequency
+// and the laps completed returned to be written in the ldx file.
+func New(log *export.Log, columns []export.Column, frequency uint16) (*File, []Lap, error) {
+	if len(log.Samples) == 0 {
+		return nil, nil, export.ErrNoSamples
+	}
+	if frequency == 0 {
+		return nil, nil, errors.New("the frequency must be positive")
+	}
+
+	f := &File{
+		Date:    log.Date,
+		Driver:  log.Driver,
+		Vehicle: log.Team,
+		Venue:   models.TrackName(log.TrackID),
+		Event:   models.SessionTypeName(log.SessionType),
+		Session: log.SessionUID,
+		Comment: "F1 2020 car " + strconv.Itoa(int(log.CarIndex)),
+	}
+	if log.LapNum > 0 {
+		f.ShortComment = "Lap " + strconv.Itoa(int(log.LapNum))
+	}
+
+	for _, c := range columns {
+		if c.ID == "time" {
+			continue
+		}
+		freq := frequency
+		if slow(c.Channel) && freq > SlowFrequency {
+			freq = SlowFrequency
+		}
+		unit := c.Unit
+		if u, ok := units[unit]; ok {
+			unit = u
+		}
+		if c.ID == "rpm" {
+			unit = "rpm"
+		}
+		f.Channels = append(f.Channels, Channel{
+			Name:      c.Header,
+			ShortName: shortName(c.ID),
+			Unit:      unit,
+			Frequency: freq,
+			Data:      resample(log.Samples, c, freq),
+		})
+	}
+
+	return f, laps(log), nil
+}
+
+// Write writes a log as a MoTeC i2 log file to path, and the laps to the ldx file next to it
+func Write(path string, log *export.Log, columns []export.Column, frequency uint16) error {
+	f, laps, err := New(log, columns, frequency)
+	if err != nil {
+		return err
+	}
+
+	ld, err := os.Create(path)
+	if err != nil {
+		return errors.Wrapf(err, "could not create %s", path)
+	}
+	if _, err := f.WriteTo(ld); err != nil {
+		ld.Close()
+		return err
+	}
+	if err := ld.Close(); err != nil {
+		return errors.Wrapf(err, "could not write %s", path)
+	}
+
+	ldxPath := strings.TrimSuffix(path, filepath.Ext(path)) + ".ldx"
+	ldx, err := os.Create(ldxPath)
+	if err != nil {
+		return errors.Wrapf(err, "could not create %s", ldxPath)
+	}
+	if err := WriteLDX(ldx, laps); err != nil {
+		ldx.Close()
+		return err
+	}
+	return errors.Wrapf(ldx.Close(), "could not write %s", ldxPath)
+}
+
+// resample returns the values of a column every 1/frequency seconds from the start of the log.
+// The values are interpolated, but the ones without unit ( gear, lap, ... ) which keep their latest value.
+func resample(samples []export.Sample, c export.Column, frequency uint16) []float32 {
+	end := float64(samples[len(samples)-1].Time)
+	n := int(math.Floor(end*float64(frequency))) + 1
+	data := make([]float32, n)
+
+	j := 0
+	for i := range data {
+		t := float64(i) / float64(frequency)
+		for j+1 < len(samples) && float64(samples[j+1].Time) <= t {
+			j++
+		}
+		a := &samples[j]
+		v := c.Value(a)
+		if c.Quantity != "" && j+1 < len(samples) && float64(a.Time) < t {
+			b := &samples[j+1]
+			k := (t - float64(a.Time)) / float64(b.Time-a.Time)
+			v += (c.Value(b) - v) * k
+		}
+		data[i] = float32(v)
+	}
+	return data
+}
+
+// laps returns the laps completed during a log
+func laps(log *export.Log) []Lap {
+	first := log.Samples[0]
+	origin := first.SessionTime - first.Time
+	duration := log.Samples[len(log.Samples)-1].Time
+
+	laps := []Lap{}
+	for _, m := range log.Laps {
+		if m.LapTime <= 0 {
+			continue
+		}
+		// the line is crossed between the last sample and the next one
+		end := m.Start + m.LapTime - origin
+		if end <= 0 || end > duration+1 {
+			continue
+		}
+		laps = append(laps, Lap{
+			LapNum:  m.LapNum,
+			End:     seconds(end),
+			LapTime: seconds(m.LapTime),
+		})
+	}
+	return laps
+}
+
+func seconds(s float32) time.Duration {
+	return time.Duration(float64(s) * float64(time.Second))
+}
+
+// slow tells whether a channel changes slowly enough to be sampled at a lower frequency
+func slow(c export.Channel) bool {
+	switch c.Quantity {
+	case export.Temperature, export.Pressure, export.Mass, export.Energy:
+		return true
+	}
+	return strings.HasPrefix(c.ID, "tyre_wear_") || c.ID == "brake_bias"
+}
+
+// shortName abbreviates a channel identifier to the 7 characters of a short name, ie: tyre_temp_surface_fl is TTSFL
+func shortName(id string) string {
+	words := strings.Split(id, "_")
+	if len(words) == 1 {
+		words[0] = strings.ToUpper(id)
+		if len(id) > 7 {
+			return words[0][:7]
+		}
+		return words[0]
+	}
+	var b strings.Builder
+	for i, w := range words {
+		// the last word is the wheel or axis
+		if i == len(words)-1 && len(w) <= 2 {
+			b.WriteString(w)
+			continue
+		}
+		b.WriteByte(w[0])
+	}
+	s := strings.ToUpper(b.String())
+	if len(s) > 7 {
+		s = s[:7]
+	}
+	return s
+}
@@ -0,0 +1,215 @@
+package motec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/export"
+)
+
+// read decodes the block of the log file found at ptr
+func read(t *testing.T, data []byte, ptr uint32, block interface{}) {
+	t.Helper()
+
+	if int(ptr)+binary.Size(block) > len(data) {
+		t.Fatalf("block at %d of %d bytes is past the end of the file of %d bytes", ptr, binary.Size(block), len(data))
+	}
+	if err := binary.Read(bytes.NewReader(data[ptr:]), binary.LittleEndian, block); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// text returns the string of a fixed size field padded with null characters
+func text(b []byte) string {
+	return strings.TrimRight(string(b), "\x00")
+}
+
+func TestWriteTo(t *testing.T) {
+	f := &File{
+		Date:         time.Date(2020, 7, 19, 14, 10, 5, 0, time.UTC),
+		Driver:       "Tommy",
+		Vehicle:      "Mercedes",
+		Venue:        "Silverstone",
+		Event:        "R",
+		Session:      "42",
+		ShortComment: "Lap 3",
+		Channels: []Channel{
+			{Name: "Speed", ShortName: "SPEED", Unit: "km/h", Frequency: 20, Data: []float32{100, 120.5, 141}},
+			{Name: "Throttle", ShortName: "THROTTL", Unit: "%", Frequency: 10, Data: []float32{0.5, 1}},
+		},
+	}
+	buf := &bytes.Buffer{}
+	n, err := f.WriteTo(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	if int(n) != len(data) {
+		t.Errorf("wrote %d bytes, returned %d", len(data), n)
+	}
+
+	// the sizes of the blocks MoTeC i2 reads
+	sizes := []struct {
+		name  string
+		block interface{}
+		size  int
+	}{
+		{"header", header{}, 0x6e2},
+		{"event", event{}, 0x482},
+		{"venue", venue{}, 0x44c},
+		{"vehicle", vehicle{}, 0x104},
+		{"channel", channelMeta{}, 0x7c},
+	}
+	for _, s := range sizes {
+		if size := binary.Size(s.block); size != s.size {
+			t.Errorf("got %s of %d bytes, want %d", s.name, size, s.size)
+		}
+	}
+
+	h := header{}
+	read(t, data, 0, &h)
+	metaSize := uint32(binary.Size(channelMeta{}))
+	eventPtr := uint32(binary.Size(header{}))
+	metaPtr := eventPtr + uint32(binary.Size(event{})+binary.Size(venue{})+binary.Size(vehicle{}))
+	if h.Marker != 0x40 {
+		t.Errorf("got marker %#x, want 0x40", h.Marker)
+	}
+	if h.EventPtr != eventPtr {
+		t.Errorf("got event at %d, want %d", h.EventPtr, eventPtr)
+	}
+	if h.ChannelMetaPtr != metaPtr {
+		t.Errorf("got first channel at %d, want %d", h.ChannelMetaPtr, metaPtr)
+	}
+	if h.ChannelDataPtr != metaPtr+2*metaSize {
+		t.Errorf("got channel data at %d, want %d", h.ChannelDataPtr, metaPtr+2*metaSize)
+	}
+	if h.NumChannels != 2 {
+		t.Errorf("got %d channels, want 2", h.NumChannels)
+	}
+	if text(h.Date[:]) != "19/07/2020" || text(h.Time[:]) != "14:10:05" {
+		t.Errorf("got date %s %s", text(h.Date[:]), text(h.Time[:]))
+	}
+	if text(h.Driver[:]) != "Tommy" || text(h.Vehicle[:]) != "Mercedes" || text(h.Venue[:]) != "Silverstone" ||
+		text(h.ShortComment[:]) != "Lap 3" {
+		t.Errorf("got driver %s, vehicle %s, venue %s and comment %s",
+			text(h.Driver[:]), text(h.Vehicle[:]), text(h.Venue[:]), text(h.ShortComment[:]))
+	}
+
+	e := event{}
+	read(t, data, h.EventPtr, &e)
+	if text(e.Name[:]) != "R" || text(e.Session[:]) != "42" {
+		t.Errorf("got event %s, session %s", text(e.Name[:]), text(e.Session[:]))
+	}
+	v := venue{}
+	read(t, data, uint32(e.VenuePtr), &v)
+	if text(v.Name[:]) != "Silverstone" {
+		t.Errorf("got venue %s", text(v.Name[:]))
+	}
+	car := vehicle{}
+	read(t, data, uint32(v.VehiclePtr), &car)
+	if text(car.ID[:]) != "Mercedes" {
+		t.Errorf("got vehicle %s", text(car.ID[:]))
+	}
+
+	// the channels are a linked list, their data follows the descriptions in the same order
+	ptr, previous, dataPtr := h.ChannelMetaPtr, uint32(0), h.ChannelDataPtr
+	for i, c := range f.Channels {
+		if ptr == 0 {
+			t.Fatalf("the list ends after %d channels, want %d", i, len(f.Channels))
+		}
+		meta := channelMeta{}
+		read(t, data, ptr, &meta)
+		if meta.PreviousPtr != previous {
+			t.Errorf("%s: got previous channel at %d, want %d", c.Name, meta.PreviousPtr, previous)
+		}
+		if meta.DataPtr != dataPtr {
+			t.Errorf("%s: got data at %d, want %d", c.Name, meta.DataPtr, dataPtr)
+		}
+		if int(meta.DataLen) != len(c.Data) {
+			t.Errorf("%s: got %d samples, want %d", c.Name, meta.DataLen, len(c.Data))
+		}
+		if meta.DataTypeKind != 0x07 || meta.DataTypeSize != 4 || meta.Frequency != c.Frequency {
+			t.Errorf("%s: got data type %#x of %d bytes at %dHz", c.Name, meta.DataTypeKind, meta.DataTypeSize, meta.Frequency)
+		}
+		if text(meta.Name[:]) != c.Name || text(meta.ShortName[:]) != c.ShortName || text(meta.Unit[:]) != c.Unit {
+			t.Errorf("got channel %s ( %s ) in %s", text(meta.Name[:]), text(meta.ShortName[:]), text(meta.Unit[:]))
+		}
+
+		samples := make([]float32, meta.DataLen)
+		read(t, data, meta.DataPtr, samples)
+		for j := range samples {
+			if samples[j] != c.Data[j] {
+				t.Errorf("%s: got sample %d of %v, want %v", c.Name, j, samples[j], c.Data[j])
+			}
+		}
+
+		previous, ptr = ptr, meta.NextPtr
+		dataPtr += 4 * meta.DataLen
+	}
+	if ptr != 0 {
+		t.Errorf("the last channel is followed by a channel at %d", ptr)
+	}
+	if int(dataPtr) != len(data) {
+		t.Errorf("the data ends at %d, the file at %d", dataPtr, len(data))
+	}
+}
+
+func TestWriteToNoChannel(t *testing.T) {
+	if _, err := (&File{}).WriteTo(&bytes.Buffer{}); err == nil {
+		t.Error("got no error for a file without channels")
+	}
+}
+
+func TestWriteLDX(t *testing.T) {
+	// a log started at 100s of session time, 120s long
+	log := &export.Log{
+		Date: time.Date(2020, 7, 19, 14, 10, 0, 0, time.UTC),
+		Laps: []export.LapMarker{
+			{LapNum: 1, Start: 90, LapTime: 50},
+			{LapNum: 2, Start: 140, LapTime: 48.5},
+			{LapNum: 3, Start: 188.5},
+		},
+	}
+	for i := 0; i <= 120; i++ {
+		log.Samples = append(log.Samples, export.Sample{Time: float32(i), SessionTime: float32(100 + i)})
+	}
+
+	_, laps, err := New(log, nil, DefaultFrequency)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	if err := WriteLDX(buf, laps); err != nil {
+		t.Fatal(err)
+	}
+
+	ldx := ldxFile{}
+	if err := xml.Unmarshal(buf.Bytes(), &ldx); err != nil {
+		t.Fatal(err)
+	}
+	// the laps end at 140s and 188.5s of session time, the third one is not completed
+	times := []string{"40000000", "88500000"}
+	if len(ldx.Markers.Markers) != len(times) {
+		t.Fatalf("got %d beacons, want %d", len(ldx.Markers.Markers), len(times))
+	}
+	for i, m := range ldx.Markers.Markers {
+		if m.ClassName != "BCN" || m.Time != times[i] {
+			t.Errorf("beacon %d: got %s at %sµs, want BCN at %sµs", i, m.ClassName, m.Time, times[i])
+		}
+	}
+
+	details := map[string]string{}
+	for _, d := range ldx.Details {
+		details[d.ID] = d.Value
+	}
+	want := map[string]string{"Total Laps": "2", "Fastest Time": "0:48.500", "Fastest Lap": "2"}
+	for id, value := range want {
+		if details[id] != value {
+			t.Errorf("got %s %q, want %q", id, details[id], value)
+		}
+	}
+}
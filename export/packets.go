@@ -0,0 +1,231 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/capture"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+// layout tells how the rows of a packet type are exported
+type layout struct {
+	name   string // Name of the files
+	packet reflect.Type
+	// cars is the field holding a value per car, the packet is exported as a row per car in the car partitions.
+	// The packets without it are exported as a row per packet for the whole session.
+	cars string
+	// playerOnly tells the fields beside the cars only describe the player car, they are null for the other cars
+	playerOnly bool
+
+	columns    []column
+	carColumns int // Number of columns of the fields of a car, after the car index
+}
+
+// layouts of the packets exported, the event and lobby info packets are left out
+var layouts = map[f1packet.PacketType]*layout{
+	f1packet.MotionPacket:              {name: "motion", packet: reflect.TypeOf(f1packet.PacketMotionData{}), cars: "CarMotionData", playerOnly: true},
+	f1packet.SessionPacket:             {name: "session", packet: reflect.TypeOf(f1packet.PacketSessionData{})},
+	f1packet.LapDataPacket:             {name: "lap_data", packet: reflect.TypeOf(f1packet.PacketLapData{}), cars: "LapData"},
+	f1packet.ParticipantsPacket:        {name: "participants", packet: reflect.TypeOf(f1packet.PacketParticipantsData{}), cars: "Participants"},
+	f1packet.CarSetupsPacket:           {name: "car_setups", packet: reflect.TypeOf(f1packet.PacketCarSetupData{}), cars: "CarSetups"},
+	f1packet.CarTelemetryPacket:        {name: "car_telemetry", packet: reflect.TypeOf(f1packet.PacketCarTelemetryData{}), cars: "CarTelemetryData", playerOnly: true},
+	f1packet.CarStatusPacket:           {name: "car_status", packet: reflect.TypeOf(f1packet.PacketCarStatusData{}), cars: "CarStatusData"},
+	f1packet.FinalClassificationPacket: {name: "final_classification", packet: reflect.TypeOf(f1packet.PacketFinalClassificationData{}), cars: "ClassificationData"},
+}
+
+func init() {
+	for _, l := range layouts {
+		l.columns = l.buildColumns()
+	}
+}
+
+// buildColumns returns the columns of the rows: the time the packet was received, the header,
+// the index and fields of the car when exported per car, and the other fields of the packet
+func (l *layout) buildColumns() []column {
+	columns := []column{
+		scalar("time", "type=INT64, convertedtype=TIMESTAMP_MICROS", true, nil),
+		scalar("session_time", "type=FLOAT", false, func(v reflect.Value) interface{} {
+			return float32(v.FieldByName("Header").FieldByName("SessionTime").Float())
+		}),
+		scalar("frame_identifier", "type=INT64", false, func(v reflect.Value) interface{} {
+			return int64(v.FieldByName("Header").FieldByName("FrameIdentifier").Uint())
+		}),
+	}
+	if l.cars != "" {
+		columns = append(columns, scalar("car_index", "type=INT32, convertedtype=UINT_8", false, nil))
+		f, _ := l.packet.FieldByName(l.cars)
+		car := flatten(f.Type.Elem(), "", false, func(v reflect.Value) reflect.Value {
+			return v
+		})
+		l.carColumns = len(car)
+		columns = append(columns, car...)
+	}
+
+	for i := 0; i < l.packet.NumField(); i++ {
+		i := i
+		f := l.packet.Field(i)
+		if f.Name == "Header" || f.Name == l.cars {
+			continue
+		}
+		columns = append(columns, flatten(f.Type, snake(f.Name), l.playerOnly, func(v reflect.Value) reflect.Value {
+			return v.Field(i)
+		})...)
+	}
+	return columns
+}
+
+func (l *layout) metadata() []string {
+	metadata := make([]string, len(l.columns))
+	for i, c := range l.columns {
+		metadata[i] = c.metadata
+	}
+	return metadata
+}
+
+// Packets exports raw packets to Parquet files with their numeric types, partitioned by session and car
+type Packets struct {
+	files   *files
+	session string // Only session exported, every session when empty
+
+	active map[string]*[22]bool // Cars seen active in every session, the other cars are left out
+}
+
+// NewPackets creates an export to dir, of a single session when session is not empty
+func NewPackets(dir string, session string) *Packets {
+	return &Packets{
+		files:   newFiles(dir),
+		session: session,
+		active:  map[string]*[22]bool{},
+	}
+}
+
+// Packet adds a raw packet received at t to the export
+func (p *Packets) Packet(t time.Time, data []byte) error {
+	header := f1packet.PacketHeader{}
+	if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, &header); err != nil {
+		return errors.Wrap(err, "could not decode header")
+	}
+	// packets of other games are left out like the ingester does
+	if header.PacketFormat != 2020 {
+		return nil
+	}
+	session := strconv.FormatUint(header.SessionUID, 10)
+	if p.session != "" && session != p.session {
+		return nil
+	}
+	l, ok := layouts[f1packet.PacketType(header.PacketID)]
+	if !ok {
+		return nil
+	}
+
+	packet := reflect.New(l.packet)
+	if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, packet.Interface()); err != nil {
+		return errors.Wrapf(err, "could not decode %s packet", l.name)
+	}
+	v := packet.Elem()
+
+	active, ok := p.active[session]
+	if !ok {
+		active = &[22]bool{}
+		p.active[session] = active
+	}
+	switch pk := packet.Interface().(type) {
+	case *f1packet.PacketLapData:
+		for i := range pk.LapData {
+			// 0 = invalid, 1 = inactive
+			if pk.LapData[i].ResultStatus >= 2 {
+				active[i] = true
+			}
+		}
+	case *f1packet.PacketParticipantsData:
+		// participants are usually sent before the first lap data
+		for i := 0; i < int(pk.NumActiveCars) && i < len(active); i++ {
+			active[i] = true
+		}
+	}
+
+	if l.cars == "" {
+		return p.files.write(session, -1, l.name, l.metadata(), l.row(t, v, reflect.Value{}, -1, header))
+	}
+
+	cars := v.FieldByName(l.cars)
+	for i := 0; i < cars.Len(); i++ {
+		if !active[i] {
+			continue
+		}
+		if err := p.files.write(session, i, l.name, l.metadata(), l.row(t, v, cars.Index(i), i, header)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// row returns the values of a row of a packet, of a car when car is not negative
+func (l *layout) row(t time.Time, packet reflect.Value, data reflect.Value, car int, header f1packet.PacketHeader) []interface{} {
+	row := make([]interface{}, len(l.columns))
+	if !t.IsZero() {
+		row[0] = t.UnixNano() / int64(time.Microsecond)
+	}
+	row[1] = l.columns[1].value(packet)
+	row[2] = l.columns[2].value(packet)
+
+	n := 3
+	if car >= 0 {
+		row[n] = int32(car)
+		n++
+		for end := n + l.carColumns; n < end; n++ {
+			row[n] = l.columns[n].value(data)
+		}
+	}
+
+	player := car < 0 || uint8(car) == header.PlayerCarIndex || uint8(car) == header.SecondaryPlayerCarIndex
+	if l.playerOnly && !player {
+		return row
+	}
+	for ; n < len(l.columns); n++ {
+		row[n] = l.columns[n].value(packet)
+	}
+	return row
+}
+
+// Close completes the files, it returns their paths and number of rows
+func (p *Packets) Close() (map[string]int, error) {
+	return p.files.close()
+}
+
+// Capture exports the packets of a capture to dir, of a single session when session is not empty
+// and of a single rig, given by name or by address, when rig is not empty.
+// It returns the paths of the files written and their number of rows.
+func Capture(r io.Reader, dir string, session string, rig string) (map[string]int, error) {
+	reader, err := capture.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	p := NewPackets(dir, session)
+	for {
+		record, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			p.Close()
+			return nil, err
+		}
+		if !record.From(rig) {
+			continue
+		}
+		if err := p.Packet(record.Time, record.Packet); err != nil {
+			p.Close()
+			return nil, err
+		}
+	}
+	return p.Close()
+}
@@ -0,0 +1,102 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// rowGroupSize is the size of the row groups, a session opens a file per car and packet type
+const rowGroupSize = 8 * 1024 * 1024
+
+// file is a Parquet file being written
+type file struct {
+	path   string
+	pf     source.ParquetFile
+	writer *writer.CSVWriter
+	rows   int
+}
+
+// files writes the Parquet files of an export, partitioned by session and car:
+// <dir>/session=<uid>/car=<index>/<name>.parquet, <dir>/session=<uid>/<name>.parquet for the data of a whole session
+type files struct {
+	dir   string
+	files map[string]*file
+}
+
+func newFiles(dir string) *files {
+	return &files{
+		dir:   dir,
+		files: map[string]*file{},
+	}
+}
+
+// path returns the path of a file, car is negative for the data of a whole session
+func (f *files) path(session string, car int, name string) string {
+	if car < 0 {
+		return filepath.Join(f.dir, "session="+session, name+".parquet")
+	}
+	return filepath.Join(f.dir, "session="+session, "car="+strconv.Itoa(car), name+".parquet")
+}
+
+// write appends a row to a file, created with the given schema on the first row
+func (f *files) write(session string, car int, name string, metadata []string, row []interface{}) error {
+	path := f.path(session, car, name)
+	out, ok := f.files[path]
+	if !ok {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return errors.Wrapf(err, "could not create directory %s", filepath.Dir(path))
+		}
+		pf, err := local.NewLocalFileWriter(path)
+		if err != nil {
+			return errors.Wrapf(err, "could not create %s", path)
+		}
+		w, err := writer.NewCSVWriter(metadata, pf, 1)
+		if err != nil {
+			pf.Close()
+			return errors.Wrapf(err, "could not create parquet writer for %s", path)
+		}
+		w.RowGroupSize = rowGroupSize
+		out = &file{path: path, pf: pf, writer: w}
+		f.files[path] = out
+	}
+
+	if err := out.writer.Write(row); err != nil {
+		return errors.Wrapf(err, "could not write row to %s", path)
+	}
+	out.rows++
+	return nil
+}
+
+// close writes the footer of every file, it returns the paths of the files written and their number of rows
+func (f *files) close() (map[string]int, error) {
+	written := map[string]int{}
+	var first error
+	for path, out := range f.files {
+		if err := out.writer.WriteStop(); err != nil && first == nil {
+			first = errors.Wrapf(err, "could not complete %s", path)
+		}
+		if err := out.pf.Close(); err != nil && first == nil {
+			first = errors.Wrapf(err, "could not close %s", path)
+		}
+		written[path] = out.rows
+	}
+	f.files = map[string]*file{}
+	return written, first
+}
+
+// Paths returns the paths of a result, sorted
+func Paths(written map[string]int) []string {
+	paths := make([]string, 0, len(written))
+	for path := range written {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -3,8 +3,18 @@ module github.com/Tommy-42/f1-2020-go-telemetry
 go 1.16
 
 require (
+	github.com/eclipse/paho.mqtt.golang v1.3.5
 	github.com/elastic/go-elasticsearch/v8 v8.0.0-20210414074309-f7ffd04b8d6a
+	github.com/lib/pq v1.10.9
 	github.com/mailgun/holster/v3 v3.16.0
+	github.com/nats-io/nats-server/v2 v2.6.6
+	github.com/nats-io/nats.go v1.13.1-0.20211122170419-d7c1d78a50fc
 	github.com/pkg/errors v0.9.1
+	github.com/segmentio/kafka-go v0.4.28
 	github.com/sirupsen/logrus v1.8.1
+	github.com/xitongsys/parquet-go v1.6.2
+	github.com/xitongsys/parquet-go-source v0.0.0-20211228015320-b4f792c43cd0
+	google.golang.org/grpc v1.46.2
+	google.golang.org/protobuf v1.28.0
+	modernc.org/sqlite v1.14.8
 )
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// BrakingEvent details a braking of a car, from the moment the brake is applied to its release.
+type BrakingEvent struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // braking_event
+
+	CarIndex    uint8 // Index of the car in the session
+	LapNum      uint8 // Lap number
+	EventNumber int   // Braking event number in the lap, starting at 1
+
+	StartDistance    float32 // Distance the brake was applied at, in metres from the start line
+	EndDistance      float32 // Distance the brake was released at, in metres from the start line
+	StartSessionTime float32 // Session time the brake was applied at
+	Duration         float32 // Time the brake was applied for in seconds
+
+	StartSpeed       float32 // Speed when the brake was applied in kilometres per hour
+	EndSpeed         float32 // Speed when the brake was released in kilometres per hour
+	PeakPressure     float32 // Highest amount of brake applied (0.0 to 1.0)
+	Deceleration     float32 // Average deceleration in G
+	PeakDeceleration float32 // Highest longitudinal G-Force
+
+	// Highest brakes temperature during the braking (celsius)
+	RearLeftBrakesTemperature   uint16
+	RearRightBrakesTemperature  uint16
+	FrontLeftBrakesTemperature  uint16
+	FrontRightBrakesTemperature uint16
+
+	// Lock-ups are only detected for the player cars
+	LockUp         bool
+	LockedWheels   []string // Wheels whose slip went past the threshold: RL, RR, FL, FR
+	LockUpDuration float32  // Time at least one wheel was locked in seconds
+}
+
+func (p *BrakingEvent) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// BrakingLap sums up the braking events of a car during a lap.
+type BrakingLap struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // braking_lap
+
+	CarIndex uint8   // Index of the car in the session
+	LapNum   uint8   // Lap number
+	LapTime  float32 // Lap time in seconds
+	Valid    bool    // Whether the lap was not invalidated
+
+	Events              int     // Number of braking events
+	LockUps             int     // Number of braking events with a lock-up
+	TotalBrakingTime    float32 // Time spent braking in seconds
+	AveragePeakPressure float32 // Average of the highest amount of brake applied per event
+	AverageDeceleration float32 // Average deceleration in G
+
+	// Average distance in metres between the braking points of the lap and the ones of the previous lap.
+	// It is a measure of the consistency of the braking, 0 when there is no previous lap to compare to.
+	BrakingPointVariation float32
+}
+
+func (p *BrakingLap) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
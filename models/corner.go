@@ -0,0 +1,77 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// Corner is a corner of a track, distances are from the start line in metres
+type Corner struct {
+	Number    int     // Corner number, starting at 1 from the start line
+	Direction string  // left or right
+	Entry     float32 // Distance the corner starts at
+	Apex      float32 // Distance of the minimum speed
+	Exit      float32 // Distance the corner ends at
+}
+
+// Corners lists the corners detected on a track.
+type Corners struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // corners
+
+	TrackID int8
+	Corners []Corner
+}
+
+func (p *Corners) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// CornerReport details how a car went through a corner during a lap
+type CornerReport struct {
+	Number    int
+	Direction string
+
+	Braking      bool    // Whether the car braked for the corner
+	BrakingPoint float32 // Distance the car started braking at, in metres from the start line
+
+	EntrySpeed           float32 // Speed at the corner entry in kilometres per hour
+	MinimumSpeed         float32 // Minimum speed in the corner in kilometres per hour
+	MinimumSpeedDistance float32 // Distance of the minimum speed
+	Gear                 int8    // Gear at the minimum speed
+	ExitSpeed            float32 // Speed at the corner exit in kilometres per hour
+
+	Time                  float32 // Time spent from the corner entry to its exit in seconds
+	TimeLost              float32 // Time lost compared to the best time of the car in the corner this session
+	TimeLostToSessionBest float32 // Time lost compared to the best time of any car in the corner this session
+}
+
+// CornerLap details how a car went through every corner of a lap.
+type CornerLap struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // corner_lap
+
+	TrackID  int8
+	CarIndex uint8   // Index of the car in the session
+	LapNum   uint8   // Lap number
+	LapTime  float32 // Lap time in seconds
+	Valid    bool    // Whether the lap was not invalidated
+	PitLap   bool    // Whether the car went through the pit lane during the lap
+
+	Corners []CornerReport
+}
+
+func (p *CornerLap) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
@@ -0,0 +1,19 @@
+package models
+
+// FlagName returns a readable name for the FIA flag shown to a car
+func FlagName(flag int8) string {
+	switch flag {
+	case 0:
+		return "none"
+	case 1:
+		return "green"
+	case 2:
+		return "blue"
+	case 3:
+		return "yellow"
+	case 4:
+		return "red"
+	default:
+		return "unknown"
+	}
+}
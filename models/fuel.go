@@ -0,0 +1,92 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// FuelMixName returns a readable name for a fuel mix
+// Fuel mix - 0 = lean, 1 = standard, 2 = rich, 3 = max
+func FuelMixName(mix uint8) string {
+	switch mix {
+	case 0:
+		return "lean"
+	case 1:
+		return "standard"
+	case 2:
+		return "rich"
+	case 3:
+		return "max"
+	default:
+		return "unknown"
+	}
+}
+
+// FuelMixUsage details the fuel used with a fuel mix
+type FuelMixUsage struct {
+	FuelMix     uint8
+	FuelMixName string
+	Laps        float32 // Fraction of laps driven with the mix
+	FuelUsed    float32 // Fuel used with the mix
+	FuelPerLap  float32 // Fuel used per lap with the mix, 0 if it was not used long enough to tell
+}
+
+// FuelLap details the fuel used by a car during a lap and projects the fuel needed to finish the race
+type FuelLap struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // fuel_lap
+
+	CarIndex uint8 // Index of the car in the session
+	LapNum   uint8 // Lap number
+	PitLap   bool  // Whether the car went through the pit lane during the lap
+
+	FuelUsed          float32        // Fuel used during the lap
+	FuelMixes         []FuelMixUsage // Fuel used by fuel mix during the lap
+	FuelInTank        float32        // Fuel in tank at the end of the lap
+	FuelCapacity      float32        // Fuel capacity
+	FuelRemainingLaps float32        // Fuel remaining in terms of laps (value on MFD)
+
+	AverageFuelPerLap   float32 // Average fuel used per lap during the session, pit laps excluded
+	RemainingLaps       uint8   // Laps left to complete the race
+	ProjectedFuelNeeded float32 // Fuel needed to finish the race at the average consumption
+	ProjectedFuelDelta  float32 // Fuel left at the finish at the average consumption, negative if short
+}
+
+func (p *FuelLap) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// FuelStrategy sums up the fuel used by a car during a session
+// and recommends a starting fuel load for the next race at the same track.
+type FuelStrategy struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // fuel_strategy
+
+	CarIndex    uint8 // Index of the car in the session
+	TrackID     int8  // -1 for unknown, 0-21 for tracks, see appendix
+	Formula     uint8 // 0 = F1 Modern, 1 = F1 Classic, 2 = F2, 3 = F1 Generic
+	SessionType uint8 // See SessionData
+
+	FuelMixes []FuelMixUsage // Fuel used by fuel mix during the session, pit laps excluded
+
+	// Recommendation based on every session recorded at the track
+	SessionsRecorded    int     // Number of sessions the recommendation is based on
+	FuelPerLap          float32 // Fuel used per lap with the standard mix
+	RaceLaps            uint8   // Number of laps of the race
+	RecommendedFuelLoad float32 // Fuel to start the race with, safety margin included
+}
+
+func (p *FuelStrategy) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
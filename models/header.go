@@ -20,6 +20,8 @@ type Header struct {
 	// ADDED IN BETA 2:
 	SecondaryPlayerCarIndex uint8 // Index of secondary player's car in the array (splitscreen)
 	// 255 if no second player
+
+	Source Source // Rig the packet was received from
 }
 
 func NewHeader(p packet.PacketHeader) Header {
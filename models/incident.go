@@ -0,0 +1,63 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// IncidentSample is the state of a car around an incident
+type IncidentSample struct {
+	SessionTime float32 // Session timestamp
+	LapDistance float32 // Distance around the lap in metres
+	Speed       float32 // Speed of car in kilometres per hour
+	Throttle    float32 // Amount of throttle applied (0.0 to 1.0)
+	Brake       float32 // Amount of brake applied (0.0 to 1.0)
+	Steer       float32 // Steering (-1.0 (full lock left) to 1.0 (full lock right))
+	Gear        int8    // Gear selected (1-8, N=0, R=-1)
+
+	Yaw                float32  // Yaw angle in radians
+	SlipAngle          float32  // Angle between the heading and the direction of travel in degrees
+	GForceLateral      float32  // Lateral G-Force component
+	GForceLongitudinal float32  // Longitudinal G-Force component
+	WorldPositionX     float32  // World space X position
+	WorldPositionZ     float32  // World space Z position
+	Surfaces           [4]uint8 // Driving surface of each wheel: RL, RR, FL, FR
+}
+
+// Incident is a spin, an excursion off track or a likely contact of a car.
+type Incident struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // incident
+
+	Kind          string // spin, off_track or contact
+	CarIndex      uint8  // Index of the car in the session
+	OtherCarIndex int    // Index of the closest car for a contact, -1 if none
+
+	LapNum      uint8   // Lap number
+	LapDistance float32 // Lap distance the incident started at in metres
+	SessionTime float32 // Session time the incident started at
+	Duration    float32 // Duration of the incident in seconds
+	Speed       float32 // Speed when the incident started in kilometres per hour
+
+	MaxYawRate   float32  // Highest yaw rate during the incident in radians per second
+	MaxSlipAngle float32  // Highest angle between the heading and the direction of travel in degrees
+	Surfaces     []string // Surfaces the car went on during an excursion off track
+
+	// Wing damage (percentage) after a contact, and the highest increase of the three
+	FrontLeftWingDamage  uint8
+	FrontRightWingDamage uint8
+	RearWingDamage       uint8
+	DamageIncrease       uint8
+
+	Snippet []IncidentSample // Telemetry from a few seconds before the incident to its end
+}
+
+func (p *Incident) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// Lap is a lap completed by a car
+type Lap struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // lap
+
+	CarIndex    uint8   // Index of the car in the session
+	LapNum      uint8   // Lap number
+	LapTime     float32 // Lap time in seconds
+	Sector1Time float32 // Sector times in seconds, 0 when not known
+	Sector2Time float32
+	Sector3Time float32
+	Valid       bool  // Whether the lap was not invalidated
+	PitLap      bool  // Whether the car went through the pit lane during the lap
+	Position    uint8 // Position of the car at the end of the lap
+
+	ActualTyreCompound     uint8 // Tyres used during the lap, 0 when the car status is not known
+	ActualTyreCompoundName string
+	VisualTyreCompound     uint8
+	VisualTyreCompoundName string
+	TyresAgeLaps           uint8 // Age in laps of the tyres at the end of the lap
+}
+
+func (p *Lap) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
@@ -66,3 +66,11 @@ func (p *ParticipantsData) ToJson() (*bytes.Reader, error) {
 	}
 	return bytes.NewReader(data), nil
 }
+
+// ParticipantName returns the name of a participant without the trailing null bytes
+func ParticipantName(name [48]byte) string {
+	if i := bytes.IndexByte(name[:], 0); i >= 0 {
+		return string(name[:i])
+	}
+	return string(name[:])
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// PersonalBest is a lap improving the personal best lap or sector times of a driver
+// on a track, for a formula and a session type, across sessions.
+type PersonalBest struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // personal_best
+
+	Driver          string
+	CarIndex        uint8 // Index of the car in the session
+	LapNum          uint8 // Lap number
+	TrackID         int8
+	TrackName       string
+	Formula         uint8 // 0 = F1 Modern, 1 = F1 Classic, 2 = F2, 3 = F1 Generic
+	SessionType     uint8
+	SessionTypeName string
+
+	LapTime         float32   // Lap time in seconds
+	Sectors         []float32 // Sector times in seconds, 0 when not known
+	LapImproved     bool      // Whether the lap is a new personal best
+	SectorsImproved []bool    // Whether each sector is a new personal best
+	PreviousLapTime float32   // Personal best lap time before the lap in seconds, 0 if none
+	Improvement     float32   // Time in seconds gained on the previous personal best, 0 if none
+
+	PersonalBestLapTime float32   // Personal best lap time after the lap in seconds
+	BestSectors         []float32 // Personal best time in every sector after the lap in seconds
+	TheoreticalBest     float32   // Sum of the personal best sectors in seconds, 0 when a sector is not known
+	LeaderboardPosition int       // Position of the driver in the leaderboard of the track after the lap
+}
+
+func (p *PersonalBest) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
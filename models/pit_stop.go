@@ -0,0 +1,49 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// PitStopTyres details the tyres fitted on a car
+type PitStopTyres struct {
+	ActualTyreCompound     uint8
+	ActualTyreCompoundName string
+	VisualTyreCompound     uint8
+	VisualTyreCompoundName string
+	TyresAgeLaps           uint8 // Age in laps of the set of tyres
+}
+
+// PitStop details a car going through the pit lane, from the pit lane entry to the pit lane exit.
+type PitStop struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // pit_stop
+
+	CarIndex uint8 // Index of the car in the session
+	LapNum   uint8 // Lap the car entered the pit lane on
+
+	PitLaneEntryTime        float32 // Session time the car entered the pit lane at
+	PitLaneExitTime         float32 // Session time the car left the pit lane at
+	PitLaneTime             float32 // Time spent in the pit lane in seconds
+	StationaryTime          float32 // Time spent stationary in the pit lane in seconds
+	PitLaneEntryLapDistance float32 // Lap distance the car entered the pit lane at in metres
+	PitLaneExitLapDistance  float32 // Lap distance the car left the pit lane at in metres
+
+	TyresBefore  PitStopTyres // Tyres fitted when the car entered the pit lane
+	TyresAfter   PitStopTyres // Tyres fitted when the car left the pit lane
+	TyresChanged bool
+
+	PositionBefore  uint8 // Race position when the car entered the pit lane
+	PositionAfter   uint8 // Race position when the car left the pit lane
+	PositionsGained int   // Positions gained during the stop, negative when lost
+}
+
+func (p *PitStop) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// PositionChange is a car taking the position of another car during a race.
+type PositionChange struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // position_change
+
+	// overtake: the car passed the other car on track
+	// pit_cycle: one of the cars was in the pit lane
+	// penalty: the other car got a time penalty
+	// retirement: the other car retired, was disqualified or not classified
+	// other: the cars swapped positions without passing each other on track
+	Kind string
+
+	CarIndex         uint8 // Index of the car gaining the position
+	OtherCarIndex    uint8 // Index of the car losing the position
+	Position         uint8 // Race position of the car after the change
+	PreviousPosition uint8 // Race position of the car before the change
+	OtherPosition    uint8 // Race position of the other car after the change
+
+	SessionTime    float32 // Session time the change happened at
+	LapNum         uint8   // Lap number of the car gaining the position
+	LapDistance    float32 // Lap distance of the car gaining the position in metres
+	Corner         int     // Number of the corner the car was going through or exiting, 0 if none or unknown
+	WorldPositionX float32 // World space X position of the car gaining the position
+	WorldPositionZ float32 // World space Z position of the car gaining the position
+
+	Drs            bool  // Whether the DRS of the car was open
+	OtherDrs       bool  // Whether the DRS of the other car was open
+	InPit          bool  // Whether the car was in the pit lane
+	OtherInPit     bool  // Whether the other car was in the pit lane
+	OtherPenalties uint8 // Accumulated time penalties of the other car in seconds
+}
+
+func (p *PositionChange) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// WeatherSample is the weather of a session from a point in time
+type WeatherSample struct {
+	SessionTime      float32 // Session time the weather changed at
+	Weather          uint8   // 0 = clear, 1 = light cloud, 2 = overcast, 3 = light rain, 4 = heavy rain, 5 = storm
+	WeatherName      string
+	TrackTemperature int8 // Track temp. in degrees celsius
+	AirTemperature   int8 // Air temp. in degrees celsius
+}
+
+// DriverResult is the result of a car at the end of a session
+type DriverResult struct {
+	CarIndex     uint8 // Index of the car in the session
+	Name         string
+	Team         string
+	RaceNumber   uint8
+	AiControlled bool
+
+	Position     uint8 // Finishing position
+	GridPosition uint8 // Grid position of the car, 0 if unknown
+	Laps         uint8 // Number of laps completed
+	Status       string
+	Points       uint8
+
+	TotalRaceTime float32 // Total race time in seconds without penalties, 0 if unknown
+	BestLapTime   float32 // Best lap time of the session in seconds
+	BestLapNum    uint8   // Lap number the best lap was set on, 0 if unknown
+
+	PitStops    int     // Number of pit stops
+	PitStopLaps []uint8 // Laps the car entered the pit lane on
+
+	Penalties     uint8 // Number of penalties
+	PenaltiesTime uint8 // Time penalties in seconds
+
+	TyreStints       []string // Visual compound of every tyre stint
+	TyreStintsActual []string // Actual compound of every tyre stint
+}
+
+// SessionSummary sums up a session once it is over.
+type SessionSummary struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // session_summary
+
+	TrackID         int8
+	TrackName       string
+	SessionType     uint8
+	SessionTypeName string
+	Formula         uint8 // 0 = F1 Modern, 1 = F1 Classic, 2 = F2, 3 = F1 Generic
+	TotalLaps       uint8 // Total number of laps of the race
+	Final           bool  // Whether the results come from the final classification
+
+	Weather []WeatherSample // Weather evolution during the session
+	Drivers []DriverResult  // Ordered by position
+}
+
+func (p *SessionSummary) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
@@ -0,0 +1,55 @@
+package models
+
+// SessionTypeName returns a readable name for a session type
+func SessionTypeName(sessionType uint8) string {
+	switch sessionType {
+	case 1:
+		return "P1"
+	case 2:
+		return "P2"
+	case 3:
+		return "P3"
+	case 4:
+		return "Short P"
+	case 5:
+		return "Q1"
+	case 6:
+		return "Q2"
+	case 7:
+		return "Q3"
+	case 8:
+		return "Short Q"
+	case 9:
+		return "OSQ"
+	case 10:
+		return "R"
+	case 11:
+		return "R2"
+	case 12:
+		return "Time Trial"
+	default:
+		return "unknown"
+	}
+}
+
+// ResultStatusName returns a readable name for the result status of a car
+func ResultStatusName(status uint8) string {
+	switch status {
+	case 0:
+		return "invalid"
+	case 1:
+		return "inactive"
+	case 2:
+		return "active"
+	case 3:
+		return "finished"
+	case 4:
+		return "disqualified"
+	case 5:
+		return "not classified"
+	case 6:
+		return "retired"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,19 @@
+package models
+
+// Source identifies where a packet was received from.
+// When several rigs send to the same ingester, it is used to tell their documents apart.
+type Source struct {
+	Address string // Address of the sender, ip:port
+	Port    int    // Local UDP port the packet was received on
+	Rig     string // Rig name, empty if the address is not configured
+	Driver  string // Driver name, empty if the address is not configured
+}
+
+// Key returns the identifier used to keep the state of a source.
+// Configured rigs are keyed by name so their state survives a change of sender port.
+func (s Source) Key() string {
+	if s.Rig != "" {
+		return s.Rig
+	}
+	return s.Address
+}
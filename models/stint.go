@@ -0,0 +1,55 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// StintLap details a lap completed during a stint
+type StintLap struct {
+	LapNum  uint8   // Lap number
+	LapTime float32 // Lap time in seconds
+	PitLap  bool    // Whether the car went through the pit lane during the lap
+
+	// Tyre wear percentage at the end of the lap
+	RearLeftTyresWear   uint8
+	RearRightTyresWear  uint8
+	FrontLeftTyresWear  uint8
+	FrontRightTyresWear uint8
+	AverageTyresWear    float32
+}
+
+// Stint details a set of tyres used by a car, from the lap it was fitted to the lap it was removed.
+type Stint struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // stint
+
+	CarIndex    uint8 // Index of the car in the session
+	StintNumber int   // Stint number of the car, starting at 1
+	StartLap    uint8 // Lap the tyres were fitted on
+	EndLap      uint8 // Last lap completed on the tyres
+	NumLaps     int   // Number of laps completed on the tyres
+
+	ActualTyreCompound     uint8
+	ActualTyreCompoundName string
+	VisualTyreCompound     uint8
+	VisualTyreCompoundName string
+	TyresAgeAtStart        uint8 // Age in laps of the tyres when they were fitted
+
+	Laps []StintLap // Wear progression and lap time of every lap of the stint
+
+	DegradationRate float32 // Average tyre wear gained per lap (percentage)
+	LapTimeTrend    float32 // Lap time gained per lap in seconds, pit laps excluded
+	BestLapTime     float32 // Best lap time of the stint in seconds
+	AverageLapTime  float32 // Average lap time of the stint in seconds, pit laps excluded
+}
+
+func (p *Stint) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
@@ -0,0 +1,33 @@
+package models
+
+// SurfaceTypeName returns a readable name for a driving surface
+func SurfaceTypeName(surface uint8) string {
+	switch surface {
+	case 0:
+		return "tarmac"
+	case 1:
+		return "rumble strip"
+	case 2:
+		return "concrete"
+	case 3:
+		return "rock"
+	case 4:
+		return "gravel"
+	case 5:
+		return "mud"
+	case 6:
+		return "sand"
+	case 7:
+		return "grass"
+	case 8:
+		return "water"
+	case 9:
+		return "cobblestone"
+	case 10:
+		return "metal"
+	case 11:
+		return "ridged"
+	default:
+		return "unknown"
+	}
+}
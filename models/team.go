@@ -0,0 +1,31 @@
+package models
+
+import "strconv"
+
+// TeamName returns a readable name for the teams of the current season
+func TeamName(teamID uint8) string {
+	switch teamID {
+	case 0:
+		return "Mercedes"
+	case 1:
+		return "Ferrari"
+	case 2:
+		return "Red Bull Racing"
+	case 3:
+		return "Williams"
+	case 4:
+		return "Racing Point"
+	case 5:
+		return "Renault"
+	case 6:
+		return "Alpha Tauri"
+	case 7:
+		return "Haas"
+	case 8:
+		return "McLaren"
+	case 9:
+		return "Alfa Romeo"
+	default:
+		return "team " + strconv.Itoa(int(teamID))
+	}
+}
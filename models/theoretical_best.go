@@ -0,0 +1,66 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// SectorBest is the best time of a car in a sector
+type SectorBest struct {
+	Time   float32 // Sector time in seconds
+	LapNum uint8   // Lap number the time was set on
+}
+
+// MiniSector is a part of the lap of equal length, timed from the lap distance
+type MiniSector struct {
+	Number int     // Mini-sector number, starting at 1
+	From   float32 // Start of the mini-sector in metres from the start line
+	To     float32 // End of the mini-sector in metres from the start line
+
+	BestTime   float32 // Best time in seconds
+	BestLapNum uint8   // Lap number the best time was set on
+	LapTime    float32 // Time in seconds during the reference lap, see DriverBest
+	TimeToBest float32 // Time in seconds lost compared to the best time during the reference lap
+}
+
+// DriverBest compares the best lap of a car with its theoretical best lap, the sum of its best sectors.
+type DriverBest struct {
+	CarIndex uint8 // Index of the car in the session
+	Laps     int   // Number of valid laps
+
+	BestLapTime    float32   // Best valid lap time in seconds
+	BestLapNum     uint8     // Lap number the best lap was set on
+	BestLapSectors []float32 // Sector times of the best lap in seconds
+
+	BestSectors         []SectorBest // Best time in every sector
+	TheoreticalBestTime float32      // Sum of the best sectors in seconds
+	Gap                 float32      // Time in seconds between the best lap and the theoretical best
+
+	// Mini-sectors are timed on the complete laps only, the reference lap is the best complete lap.
+	// Empty when no complete lap was recorded.
+	MiniSectorsReferenceLapNum     uint8
+	MiniSectorsTheoreticalBestTime float32 // Reference lap time minus the time lost in every mini-sector
+	MiniSectorsGap                 float32 // Time in seconds lost in the mini-sectors during the reference lap
+	MiniSectors                    []MiniSector
+}
+
+// TheoreticalBest is the theoretical best lap report of the cars of a session.
+type TheoreticalBest struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // theoretical_best
+
+	TrackID     int8  // -1 for unknown, 0-21 for tracks, see appendix
+	SessionType uint8 // 0 = unknown, 1 = P1, 2 = P2, 3 = P3, 4 = Short P, 5 = Q1 ...
+
+	Drivers []DriverBest // Ordered by best lap time
+}
+
+func (p *TheoreticalBest) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// TimingEntry is the line of a car in the timing tower
+type TimingEntry struct {
+	Position      uint8   // Race position
+	CarIndex      uint8   // Index of the car in the session
+	LapNum        uint8   // Current lap number
+	LapDistance   float32 // Distance around the current lap in metres
+	TotalDistance float32 // Total distance travelled in the session in metres
+
+	GapToLeader float32 // Time in seconds behind the leader, 0 for the leader
+	LapsDown    int     // Number of laps behind the leader
+	Interval    float32 // Time in seconds behind the car ahead, 0 for the leader
+	// Number of laps behind the car ahead
+	IntervalLapsDown int
+
+	InPit        bool    // Whether the car is in the pit lane
+	LastLapTime  float32 // Last lap time in seconds
+	BestLapTime  float32 // Best lap time of the session in seconds
+	ResultStatus uint8   // 2 = active, 3 = finished, 4 = disqualified, 5 = not classified, 6 = retired
+}
+
+// TimingTower orders the cars of a session with their gap to the leader and to the car ahead.
+// During a race the gaps are the time between the cars on track, during other sessions they are computed on the best lap times.
+type TimingTower struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // timing_tower
+
+	TrackID     int8  // -1 for unknown, 0-21 for tracks, see appendix
+	SessionType uint8 // 0 = unknown, 1 = P1, 2 = P2, 3 = P3, 4 = Short P, 5 = Q1 ...
+	Race        bool  // Whether the gaps are the time between the cars on track
+
+	Cars []TimingEntry // Ordered by position
+}
+
+func (p *TimingTower) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
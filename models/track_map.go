@@ -0,0 +1,73 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// TrackMapPoint is a point of the centreline of a track
+type TrackMapPoint struct {
+	Distance float32 // Distance from the start line in metres
+	X        float32 // World space X position
+	Y        float32 // World space Y position
+	Z        float32 // World space Z position
+}
+
+// TrackMap is the centreline of a track, indexed by the distance from the start line.
+type TrackMap struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // track_map
+
+	TrackID     int8    // -1 for unknown, 0-21 for tracks, see appendix
+	TrackLength uint16  // Track length in metres
+	Step        float32 // Distance between two points in metres
+	Points      []TrackMapPoint
+}
+
+func (p *TrackMap) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// CarOnTrack places a car on the map of the track
+type CarOnTrack struct {
+	CarIndex     uint8   // Index of the car in the session
+	RacePosition uint8   // Car race position
+	LapNum       uint8   // Current lap number
+	LapDistance  float32 // Distance around the current lap in metres
+	LapFraction  float32 // Fraction (0..1) of the lap completed
+	PitStatus    uint8   // 0 = none, 1 = pitting, 2 = in pit area
+
+	WorldPositionX float32 // World space X position
+	WorldPositionY float32 // World space Y position
+	WorldPositionZ float32 // World space Z position
+
+	// Position on the map, as a fraction (0..1) of its width and height, see trackmap.Map.SVG
+	// Only set once a map of the track is available.
+	OnMap bool
+	MapX  float32
+	MapY  float32
+}
+
+// CarPositions places every car of the session on the map of the track.
+type CarPositions struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // car_positions
+
+	TrackID int8
+	Cars    []CarOnTrack
+}
+
+func (p *CarPositions) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// WheelspinEvent details a loss of traction of the rear wheels under throttle.
+type WheelspinEvent struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // wheelspin_event
+
+	CarIndex    uint8 // Index of the car in the session
+	LapNum      uint8 // Lap number
+	EventNumber int   // Wheelspin event number in the lap, starting at 1
+
+	StartDistance    float32 // Distance the wheels started spinning at, in metres from the start line
+	EndDistance      float32 // Distance the wheels stopped spinning at, in metres from the start line
+	StartSessionTime float32 // Session time the wheels started spinning at
+	Duration         float32 // Time the wheels spun for in seconds
+
+	PeakSlip        float32 // Highest rear wheel slip ratio
+	AverageSlip     float32 // Average rear wheel slip ratio
+	AverageThrottle float32 // Average amount of throttle applied (0.0 to 1.0)
+	Speed           float32 // Speed when the wheels started spinning in kilometres per hour
+	Gear            int8    // Gear selected when the wheels started spinning
+	Corner          int     // Number of the corner the car was going through or exiting, 0 if none
+	TractionControl uint8   // 0 (off) - 2 (high)
+}
+
+func (p *WheelspinEvent) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// TractionLap sums up the wheelspin events of a car during a lap.
+type TractionLap struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // traction_lap
+
+	CarIndex        uint8   // Index of the car in the session
+	LapNum          uint8   // Lap number
+	LapTime         float32 // Lap time in seconds
+	Valid           bool    // Whether the lap was not invalidated
+	TractionControl uint8   // Traction control setting used for most of the lap, 0 (off) - 2 (high)
+
+	Events          int     // Number of wheelspin events
+	TotalSpinTime   float32 // Time spent spinning the rear wheels in seconds
+	AveragePeakSlip float32 // Average of the highest rear wheel slip ratio per event
+}
+
+func (p *TractionLap) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// TractionSetting sums up the laps of a car driven with a traction control setting.
+type TractionSetting struct {
+	Header    Header
+	Timestamp time.Time
+	Type      string // traction_setting
+
+	CarIndex        uint8 // Index of the car in the session
+	TractionControl uint8 // 0 (off) - 2 (high)
+
+	Laps            int     // Number of laps driven with the setting
+	BestLapTime     float32 // Best valid lap time in seconds, 0 if none
+	AverageLapTime  float32 // Average valid lap time in seconds, 0 if none
+	EventsPerLap    float32 // Average number of wheelspin events per lap
+	SpinTimePerLap  float32 // Average time spent spinning the rear wheels per lap in seconds
+	AveragePeakSlip float32 // Average of the highest rear wheel slip ratio per event
+}
+
+func (p *TractionSetting) ToJson() (*bytes.Reader, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
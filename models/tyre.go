@@ -0,0 +1,64 @@
+package models
+
+// TyreCompoundName returns a readable name for an actual or visual tyre compound
+func TyreCompoundName(compound uint8) string {
+	switch compound {
+	// F1 Modern actual
+	case 16:
+		return "C5"
+	case 17:
+		return "C4"
+	case 18:
+		return "C3"
+	case 19:
+		return "C2"
+	case 20:
+		return "C1"
+	case 7:
+		return "inter"
+	case 8:
+		return "wet"
+	// F1 Classic
+	case 9:
+		return "dry"
+	case 10:
+		return "wet"
+	// F2
+	case 11:
+		return "super soft"
+	case 12:
+		return "soft"
+	case 13:
+		return "medium"
+	case 14:
+		return "hard"
+	case 15:
+		return "wet"
+	default:
+		return "unknown"
+	}
+}
+
+// VisualTyreCompoundName returns a readable name for a visual tyre compound
+// F1 visual - 16 = soft, 17 = medium, 18 = hard, 7 = inter, 8 = wet
+// F2 visual - 19 = super soft, 20 = soft, 21 = medium, 22 = hard, 15 = wet
+func VisualTyreCompoundName(compound uint8) string {
+	switch compound {
+	case 16:
+		return "soft"
+	case 17:
+		return "medium"
+	case 18:
+		return "hard"
+	case 19:
+		return "super soft"
+	case 20:
+		return "soft"
+	case 21:
+		return "medium"
+	case 22:
+		return "hard"
+	default:
+		return TyreCompoundName(compound)
+	}
+}
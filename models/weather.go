@@ -0,0 +1,21 @@
+package models
+
+// WeatherName returns a readable name for the weather
+func WeatherName(weather uint8) string {
+	switch weather {
+	case 0:
+		return "clear"
+	case 1:
+		return "light cloud"
+	case 2:
+		return "overcast"
+	case 3:
+		return "light rain"
+	case 4:
+		return "heavy rain"
+	case 5:
+		return "storm"
+	default:
+		return "unknown"
+	}
+}
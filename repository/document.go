@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+// PacketNames names the raw packets, which have no Type, by packet id
+var PacketNames = map[f1packet.PacketType]string{
+	f1packet.MotionPacket:              "motion",
+	f1packet.SessionPacket:             "session",
+	f1packet.LapDataPacket:             "lap_data",
+	f1packet.EventPacket:               "event",
+	f1packet.ParticipantsPacket:        "participants",
+	f1packet.CarSetupsPacket:           "car_setups",
+	f1packet.CarTelemetryPacket:        "car_telemetry",
+	f1packet.CarStatusPacket:           "car_status",
+	f1packet.FinalClassificationPacket: "final_classification",
+	f1packet.LobbyInfoPacket:           "lobby_info",
+}
+
+// Document is what the repositories routing the documents need to know of them
+type Document struct {
+	Name       string // Type of the document, or the name of its packet for the raw packets
+	SessionUID string
+	CarIndex   int // Car the document is about, -1 for the documents about the whole session
+	Rig        string
+	Driver     string
+}
+
+// Describe reads the name, session and car of a json document.
+// The raw packets only hold the player car, they are about it but the session and event packets.
+func Describe(body []byte) (Document, error) {
+	doc := struct {
+		Type     string
+		CarIndex *int
+		Header   struct {
+			PacketID       uint8
+			SessionUID     string
+			PlayerCarIndex uint8
+			Source         struct {
+				Rig    string
+				Driver string
+			}
+		}
+	}{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return Document{}, errors.Wrap(err, "could not decode document")
+	}
+
+	d := Document{
+		Name:       doc.Type,
+		SessionUID: doc.Header.SessionUID,
+		CarIndex:   -1,
+		Rig:        doc.Header.Source.Rig,
+		Driver:     doc.Header.Source.Driver,
+	}
+	if d.Name == "" {
+		d.Name = PacketNames[f1packet.PacketType(doc.Header.PacketID)]
+		if d.Name == "" {
+			return Document{}, errors.Errorf("unknown document for packet id %d", doc.Header.PacketID)
+		}
+		if t := f1packet.PacketType(doc.Header.PacketID); t != f1packet.SessionPacket && t != f1packet.EventPacket {
+			d.CarIndex = int(doc.Header.PlayerCarIndex)
+		}
+	}
+	if doc.CarIndex != nil {
+		d.CarIndex = *doc.CarIndex
+	}
+	return d, nil
+}
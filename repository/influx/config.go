@@ -0,0 +1,30 @@
+package influx
+
+import "time"
+
+type Config struct {
+	Address string // Address of the InfluxDB server, ie: http://localhost:8086
+
+	// InfluxDB 2.x, the points are written to a bucket of an organization with a token
+	Token  string
+	Org    string
+	Bucket string
+
+	// InfluxDB 1.x, the points are written to a database when it is set
+	Database string
+
+	BatchSize     int           // Number of points buffered before being written. Default: 5000.
+	FlushInterval time.Duration // Maximum time a point is buffered before being written. Default: 1s.
+	Timeout       time.Duration // Timeout of a write request. Default: 10s.
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Address:       "http://localhost:8086",
+		Org:           "f1",
+		Bucket:        "f1",
+		BatchSize:     5000,
+		FlushInterval: time.Second,
+		Timeout:       10 * time.Second,
+	}
+}
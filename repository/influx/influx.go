@@ -0,0 +1,233 @@
+package influx
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository"
+)
+
+// maxSessions is the number of sessions the start time and track are kept for
+const maxSessions = 100
+
+// session is what is known of a session to tag and timestamp its points
+type session struct {
+	start   time.Time // Wall clock time of the start of the session
+	trackID string    // Track of the session, from its session packets
+}
+
+// Influx writes the documents as InfluxDB points, in batches over HTTP.
+// The points of a session are timestamped from the session time of their packet,
+// the session is anchored to the wall clock on its first document.
+// Its track, given by the session packets, tags the points of the documents without a TrackID.
+type Influx struct {
+	client   *http.Client
+	writeURL string
+	token    string
+	conf     Config
+
+	mu     sync.Mutex
+	buffer bytes.Buffer
+	points int
+
+	sessions map[string]*session
+	order    []string // Sessions by first document, the oldest first
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewInflux(conf Config) (*Influx, error) {
+	defaults := DefaultConfig()
+	if conf.BatchSize <= 0 {
+		conf.BatchSize = defaults.BatchSize
+	}
+	if conf.FlushInterval <= 0 {
+		conf.FlushInterval = defaults.FlushInterval
+	}
+	if conf.Timeout <= 0 {
+		conf.Timeout = defaults.Timeout
+	}
+
+	address := strings.TrimSuffix(conf.Address, "/")
+	query := url.Values{"precision": []string{"ns"}}
+	writeURL := address + "/api/v2/write?"
+	if conf.Database != "" {
+		query.Set("db", conf.Database)
+		writeURL = address + "/write?"
+	} else {
+		query.Set("org", conf.Org)
+		query.Set("bucket", conf.Bucket)
+	}
+
+	i := &Influx{
+		client:   &http.Client{Timeout: conf.Timeout},
+		writeURL: writeURL + query.Encode(),
+		token:    conf.Token,
+		conf:     conf,
+		sessions: map[string]*session{},
+		done:     make(chan struct{}),
+	}
+
+	res, err := i.client.Get(address + "/ping")
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not reach influxdb: %s", conf.Address)
+	}
+	res.Body.Close()
+	if res.StatusCode >= 300 {
+		return nil, errors.New(fmt.Sprintf("could not ping influxdb %s: [%d]%s", conf.Address, res.StatusCode, res.Status))
+	}
+
+	i.wg.Add(1)
+	go i.run()
+
+	return i, nil
+}
+
+// Store converts the document to a point and buffers it, the batch is written once full
+func (i *Influx) Store(ctx context.Context, body *bytes.Reader) error {
+	if i == nil {
+		return errors.New("influx is not initialized")
+	}
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return errors.Wrap(err, "could not read document")
+	}
+	p, err := decode(data)
+	if err != nil {
+		return errors.Wrap(err, "could not convert document to influxdb line protocol")
+	}
+	// a point needs at least one field
+	if len(p.fields) == 0 {
+		return nil
+	}
+
+	i.mu.Lock()
+	p.line(&i.buffer, i.session(p))
+	i.points++
+	if i.points < i.conf.BatchSize {
+		i.mu.Unlock()
+		return nil
+	}
+	batch := i.take()
+	i.mu.Unlock()
+
+	return i.write(ctx, batch)
+}
+
+// Close writes the points still buffered and stops the periodic writes
+func (i *Influx) Close() error {
+	close(i.done)
+	i.wg.Wait()
+
+	i.mu.Lock()
+	batch := i.take()
+	i.mu.Unlock()
+	return i.write(context.Background(), batch)
+}
+
+// run writes the buffered points every FlushInterval until closed
+func (i *Influx) run() {
+	defer i.wg.Done()
+
+	ticker := time.NewTicker(i.conf.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-i.done:
+			return
+		case <-ticker.C:
+			i.mu.Lock()
+			batch := i.take()
+			i.mu.Unlock()
+			if err := i.write(context.Background(), batch); err != nil {
+				logrus.WithError(err).Error("could not write points to influxdb")
+			}
+		}
+	}
+}
+
+// take returns the buffered points and empties the buffer, i.mu has to be held
+func (i *Influx) take() []byte {
+	if i.points == 0 {
+		return nil
+	}
+	batch := make([]byte, i.buffer.Len())
+	copy(batch, i.buffer.Bytes())
+	i.buffer.Reset()
+	i.points = 0
+	return batch
+}
+
+// session tags the point with the track of its session and returns its time
+// from the session time of its packet, i.mu has to be held
+func (i *Influx) session(p *point) time.Time {
+	created := p.timestamp
+	if created.IsZero() {
+		created = time.Now().UTC()
+	}
+	if p.header.SessionUID == "" {
+		return created
+	}
+
+	sessionTime := time.Duration(p.header.SessionTime * float64(time.Second))
+	s, ok := i.sessions[p.header.SessionUID]
+	if !ok {
+		s = &session{start: created.Add(-sessionTime)}
+		i.sessions[p.header.SessionUID] = s
+		i.order = append(i.order, p.header.SessionUID)
+		if len(i.order) > maxSessions {
+			delete(i.sessions, i.order[0])
+			i.order = i.order[1:]
+		}
+	}
+
+	if trackID, ok := p.tags["track_id"]; ok {
+		if p.measurement == repository.PacketNames[f1packet.SessionPacket] {
+			s.trackID = trackID
+		}
+	} else {
+		p.tags["track_id"] = s.trackID
+	}
+	return s.start.Add(sessionTime)
+}
+
+// write sends a batch of points to influxdb
+func (i *Influx) write(ctx context.Context, batch []byte) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, i.writeURL, bytes.NewReader(batch))
+	if err != nil {
+		return errors.Wrap(err, "could not create influxdb write request")
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if i.token != "" {
+		req.Header.Set("Authorization", "Token "+i.token)
+	}
+
+	res, err := i.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not request influxdb")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(res.Body)
+		return errors.Wrap(errors.New(fmt.Sprintf("[%d] %s", res.StatusCode, msg)), "could not write points to influxdb")
+	}
+	return nil
+}
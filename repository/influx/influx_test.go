@@ -0,0 +1,357 @@
+package influx
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+// server is an influxdb answering the pings and recording the bodies of the writes
+type server struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	writes []string
+	urls   []string
+	auth   []string
+	ch     chan struct{}
+}
+
+func newServer(t *testing.T) *server {
+	s := &server{ch: make(chan struct{}, 100)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/write", "/write":
+			body, _ := ioutil.ReadAll(req.Body)
+			s.mu.Lock()
+			s.writes = append(s.writes, string(body))
+			s.urls = append(s.urls, req.URL.String())
+			s.auth = append(s.auth, req.Header.Get("Authorization"))
+			s.mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+			s.ch <- struct{}{}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// lines returns the lines of every write received
+func (s *server) lines() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lines := []string{}
+	for _, w := range s.writes {
+		lines = append(lines, strings.Split(strings.TrimSuffix(w, "\n"), "\n")...)
+	}
+	return lines
+}
+
+// count returns the number of writes received
+func (s *server) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.writes)
+}
+
+// line is a line of the line protocol split in its parts
+type line struct {
+	measurement string
+	tags        map[string]string
+	fields      map[string]string
+	timestamp   int64
+}
+
+// split splits s on the separators that are not escaped or quoted
+func split(s string, sep byte) []string {
+	parts := []string{}
+	quoted := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\':
+			i++
+		case s[i] == '"':
+			quoted = !quoted
+		case s[i] == sep && !quoted:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+var unescaper = strings.NewReplacer(`\,`, ",", `\=`, "=", `\ `, " ")
+
+func parse(t *testing.T, s string) line {
+	t.Helper()
+
+	parts := split(s, ' ')
+	if len(parts) != 3 {
+		t.Fatalf("line %q has %d parts, want 3", s, len(parts))
+	}
+	l := line{tags: map[string]string{}, fields: map[string]string{}}
+
+	tags := split(parts[0], ',')
+	l.measurement = unescaper.Replace(tags[0])
+	for _, tag := range tags[1:] {
+		kv := split(tag, '=')
+		l.tags[unescaper.Replace(kv[0])] = unescaper.Replace(kv[1])
+	}
+	for _, field := range split(parts[1], ',') {
+		kv := split(field, '=')
+		l.fields[unescaper.Replace(kv[0])] = kv[1]
+	}
+
+	var err error
+	if l.timestamp, err = strconv.ParseInt(parts[2], 10, 64); err != nil {
+		t.Fatalf("invalid timestamp in line %q: %v", s, err)
+	}
+	return l
+}
+
+type jsonDocument interface {
+	ToJson() (*bytes.Reader, error)
+}
+
+func store(t *testing.T, i *Influx, docs ...jsonDocument) {
+	t.Helper()
+
+	for _, doc := range docs {
+		body, err := doc.ToJson()
+		if err != nil {
+			t.Fatalf("could not encode document: %v", err)
+		}
+		if err := i.Store(context.Background(), body); err != nil {
+			t.Fatalf("could not store document: %v", err)
+		}
+	}
+}
+
+func testHeader(packetID uint8, sessionTime float32) models.Header {
+	return models.Header{
+		PacketID:       packetID,
+		SessionUID:     "42",
+		SessionTime:    sessionTime,
+		PlayerCarIndex: 1,
+		Source:         models.Source{Address: "10.0.0.1:5000", Rig: "rig 1", Driver: "Tommy"},
+	}
+}
+
+func TestLines(t *testing.T) {
+	s := newServer(t)
+	conf := DefaultConfig()
+	conf.Address = s.URL
+	conf.Token = "secret"
+	conf.BatchSize = 3
+	conf.FlushInterval = time.Hour
+	i, err := NewInflux(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2020, 7, 19, 14, 10, 0, 0, time.UTC)
+	session := &models.SessionData{Header: testHeader(1, 2), Timestamp: start.Add(2 * time.Second), TrackID: 10, TotalLaps: 44}
+	telemetry := &models.CarTelemetryData{Header: testHeader(6, 62.5), Timestamp: start.Add(time.Hour)}
+	telemetry.CarTelemetryData.Speed = 287
+	telemetry.CarTelemetryData.Throttle = "0.5000"
+	braking := &models.BrakingEvent{
+		Header:       testHeader(6, 70),
+		Timestamp:    start.Add(time.Hour),
+		Type:         "braking_event",
+		CarIndex:     3,
+		LapNum:       4,
+		EventNumber:  2,
+		StartSpeed:   310.5,
+		LockUp:       true,
+		LockedWheels: []string{"FL"},
+	}
+	store(t, i, session, telemetry, braking)
+	if err := i.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if s.count() != 1 {
+		t.Fatalf("got %d writes, want 1", s.count())
+	}
+	if !strings.HasPrefix(s.urls[0], "/api/v2/write?") || !strings.Contains(s.urls[0], "bucket=f1") ||
+		!strings.Contains(s.urls[0], "org=f1") || !strings.Contains(s.urls[0], "precision=ns") {
+		t.Errorf("got write url %s", s.urls[0])
+	}
+	if s.auth[0] != "Token secret" {
+		t.Errorf("got authorization %q, want %q", s.auth[0], "Token secret")
+	}
+
+	lines := s.lines()
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3: %q", len(lines), lines)
+	}
+	if !strings.Contains(lines[0], `,rig=rig\ 1,`) {
+		t.Errorf("rig tag is not escaped: %s", lines[0])
+	}
+
+	tests := []struct {
+		measurement string
+		tags        map[string]string
+		fields      map[string]string
+		absent      []string
+		timestamp   time.Time
+	}{
+		{
+			measurement: "session",
+			tags:        map[string]string{"session_uid": "42", "rig": "rig 1", "driver": "Tommy", "car_index": "1", "track_id": "10"},
+			fields:      map[string]string{"TotalLaps": "44"},
+			absent:      []string{"TrackID", "Header.SessionTime", "Timestamp"},
+			timestamp:   start.Add(2 * time.Second),
+		},
+		{
+			// the points are timestamped from the session time, the session tags the track
+			measurement: "car_telemetry",
+			tags:        map[string]string{"session_uid": "42", "rig": "rig 1", "driver": "Tommy", "car_index": "1", "track_id": "10"},
+			fields:      map[string]string{"CarTelemetryData.Speed": "287", "CarTelemetryData.Throttle": "0.5"},
+			timestamp:   start.Add(62500 * time.Millisecond),
+		},
+		{
+			measurement: "braking_event",
+			tags:        map[string]string{"session_uid": "42", "car_index": "3", "event_number": "2", "track_id": "10"},
+			fields:      map[string]string{"LapNum": "4", "StartSpeed": "310.5", "LockUp": "true", "LockedWheels.0": `"FL"`},
+			absent:      []string{"EventNumber", "CarIndex", "Type"},
+			timestamp:   start.Add(70 * time.Second),
+		},
+	}
+	for n, test := range tests {
+		l := parse(t, lines[n])
+		if l.measurement != test.measurement {
+			t.Errorf("line %d: got measurement %s, want %s", n, l.measurement, test.measurement)
+		}
+		for key, value := range test.tags {
+			if l.tags[key] != value {
+				t.Errorf("%s: got tag %s=%q, want %q", test.measurement, key, l.tags[key], value)
+			}
+		}
+		for key, value := range test.fields {
+			if l.fields[key] != value {
+				t.Errorf("%s: got field %s=%q, want %q", test.measurement, key, l.fields[key], value)
+			}
+		}
+		for _, key := range test.absent {
+			if _, ok := l.fields[key]; ok {
+				t.Errorf("%s: unexpected field %s", test.measurement, key)
+			}
+		}
+		if l.timestamp != test.timestamp.UnixNano() {
+			t.Errorf("%s: got timestamp %s, want %s", test.measurement, time.Unix(0, l.timestamp).UTC(), test.timestamp)
+		}
+	}
+}
+
+func TestEventNumber(t *testing.T) {
+	// the events of a lap share their header, the event number keeps their points apart
+	p1, err := decode([]byte(`{"Header":{"SessionUID":"42"},"Type":"braking_event","CarIndex":0,"EventNumber":1,"Duration":1.5}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2, err := decode([]byte(`{"Header":{"SessionUID":"42"},"Type":"braking_event","CarIndex":0,"EventNumber":2,"Duration":0.5}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timestamp := time.Unix(1600000000, 0)
+	buf := &bytes.Buffer{}
+	p1.line(buf, timestamp)
+	p2.line(buf, timestamp)
+
+	want := "braking_event,car_index=0,event_number=1,session_uid=42 Duration=1.5 1600000000000000000\n" +
+		"braking_event,car_index=0,event_number=2,session_uid=42 Duration=0.5 1600000000000000000\n"
+	if buf.String() != want {
+		t.Errorf("got lines\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestFlushBatchSize(t *testing.T) {
+	s := newServer(t)
+	conf := DefaultConfig()
+	conf.Address = s.URL
+	conf.BatchSize = 2
+	conf.FlushInterval = time.Hour
+	i, err := NewInflux(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer i.Close()
+
+	telemetry := &models.CarTelemetryData{Header: testHeader(6, 1)}
+	telemetry.CarTelemetryData.Speed = 100
+	store(t, i, telemetry)
+	if s.count() != 0 {
+		t.Fatalf("got %d writes before the batch is full, want 0", s.count())
+	}
+
+	// the full batch is written by Store
+	telemetry.Header.SessionTime = 2
+	store(t, i, telemetry)
+	if s.count() != 1 {
+		t.Fatalf("got %d writes once the batch is full, want 1", s.count())
+	}
+	if lines := s.lines(); len(lines) != 2 {
+		t.Errorf("got %d lines, want 2", len(lines))
+	}
+
+	telemetry.Header.SessionTime = 3
+	store(t, i, telemetry)
+	if s.count() != 1 {
+		t.Errorf("got %d writes, want 1", s.count())
+	}
+}
+
+func TestFlushInterval(t *testing.T) {
+	s := newServer(t)
+	conf := DefaultConfig()
+	conf.Address = s.URL
+	conf.Database = "f1"
+	conf.BatchSize = 100
+	conf.FlushInterval = 50 * time.Millisecond
+	i, err := NewInflux(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	telemetry := &models.CarTelemetryData{Header: testHeader(6, 1)}
+	telemetry.CarTelemetryData.Speed = 100
+	store(t, i, telemetry)
+
+	select {
+	case <-s.ch:
+	case <-time.After(time.Second):
+		t.Fatal("the buffered point was not written after the flush interval")
+	}
+	if lines := s.lines(); len(lines) != 1 {
+		t.Errorf("got %d lines, want 1", len(lines))
+	}
+	if !strings.HasPrefix(s.urls[0], "/write?") || !strings.Contains(s.urls[0], "db=f1") {
+		t.Errorf("got influxdb 1.x write url %s", s.urls[0])
+	}
+
+	// nothing is written while the buffer is empty
+	time.Sleep(3 * conf.FlushInterval)
+	if err := i.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if s.count() != 1 {
+		t.Errorf("got %d writes, want 1", s.count())
+	}
+}
@@ -0,0 +1,183 @@
+package influx
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository"
+)
+
+// document is a models document decoded from json
+type document map[string]interface{}
+
+// header is the part of the Header of a document used to tag and timestamp its point
+type header struct {
+	PacketID       uint8
+	SessionUID     string
+	SessionTime    float64
+	PlayerCarIndex uint8
+	Source         struct {
+		Rig    string
+		Driver string
+	}
+}
+
+// point is a document converted to a line of the line protocol
+type point struct {
+	measurement string
+	tags        map[string]string
+	fields      map[string]string // field values already formatted for the line protocol
+	header      header
+	timestamp   time.Time // Timestamp of the document, when it was created
+}
+
+// decode converts a json document to a point.
+// The header becomes the session_uid, rig, driver and car_index tags, with the TrackID of the document
+// and the EventNumber of the events, the events of a lap share its header and would be merged otherwise,
+// every other number, boolean and string is a field named after its path in the document, ie: CarTelemetryData.Speed.
+// Lists of objects, such as the telemetry snippets or the track map points, are left out.
+func decode(body []byte) (*point, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	doc := document{}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, errors.Wrap(err, "could not decode document")
+	}
+
+	p := &point{
+		tags:   map[string]string{},
+		fields: map[string]string{},
+	}
+
+	if raw, ok := doc["Header"]; ok {
+		data, err := json.Marshal(raw)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not encode header")
+		}
+		if err := json.Unmarshal(data, &p.header); err != nil {
+			return nil, errors.Wrap(err, "could not decode header")
+		}
+	}
+	if s, ok := doc["Timestamp"].(string); ok {
+		p.timestamp, _ = time.Parse(time.RFC3339Nano, s)
+	}
+
+	p.measurement, _ = doc["Type"].(string)
+	if p.measurement == "" {
+		p.measurement = repository.PacketNames[f1packet.PacketType(p.header.PacketID)]
+		// the raw packets only hold the player car
+		p.tags["car_index"] = strconv.Itoa(int(p.header.PlayerCarIndex))
+	}
+	if p.measurement == "" {
+		return nil, errors.Errorf("unknown measurement for packet id %d", p.header.PacketID)
+	}
+
+	p.tags["session_uid"] = p.header.SessionUID
+	p.tags["rig"] = p.header.Source.Rig
+	p.tags["driver"] = p.header.Source.Driver
+	if n, ok := doc["CarIndex"].(json.Number); ok {
+		p.tags["car_index"] = n.String()
+	}
+	if n, ok := doc["TrackID"].(json.Number); ok {
+		p.tags["track_id"] = n.String()
+	}
+	if n, ok := doc["EventNumber"].(json.Number); ok {
+		p.tags["event_number"] = n.String()
+	}
+
+	for key, value := range doc {
+		switch key {
+		case "Header", "Timestamp", "Type", "CarIndex", "TrackID", "EventNumber":
+			continue
+		}
+		p.flatten(key, value)
+	}
+	return p, nil
+}
+
+// flatten adds the fields of a value found at path
+func (p *point) flatten(path string, value interface{}) {
+	switch v := value.(type) {
+	case json.Number:
+		// every number is written as a float, a field keeps the same type across points
+		if f, err := v.Float64(); err == nil {
+			p.fields[path] = strconv.FormatFloat(f, 'f', -1, 64)
+		}
+	case bool:
+		p.fields[path] = strconv.FormatBool(v)
+	case string:
+		// some telemetry is formatted as a string, ie: CarTelemetryData.Throttle
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			p.fields[path] = strconv.FormatFloat(f, 'f', -1, 64)
+			return
+		}
+		// the names of the raw packets are padded with null characters
+		p.fields[path] = `"` + fieldEscaper.Replace(strings.TrimRight(v, "\x00")) + `"`
+	case map[string]interface{}:
+		for key, value := range v {
+			p.flatten(path+"."+key, value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			switch value.(type) {
+			case map[string]interface{}, []interface{}:
+				return
+			}
+			p.flatten(path+"."+strconv.Itoa(i), value)
+		}
+	}
+}
+
+var (
+	measurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `)
+	keyEscaper         = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+	fieldEscaper       = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+)
+
+// line writes the point in the line protocol with the given timestamp,
+// ie: car_telemetry,car_index=0,session_uid=42 CarTelemetryData.Speed=287 1600000000000000000
+func (p *point) line(buf *bytes.Buffer, timestamp time.Time) {
+	buf.WriteString(measurementEscaper.Replace(p.measurement))
+
+	for _, key := range sortedKeys(p.tags) {
+		// empty tag values are not allowed
+		if p.tags[key] == "" {
+			continue
+		}
+		buf.WriteByte(',')
+		buf.WriteString(keyEscaper.Replace(key))
+		buf.WriteByte('=')
+		buf.WriteString(keyEscaper.Replace(p.tags[key]))
+	}
+
+	for i, key := range sortedKeys(p.fields) {
+		if i == 0 {
+			buf.WriteByte(' ')
+		} else {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(keyEscaper.Replace(key))
+		buf.WriteByte('=')
+		buf.WriteString(p.fields[key])
+	}
+
+	buf.WriteByte(' ')
+	buf.WriteString(strconv.FormatInt(timestamp.UnixNano(), 10))
+	buf.WriteByte('\n')
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
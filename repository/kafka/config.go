@@ -0,0 +1,25 @@
+package kafka
+
+import "time"
+
+type Config struct {
+	Brokers     []string // Addresses of the brokers, ie: localhost:9092
+	TopicPrefix string   // Prefix of the topics, a topic per document type: f1.car_telemetry, f1.lap, ...
+
+	Acks        string // Acknowledgements required: none, one or all
+	Compression string // Compression of the batches: none, gzip, snappy, lz4 or zstd
+
+	BatchSize     int           // Number of messages of a partition buffered before being written. Default: 1000.
+	FlushInterval time.Duration // Maximum time a message is buffered before being written. Default: 100ms.
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Brokers:       []string{"localhost:9092"},
+		TopicPrefix:   "f1.",
+		Acks:          "one",
+		Compression:   "snappy",
+		BatchSize:     1000,
+		FlushInterval: 100 * time.Millisecond,
+	}
+}
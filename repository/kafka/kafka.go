@@ -0,0 +1,158 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository"
+)
+
+// Kafka publishes the documents to a topic per document type.
+// The messages are keyed by session and car, the messages of a car during a session go to the same partition
+// and are consumed in the order they were published.
+type Kafka struct {
+	writer *kafkago.Writer
+	prefix string
+}
+
+func NewKafka(conf Config) (*Kafka, error) {
+	defaults := DefaultConfig()
+	if len(conf.Brokers) == 0 {
+		conf.Brokers = defaults.Brokers
+	}
+	if conf.BatchSize <= 0 {
+		conf.BatchSize = defaults.BatchSize
+	}
+	if conf.FlushInterval <= 0 {
+		conf.FlushInterval = defaults.FlushInterval
+	}
+	if conf.Acks == "" {
+		conf.Acks = defaults.Acks
+	}
+
+	acks, err := parseAcks(conf.Acks)
+	if err != nil {
+		return nil, err
+	}
+	compression, err := parseCompression(conf.Compression)
+	if err != nil {
+		return nil, err
+	}
+
+	// fail early when no broker can be reached
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var dialErr error
+	for _, broker := range conf.Brokers {
+		conn, err := kafkago.DialContext(ctx, "tcp", broker)
+		if err == nil {
+			conn.Close()
+			dialErr = nil
+			break
+		}
+		dialErr = errors.Wrapf(err, "could not reach kafka broker %s", broker)
+	}
+	if dialErr != nil {
+		return nil, dialErr
+	}
+
+	return &Kafka{
+		writer: &kafkago.Writer{
+			Addr:         kafkago.TCP(conf.Brokers...),
+			Balancer:     &kafkago.Hash{},
+			RequiredAcks: acks,
+			Compression:  compression,
+			BatchSize:    conf.BatchSize,
+			BatchTimeout: conf.FlushInterval,
+			// the messages are buffered and written in the background, as the other repositories do
+			Async: true,
+			Completion: func(messages []kafkago.Message, err error) {
+				if err != nil {
+					logrus.WithError(err).Errorf("could not publish %d messages to kafka", len(messages))
+				}
+			},
+		},
+		prefix: conf.TopicPrefix,
+	}, nil
+}
+
+// Store publishes the document to the topic of its type
+func (k *Kafka) Store(ctx context.Context, body *bytes.Reader) error {
+	if k == nil {
+		return errors.New("kafka is not initialized")
+	}
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return errors.Wrap(err, "could not read document")
+	}
+	msg, err := message(k.prefix, data)
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(k.writer.WriteMessages(ctx, msg), "could not publish document to kafka")
+}
+
+// Close writes the messages still buffered
+func (k *Kafka) Close() error {
+	return errors.Wrap(k.writer.Close(), "could not close kafka writer")
+}
+
+// message returns the message of a json document, published to the topic of its type prefixed by prefix,
+// with the rig and driver of its source as headers
+func message(prefix string, data []byte) (kafkago.Message, error) {
+	doc, err := repository.Describe(data)
+	if err != nil {
+		return kafkago.Message{}, err
+	}
+
+	msg := kafkago.Message{
+		Topic: prefix + doc.Name,
+		Key:   []byte(Key(doc)),
+		Value: data,
+	}
+	if doc.Rig != "" {
+		msg.Headers = append(msg.Headers, kafkago.Header{Key: "rig", Value: []byte(doc.Rig)})
+	}
+	if doc.Driver != "" {
+		msg.Headers = append(msg.Headers, kafkago.Header{Key: "driver", Value: []byte(doc.Driver)})
+	}
+	return msg, nil
+}
+
+// parseAcks returns the acknowledgements required by their name: none, one or all
+func parseAcks(name string) (kafkago.RequiredAcks, error) {
+	var acks kafkago.RequiredAcks
+	if err := acks.UnmarshalText([]byte(name)); err != nil {
+		return acks, errors.Wrap(err, "invalid kafka acks")
+	}
+	return acks, nil
+}
+
+// parseCompression returns the compression codec by its name: none, gzip, snappy, lz4 or zstd, no compression when empty
+func parseCompression(name string) (kafkago.Compression, error) {
+	var compression kafkago.Compression
+	if name == "" || name == "none" {
+		return compression, nil
+	}
+	if err := compression.UnmarshalText([]byte(name)); err != nil {
+		return compression, errors.Wrap(err, "invalid kafka compression")
+	}
+	return compression, nil
+}
+
+// Key returns the key of the message of a document: its session UID and car index, ie: 123/0,
+// or only its session UID for the documents about the whole session
+func Key(doc repository.Document) string {
+	if doc.CarIndex < 0 {
+		return doc.SessionUID
+	}
+	return doc.SessionUID + "/" + strconv.Itoa(doc.CarIndex)
+}
@@ -0,0 +1,100 @@
+//go:build integration
+// +build integration
+
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	kafkago "github.com/segmentio/kafka-go"
+)
+
+// TestPublish publishes documents to a local broker and reads them back, KAFKA_BROKERS gives the broker,
+// default localhost:9092. Run with: go test -tags integration ./repository/kafka
+func TestPublish(t *testing.T) {
+	broker := "localhost:9092"
+	if brokers := os.Getenv("KAFKA_BROKERS"); brokers != "" {
+		broker = strings.Split(brokers, ",")[0]
+	}
+	prefix := "f1-test-" + strconv.FormatInt(time.Now().UnixNano(), 10) + "."
+	topic := prefix + "lap"
+
+	conn, err := kafkago.Dial("tcp", broker)
+	if err != nil {
+		t.Fatalf("could not reach kafka broker %s: %v", broker, err)
+	}
+	defer conn.Close()
+	controller, err := conn.Controller()
+	if err != nil {
+		t.Fatal(err)
+	}
+	controllerConn, err := kafkago.Dial("tcp", net.JoinHostPort(controller.Host, strconv.Itoa(controller.Port)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer controllerConn.Close()
+	if err := controllerConn.CreateTopics(kafkago.TopicConfig{Topic: topic, NumPartitions: 1, ReplicationFactor: 1}); err != nil {
+		t.Fatal(err)
+	}
+	defer controllerConn.DeleteTopics(topic)
+
+	conf := DefaultConfig()
+	conf.Brokers = []string{broker}
+	conf.TopicPrefix = prefix
+	conf.Acks = "all"
+	conf.FlushInterval = 10 * time.Millisecond
+	k, err := NewKafka(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	docs := []string{
+		`{"Header":{"SessionUID":"42","Source":{"Rig":"rig-1","Driver":"Tommy"}},"Type":"lap","CarIndex":0,"LapNum":1}`,
+		`{"Header":{"SessionUID":"42","Source":{"Rig":"rig-1","Driver":"Tommy"}},"Type":"lap","CarIndex":0,"LapNum":2}`,
+		`{"Header":{"SessionUID":"42"},"Type":"lap","CarIndex":3,"LapNum":1}`,
+	}
+	for _, doc := range docs {
+		if err := k.Store(context.Background(), bytes.NewReader([]byte(doc))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := k.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader := kafkago.NewReader(kafkago.ReaderConfig{Brokers: []string{broker}, Topic: topic, Partition: 0})
+	defer reader.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	keys := []string{"42/0", "42/0", "42/3"}
+	for i, doc := range docs {
+		msg, err := reader.ReadMessage(ctx)
+		if err != nil {
+			t.Fatalf("could not read message %d: %v", i, err)
+		}
+		if string(msg.Value) != doc {
+			t.Errorf("message %d: got %s, want %s", i, msg.Value, doc)
+		}
+		if string(msg.Key) != keys[i] {
+			t.Errorf("message %d: got key %s, want %s", i, msg.Key, keys[i])
+		}
+		headers := map[string]string{}
+		for _, h := range msg.Headers {
+			headers[h.Key] = string(h.Value)
+		}
+		if i < 2 && (headers["rig"] != "rig-1" || headers["driver"] != "Tommy") {
+			t.Errorf("message %d: got headers %v", i, headers)
+		}
+		if i == 2 && len(headers) != 0 {
+			t.Errorf("message %d: got headers %v, want none", i, headers)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package kafka
+
+import (
+	"testing"
+
+	kafkago "github.com/segmentio/kafka-go"
+)
+
+func TestMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		topic   string
+		key     string
+		headers map[string]string
+	}{
+		{
+			name:    "raw packet of the player car",
+			body:    `{"Header":{"PacketID":6,"SessionUID":"42","PlayerCarIndex":2,"Source":{"Rig":"rig-1","Driver":"Tommy"}}}`,
+			topic:   "f1.car_telemetry",
+			key:     "42/2",
+			headers: map[string]string{"rig": "rig-1", "driver": "Tommy"},
+		},
+		{
+			name:  "session packet",
+			body:  `{"Header":{"PacketID":1,"SessionUID":"42","PlayerCarIndex":2}}`,
+			topic: "f1.session",
+			key:   "42",
+		},
+		{
+			name:  "event packet",
+			body:  `{"Header":{"PacketID":3,"SessionUID":"42","PlayerCarIndex":2},"EventStringCode":"SSTA"}`,
+			topic: "f1.event",
+			key:   "42",
+		},
+		{
+			name:    "document of a car",
+			body:    `{"Header":{"PacketID":2,"SessionUID":"42","PlayerCarIndex":2,"Source":{"Rig":"rig-1"}},"Type":"lap","CarIndex":5}`,
+			topic:   "f1.lap",
+			key:     "42/5",
+			headers: map[string]string{"rig": "rig-1"},
+		},
+		{
+			name:  "document of the first car",
+			body:  `{"Header":{"PacketID":2,"SessionUID":"42","PlayerCarIndex":2},"Type":"braking_event","CarIndex":0}`,
+			topic: "f1.braking_event",
+			key:   "42/0",
+		},
+		{
+			name:  "document of the whole session",
+			body:  `{"Header":{"PacketID":2,"SessionUID":"42","PlayerCarIndex":2},"Type":"timing_tower"}`,
+			topic: "f1.timing_tower",
+			key:   "42",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg, err := message("f1.", []byte(test.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if msg.Topic != test.topic {
+				t.Errorf("got topic %s, want %s", msg.Topic, test.topic)
+			}
+			if string(msg.Key) != test.key {
+				t.Errorf("got key %s, want %s", msg.Key, test.key)
+			}
+			if string(msg.Value) != test.body {
+				t.Errorf("got value %s, want the document", msg.Value)
+			}
+			if len(msg.Headers) != len(test.headers) {
+				t.Errorf("got %d headers, want %d", len(msg.Headers), len(test.headers))
+			}
+			for _, h := range msg.Headers {
+				if test.headers[h.Key] != string(h.Value) {
+					t.Errorf("got header %s=%s, want %q", h.Key, h.Value, test.headers[h.Key])
+				}
+			}
+		})
+	}
+}
+
+func TestMessageUnknownPacket(t *testing.T) {
+	if _, err := message("f1.", []byte(`{"Header":{"PacketID":42,"SessionUID":"42"}}`)); err == nil {
+		t.Error("got no error for an unknown packet")
+	}
+	if _, err := message("f1.", []byte(`not json`)); err == nil {
+		t.Error("got no error for an invalid document")
+	}
+}
+
+func TestParseAcks(t *testing.T) {
+	tests := []struct {
+		name  string
+		acks  kafkago.RequiredAcks
+		valid bool
+	}{
+		{"none", kafkago.RequireNone, true},
+		{"one", kafkago.RequireOne, true},
+		{"all", kafkago.RequireAll, true},
+		{"", 0, false},
+		{"two", 0, false},
+	}
+
+	for _, test := range tests {
+		acks, err := parseAcks(test.name)
+		if test.valid != (err == nil) {
+			t.Errorf("%q: got error %v", test.name, err)
+			continue
+		}
+		if test.valid && acks != test.acks {
+			t.Errorf("%q: got acks %s, want %s", test.name, acks, test.acks)
+		}
+	}
+}
+
+func TestParseCompression(t *testing.T) {
+	tests := []struct {
+		name        string
+		compression kafkago.Compression
+		valid       bool
+	}{
+		{"", 0, true},
+		{"none", 0, true},
+		{"gzip", kafkago.Gzip, true},
+		{"snappy", kafkago.Snappy, true},
+		{"lz4", kafkago.Lz4, true},
+		{"zstd", kafkago.Zstd, true},
+		{"brotli", 0, false},
+	}
+
+	for _, test := range tests {
+		compression, err := parseCompression(test.name)
+		if test.valid != (err == nil) {
+			t.Errorf("%q: got error %v", test.name, err)
+			continue
+		}
+		if test.valid && compression != test.compression {
+			t.Errorf("%q: got compression %s, want %s", test.name, compression, test.compression)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package mqtt
+
+import "time"
+
+type Config struct {
+	Broker   string // Address of the broker, ie: tcp://localhost:1883
+	ClientID string
+	Username string
+	Password string
+
+	TopicPrefix string // Root of the topic tree, ie: f1/<rig>/car/gear
+	QoS         byte   // Quality of service of the messages: 0, 1 or 2
+
+	// Minimum time between two messages of the values changing continuously: speed, RPM and rev lights.
+	// Default: 50ms, 20 messages per second.
+	Interval time.Duration
+	// Minimum time between two messages of the values changing slowly: fuel laps and deltas. Default: 1s.
+	SlowInterval time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Broker:       "tcp://localhost:1883",
+		ClientID:     "f1-telemetry",
+		TopicPrefix:  "f1",
+		Interval:     50 * time.Millisecond,
+		SlowInterval: time.Second,
+	}
+}
@@ -0,0 +1,366 @@
+package mqtt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"strconv"
+	"sync"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/timing"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository"
+)
+
+const (
+	// connectTimeout is the time given to connect to the broker and to publish on close
+	connectTimeout = 10 * time.Second
+	// reorderWindow is how far back in session time a document is considered received out of order,
+	// a larger rewind is a flashback and its values are published
+	reorderWindow = float32(1)
+	// pendingTokens is the number of messages published and not acknowledged yet before publishing blocks
+	pendingTokens = 1024
+)
+
+// topic holds the latest value published to a topic
+type topic struct {
+	value     string
+	published time.Time
+	interval  time.Duration // Minimum time between two messages, 0 to publish every change
+	retained  bool
+
+	// documents are stored concurrently, a slightly older value received after a newer one is dropped
+	session     string
+	sessionTime float32
+
+	pending    string // Latest value received before the interval elapsed
+	hasPending bool
+}
+
+// message is a message to publish
+type message struct {
+	topic    string
+	value    string
+	retained bool
+}
+
+// delivery is a message published and waiting for its acknowledgement
+type delivery struct {
+	topic string
+	token paho.Token
+}
+
+// MQTT publishes a subset of the values of the player car to a topic tree, for dashboards and cockpit hardware:
+//
+//	<prefix>/status                                  online or offline, retained
+//	<prefix>/<rig>/session                           session metadata as json, retained
+//	<prefix>/<rig>/car/speed|rpm|rev_lights          at most every Interval
+//	<prefix>/<rig>/car/gear|drs|drs_allowed|flag     on change
+//	<prefix>/<rig>/car/fuel_laps                     at most every SlowInterval
+//	<prefix>/<rig>/timing/position                   on change
+//	<prefix>/<rig>/timing/delta_ahead|delta_behind   at most every SlowInterval
+//
+// The values are published as text, the last value received during an interval is published once it elapsed.
+// The timing values are read from the live towers, updated on every lap data, and not from the stored documents.
+type MQTT struct {
+	client paho.Client
+	conf   Config
+	towers *timing.Towers
+
+	mu     sync.Mutex
+	topics map[string]*topic
+
+	deliveries chan delivery
+	delivered  chan struct{}
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewMQTT(conf Config, towers *timing.Towers) (*MQTT, error) {
+	defaults := DefaultConfig()
+	if conf.Broker == "" {
+		conf.Broker = defaults.Broker
+	}
+	if conf.ClientID == "" {
+		conf.ClientID = defaults.ClientID
+	}
+	if conf.Interval <= 0 {
+		conf.Interval = defaults.Interval
+	}
+	if conf.SlowInterval <= 0 {
+		conf.SlowInterval = defaults.SlowInterval
+	}
+	if conf.QoS > 2 {
+		return nil, errors.Errorf("invalid mqtt qos %d", conf.QoS)
+	}
+
+	status := conf.TopicPrefix + "/status"
+	opts := paho.NewClientOptions().
+		AddBroker(conf.Broker).
+		SetClientID(conf.ClientID).
+		SetUsername(conf.Username).
+		SetPassword(conf.Password).
+		SetAutoReconnect(true).
+		SetConnectTimeout(connectTimeout).
+		SetWill(status, "offline", 1, true).
+		SetOnConnectHandler(func(c paho.Client) {
+			c.Publish(status, 1, true, "online")
+		})
+
+	m := &MQTT{
+		client:     paho.NewClient(opts),
+		conf:       conf,
+		towers:     towers,
+		topics:     map[string]*topic{},
+		deliveries: make(chan delivery, pendingTokens),
+		delivered:  make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+
+	token := m.client.Connect()
+	if !token.WaitTimeout(connectTimeout) {
+		return nil, errors.Errorf("could not connect to mqtt broker %s: timeout", conf.Broker)
+	}
+	if err := token.Error(); err != nil {
+		return nil, errors.Wrapf(err, "could not connect to mqtt broker %s", conf.Broker)
+	}
+
+	go m.wait()
+	m.wg.Add(2)
+	go m.run()
+	go m.follow(towers.Subscribe(""))
+
+	return m, nil
+}
+
+// Store publishes the values of the document that changed
+func (m *MQTT) Store(ctx context.Context, body *bytes.Reader) error {
+	if m == nil {
+		return errors.New("mqtt is not initialized")
+	}
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return errors.Wrap(err, "could not read document")
+	}
+	doc, err := repository.Describe(data)
+	if err != nil {
+		return err
+	}
+
+	base := m.base(doc.Rig)
+
+	switch doc.Name {
+	case "session":
+		s := models.SessionData{}
+		if err := json.Unmarshal(data, &s); err != nil {
+			return errors.Wrap(err, "could not decode session")
+		}
+		metadata, err := json.Marshal(map[string]interface{}{
+			"SessionUID":  doc.SessionUID,
+			"TrackID":     s.TrackID,
+			"Track":       models.TrackName(s.TrackID),
+			"SessionType": models.SessionTypeName(s.SessionType),
+			"Formula":     s.Formula,
+			"Weather":     models.WeatherName(s.Weather),
+			"TotalLaps":   s.TotalLaps,
+			"TrackLength": s.TrackLength,
+			"Driver":      doc.Driver,
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not encode session metadata")
+		}
+		m.publish(s.Header, topicValue{base + "/session", string(metadata), 0, true})
+
+	case "car_telemetry":
+		t := models.CarTelemetryData{}
+		if err := json.Unmarshal(data, &t); err != nil {
+			return errors.Wrap(err, "could not decode car telemetry")
+		}
+		c := t.CarTelemetryData
+		m.publish(t.Header,
+			topicValue{base + "/car/speed", strconv.Itoa(int(c.Speed)), m.conf.Interval, false},
+			topicValue{base + "/car/rpm", strconv.Itoa(int(c.EngineRPM)), m.conf.Interval, false},
+			topicValue{base + "/car/rev_lights", strconv.Itoa(int(c.RevLightsPercent)), m.conf.Interval, false},
+			topicValue{base + "/car/gear", strconv.Itoa(int(c.Gear)), 0, false},
+			topicValue{base + "/car/drs", strconv.Itoa(int(c.Drs)), 0, false},
+		)
+
+	case "car_status":
+		s := models.CarStatusData{}
+		if err := json.Unmarshal(data, &s); err != nil {
+			return errors.Wrap(err, "could not decode car status")
+		}
+		c := s.CarStatusData
+		fuelLaps, _ := strconv.ParseFloat(c.FuelRemainingLaps, 64)
+		m.publish(s.Header,
+			topicValue{base + "/car/fuel_laps", strconv.FormatFloat(fuelLaps, 'f', 2, 64), m.conf.SlowInterval, false},
+			topicValue{base + "/car/drs_allowed", strconv.Itoa(int(c.DrsAllowed)), 0, false},
+			topicValue{base + "/car/flag", models.FlagName(c.VehicleFiaFlags), 0, false},
+		)
+	}
+	return nil
+}
+
+// base returns the root of the topics of a rig
+func (m *MQTT) base(rig string) string {
+	if rig == "" {
+		rig = "default"
+	}
+	return m.conf.TopicPrefix + "/" + rig
+}
+
+// follow publishes the position and deltas of the player car from the live towers, until closed
+func (m *MQTT) follow(towers chan *models.TimingTower) {
+	defer m.wg.Done()
+	defer m.towers.Unsubscribe(towers)
+
+	for {
+		select {
+		case <-m.done:
+			return
+		case tower := <-towers:
+			base := m.base(tower.Header.Source.Rig)
+			for i, car := range tower.Cars {
+				if car.CarIndex != tower.Header.PlayerCarIndex {
+					continue
+				}
+				behind := float32(0)
+				if i+1 < len(tower.Cars) {
+					behind = tower.Cars[i+1].Interval
+				}
+				m.publish(tower.Header,
+					topicValue{base + "/timing/position", strconv.Itoa(int(car.Position)), 0, false},
+					topicValue{base + "/timing/delta_ahead", strconv.FormatFloat(float64(car.Interval), 'f', 3, 32), m.conf.SlowInterval, false},
+					topicValue{base + "/timing/delta_behind", strconv.FormatFloat(float64(behind), 'f', 3, 32), m.conf.SlowInterval, false},
+				)
+			}
+		}
+	}
+}
+
+// Close publishes the values still pending and disconnects from the broker
+func (m *MQTT) Close() error {
+	close(m.done)
+	m.wg.Wait()
+
+	m.send(m.flush(true))
+	close(m.deliveries)
+	<-m.delivered
+
+	// the will is only published by the broker when the connection is lost
+	var err error
+	token := m.client.Publish(m.conf.TopicPrefix+"/status", 1, true, "offline")
+	if !token.WaitTimeout(connectTimeout) {
+		err = errors.New("could not publish mqtt offline status: timeout")
+	} else if token.Error() != nil {
+		err = errors.Wrap(token.Error(), "could not publish mqtt offline status")
+	}
+	m.client.Disconnect(uint(connectTimeout / time.Millisecond))
+	return err
+}
+
+// run publishes the pending values once their interval elapsed, until closed
+func (m *MQTT) run() {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.conf.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ticker.C:
+			m.send(m.flush(false))
+		}
+	}
+}
+
+// topicValue is a value received for a topic
+type topicValue struct {
+	topic    string
+	value    string
+	interval time.Duration
+	retained bool
+}
+
+// publish publishes the values that changed, or keeps them pending until their interval elapsed
+func (m *MQTT) publish(h models.Header, values ...topicValue) {
+	now := time.Now()
+	messages := []message{}
+
+	m.mu.Lock()
+	for _, v := range values {
+		t, ok := m.topics[v.topic]
+		if !ok {
+			t = &topic{interval: v.interval, retained: v.retained}
+			m.topics[v.topic] = t
+		} else if t.session == h.SessionUID && h.SessionTime < t.sessionTime && t.sessionTime-h.SessionTime < reorderWindow {
+			continue
+		}
+		t.session = h.SessionUID
+		t.sessionTime = h.SessionTime
+
+		if ok && v.value == t.value {
+			t.hasPending = false
+			continue
+		}
+		if ok && now.Sub(t.published) < t.interval {
+			t.pending = v.value
+			t.hasPending = true
+			continue
+		}
+		t.value = v.value
+		t.published = now
+		t.hasPending = false
+		messages = append(messages, message{v.topic, v.value, t.retained})
+	}
+	m.mu.Unlock()
+
+	m.send(messages)
+}
+
+// flush returns the pending values whose interval elapsed, or every pending value when all is set
+func (m *MQTT) flush(all bool) []message {
+	now := time.Now()
+	messages := []message{}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for name, t := range m.topics {
+		if !t.hasPending || (!all && now.Sub(t.published) < t.interval) {
+			continue
+		}
+		t.value = t.pending
+		t.published = now
+		t.hasPending = false
+		messages = append(messages, message{name, t.value, t.retained})
+	}
+	return messages
+}
+
+// send publishes the messages without waiting for their acknowledgement
+func (m *MQTT) send(messages []message) {
+	for _, msg := range messages {
+		token := m.client.Publish(msg.topic, m.conf.QoS, msg.retained, msg.value)
+		m.deliveries <- delivery{msg.topic, token}
+	}
+}
+
+// wait waits for the acknowledgement of the messages published in order and logs their errors, until the deliveries are closed
+func (m *MQTT) wait() {
+	defer close(m.delivered)
+
+	for d := range m.deliveries {
+		if d.token.WaitTimeout(connectTimeout) && d.token.Error() != nil {
+			logrus.WithError(d.token.Error()).Errorf("could not publish to mqtt topic %s", d.topic)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Multi stores every document to several repositories
+type Multi []Repository
+
+// Store stores the document to every repository, it returns the first error
+// but still stores the document to the repositories after the failing one
+func (m Multi) Store(ctx context.Context, body *bytes.Reader) error {
+	var first error
+	for _, repo := range m {
+		if _, err := body.Seek(0, io.SeekStart); err != nil {
+			return errors.Wrap(err, "could not rewind document")
+		}
+		if err := repo.Store(ctx, body); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// Close closes the repositories holding resources, such as buffered documents
+func (m Multi) Close() error {
+	var first error
+	for _, repo := range m {
+		closer, ok := repo.(io.Closer)
+		if !ok {
+			continue
+		}
+		if err := closer.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
@@ -0,0 +1,21 @@
+package nats
+
+import "time"
+
+type Config struct {
+	URL           string // Address of the server, ie: nats://localhost:4222. Comma separated for a cluster.
+	SubjectPrefix string // First token of the subjects, ie: f1.<session>.<packet>.<car>
+
+	// JetStream stream the subjects are persisted to, created when it does not exist.
+	// The messages are only published, not persisted, when empty.
+	Stream string
+	MaxAge time.Duration // Maximum age of the messages of a stream it creates, 0 to keep them forever. Default: 24h.
+}
+
+func DefaultConfig() Config {
+	return Config{
+		URL:           "nats://localhost:4222",
+		SubjectPrefix: "f1",
+		MaxAge:        24 * time.Hour,
+	}
+}
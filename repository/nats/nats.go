@@ -0,0 +1,134 @@
+package nats
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"strconv"
+	"time"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository"
+)
+
+// closeTimeout is the time given to the messages still in flight on close
+const closeTimeout = 10 * time.Second
+
+// NATS publishes the documents to a subject per session, type and car, ie: f1.123.car_telemetry.0,
+// and persists them to a JetStream stream when one is configured.
+type NATS struct {
+	conn   *natsgo.Conn
+	js     natsgo.JetStreamContext // nil when the messages are not persisted
+	prefix string
+}
+
+func NewNATS(conf Config) (*NATS, error) {
+	defaults := DefaultConfig()
+	if conf.URL == "" {
+		conf.URL = defaults.URL
+	}
+	if conf.SubjectPrefix == "" {
+		conf.SubjectPrefix = defaults.SubjectPrefix
+	}
+
+	conn, err := natsgo.Connect(conf.URL,
+		natsgo.Name("f1-telemetry"),
+		natsgo.MaxReconnects(-1),
+		natsgo.DisconnectErrHandler(func(_ *natsgo.Conn, err error) {
+			if err != nil {
+				logrus.WithError(err).Error("disconnected from nats")
+			}
+		}),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not connect to nats %s", conf.URL)
+	}
+
+	n := &NATS{conn: conn, prefix: conf.SubjectPrefix}
+	if conf.Stream == "" {
+		return n, nil
+	}
+
+	n.js, err = conn.JetStream(natsgo.PublishAsyncErrHandler(func(_ natsgo.JetStream, msg *natsgo.Msg, err error) {
+		logrus.WithError(err).Errorf("could not persist message to nats subject %s", msg.Subject)
+	}))
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "could not open nats jetstream")
+	}
+	if _, err := n.js.StreamInfo(conf.Stream); err == natsgo.ErrStreamNotFound {
+		_, err = n.js.AddStream(&natsgo.StreamConfig{
+			Name:     conf.Stream,
+			Subjects: []string{conf.SubjectPrefix + ".>"},
+			Storage:  natsgo.FileStorage,
+			MaxAge:   conf.MaxAge,
+		})
+		if err != nil {
+			conn.Close()
+			return nil, errors.Wrapf(err, "could not create nats stream %s", conf.Stream)
+		}
+	} else if err != nil {
+		conn.Close()
+		return nil, errors.Wrapf(err, "could not get nats stream %s", conf.Stream)
+	}
+	return n, nil
+}
+
+// Store publishes the document to its subject
+func (n *NATS) Store(ctx context.Context, body *bytes.Reader) error {
+	if n == nil {
+		return errors.New("nats is not initialized")
+	}
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return errors.Wrap(err, "could not read document")
+	}
+	doc, err := repository.Describe(data)
+	if err != nil {
+		return err
+	}
+
+	msg := natsgo.NewMsg(Subject(n.prefix, doc))
+	msg.Data = data
+	if doc.Rig != "" {
+		msg.Header.Set("Rig", doc.Rig)
+	}
+	if doc.Driver != "" {
+		msg.Header.Set("Driver", doc.Driver)
+	}
+
+	if n.js != nil {
+		// the acknowledgements are awaited in the background, as the other repositories do
+		_, err = n.js.PublishMsgAsync(msg)
+		return errors.Wrapf(err, "could not persist document to nats subject %s", msg.Subject)
+	}
+	return errors.Wrapf(n.conn.PublishMsg(msg), "could not publish document to nats subject %s", msg.Subject)
+}
+
+// Close waits for the messages still in flight and closes the connection
+func (n *NATS) Close() error {
+	if n.js != nil {
+		select {
+		case <-n.js.PublishAsyncComplete():
+		case <-time.After(closeTimeout):
+			logrus.Errorf("%d messages were not persisted to nats", n.js.PublishAsyncPending())
+		}
+	}
+	err := n.conn.FlushTimeout(closeTimeout)
+	n.conn.Close()
+	return errors.Wrap(err, "could not flush nats connection")
+}
+
+// Subject returns the subject of a document: <prefix>.<session>.<name>.<car>, ie: f1.123.car_telemetry.0.
+// The car is "all" for the documents about the whole session.
+func Subject(prefix string, doc repository.Document) string {
+	car := "all"
+	if doc.CarIndex >= 0 {
+		car = strconv.Itoa(doc.CarIndex)
+	}
+	return prefix + "." + doc.SessionUID + "." + doc.Name + "." + car
+}
@@ -0,0 +1,183 @@
+package nats
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats-server/v2/test"
+	natsgo "github.com/nats-io/nats.go"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository"
+)
+
+// runServer runs a nats server on a random port, with jetstream when enabled
+func runServer(t *testing.T, jetStream bool) *server.Server {
+	opts := test.DefaultTestOptions
+	opts.Port = -1
+	if jetStream {
+		opts.JetStream = true
+		opts.StoreDir = t.TempDir()
+	}
+	s := test.RunServer(&opts)
+	t.Cleanup(s.Shutdown)
+	return s
+}
+
+func connect(t *testing.T, s *server.Server) *natsgo.Conn {
+	conn, err := natsgo.Connect(s.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(conn.Close)
+	return conn
+}
+
+func store(t *testing.T, n *NATS, docs ...string) {
+	t.Helper()
+
+	for _, doc := range docs {
+		if err := n.Store(context.Background(), bytes.NewReader([]byte(doc))); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestSubject(t *testing.T) {
+	tests := []struct {
+		doc     repository.Document
+		subject string
+	}{
+		{repository.Document{Name: "car_telemetry", SessionUID: "123", CarIndex: 0}, "f1.123.car_telemetry.0"},
+		{repository.Document{Name: "lap", SessionUID: "123", CarIndex: 19}, "f1.123.lap.19"},
+		{repository.Document{Name: "session", SessionUID: "123", CarIndex: -1}, "f1.123.session.all"},
+		{repository.Document{Name: "timing_tower", SessionUID: "123", CarIndex: -1}, "f1.123.timing_tower.all"},
+	}
+	for _, test := range tests {
+		if subject := Subject("f1", test.doc); subject != test.subject {
+			t.Errorf("got subject %s, want %s", subject, test.subject)
+		}
+	}
+}
+
+func TestPublish(t *testing.T) {
+	s := runServer(t, false)
+	sub, err := connect(t, s).SubscribeSync("telemetry.>")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := DefaultConfig()
+	conf.URL = s.ClientURL()
+	conf.SubjectPrefix = "telemetry"
+	n, err := NewNATS(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store(t, n,
+		`{"Header":{"PacketID":6,"SessionUID":"42","PlayerCarIndex":2,"Source":{"Rig":"rig-1","Driver":"Tommy"}}}`,
+		`{"Header":{"PacketID":1,"SessionUID":"42","PlayerCarIndex":2}}`,
+		`{"Header":{"PacketID":2,"SessionUID":"42","PlayerCarIndex":2},"Type":"lap","CarIndex":7}`,
+		`{"Header":{"PacketID":2,"SessionUID":"42","PlayerCarIndex":2},"Type":"timing_tower"}`,
+	)
+	if err := n.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		subject string
+		rig     string
+		driver  string
+	}{
+		{"telemetry.42.car_telemetry.2", "rig-1", "Tommy"},
+		{"telemetry.42.session.all", "", ""},
+		{"telemetry.42.lap.7", "", ""},
+		{"telemetry.42.timing_tower.all", "", ""},
+	}
+	for _, test := range tests {
+		msg, err := sub.NextMsg(time.Second)
+		if err != nil {
+			t.Fatalf("could not receive message on %s: %v", test.subject, err)
+		}
+		if msg.Subject != test.subject {
+			t.Errorf("got subject %s, want %s", msg.Subject, test.subject)
+		}
+		if rig := msg.Header.Get("Rig"); rig != test.rig {
+			t.Errorf("%s: got rig %q, want %q", test.subject, rig, test.rig)
+		}
+		if driver := msg.Header.Get("Driver"); driver != test.driver {
+			t.Errorf("%s: got driver %q, want %q", test.subject, driver, test.driver)
+		}
+	}
+}
+
+func TestStream(t *testing.T) {
+	s := runServer(t, true)
+	js, err := connect(t, s).JetStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := DefaultConfig()
+	conf.URL = s.ClientURL()
+	conf.Stream = "F1"
+	conf.MaxAge = time.Hour
+	n, err := NewNATS(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the stream is created when missing
+	info, err := js.StreamInfo("F1")
+	if err != nil {
+		t.Fatalf("the stream was not created: %v", err)
+	}
+	if len(info.Config.Subjects) != 1 || info.Config.Subjects[0] != "f1.>" {
+		t.Errorf("got stream subjects %v, want [f1.>]", info.Config.Subjects)
+	}
+	if info.Config.MaxAge != time.Hour {
+		t.Errorf("got stream max age %s, want 1h", info.Config.MaxAge)
+	}
+
+	store(t, n,
+		`{"Header":{"PacketID":6,"SessionUID":"42","PlayerCarIndex":2}}`,
+		`{"Header":{"PacketID":1,"SessionUID":"42","PlayerCarIndex":2}}`,
+	)
+	if err := n.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	sub, err := js.SubscribeSync("f1.42.session.all", natsgo.DeliverAll())
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := sub.NextMsg(time.Second)
+	if err != nil {
+		t.Fatalf("the session was not persisted: %v", err)
+	}
+	if string(msg.Data) != `{"Header":{"PacketID":1,"SessionUID":"42","PlayerCarIndex":2}}` {
+		t.Errorf("got persisted document %s", msg.Data)
+	}
+	if info, err = js.StreamInfo("F1"); err != nil {
+		t.Fatal(err)
+	}
+	if info.State.Msgs != 2 {
+		t.Errorf("got %d messages in the stream, want 2", info.State.Msgs)
+	}
+
+	// an existing stream is left as is
+	conf.MaxAge = 2 * time.Hour
+	n, err = NewNATS(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer n.Close()
+	if info, err = js.StreamInfo("F1"); err != nil {
+		t.Fatal(err)
+	}
+	if info.Config.MaxAge != time.Hour || info.State.Msgs != 2 {
+		t.Errorf("the existing stream was changed: max age %s, %d messages", info.Config.MaxAge, info.State.Msgs)
+	}
+}
@@ -0,0 +1,21 @@
+package postgres
+
+import "time"
+
+type Config struct {
+	URL string // Connection string, ie: postgres://f1:f1@localhost:5432/f1?sslmode=disable
+
+	// Timescale turns the telemetry table into a TimescaleDB hypertable, the extension has to be available
+	Timescale bool
+
+	BatchSize     int           // Number of rows buffered before being written. Default: 5000.
+	FlushInterval time.Duration // Maximum time a row is buffered before being written. Default: 1s.
+}
+
+func DefaultConfig() Config {
+	return Config{
+		URL:           "postgres://f1:f1@localhost:5432/f1?sslmode=disable",
+		BatchSize:     5000,
+		FlushInterval: time.Second,
+	}
+}
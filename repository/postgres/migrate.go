@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// migrations are applied in the order of their version, the number prefixing their file name
+//
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// migrationLock is the advisory lock held while migrating, several ingesters can start together
+const migrationLock = 2020
+
+// migrate applies the migrations not applied yet, each in its own transaction
+func migrate(ctx context.Context, db *sql.DB, timescale bool) error {
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		name       TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`)
+	if err != nil {
+		return errors.Wrap(err, "could not create schema_migrations")
+	}
+
+	entries, err := migrations.ReadDir("migrations")
+	if err != nil {
+		return errors.Wrap(err, "could not list migrations")
+	}
+	names := []string{}
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		version, err := strconv.Atoi(strings.SplitN(name, "_", 2)[0])
+		if err != nil {
+			return errors.Wrapf(err, "invalid migration version %s", name)
+		}
+		query, err := migrations.ReadFile("migrations/" + name)
+		if err != nil {
+			return errors.Wrapf(err, "could not read migration %s", name)
+		}
+		if err := apply(ctx, db, version, name, string(query)); err != nil {
+			return err
+		}
+	}
+
+	if timescale {
+		if _, err := db.ExecContext(ctx, "CREATE EXTENSION IF NOT EXISTS timescaledb"); err != nil {
+			return errors.Wrap(err, "could not create timescaledb extension")
+		}
+		_, err := db.ExecContext(ctx, "SELECT create_hypertable('telemetry', 'time', if_not_exists => TRUE, migrate_data => TRUE)")
+		if err != nil {
+			return errors.Wrap(err, "could not create telemetry hypertable")
+		}
+	}
+	return nil
+}
+
+// apply applies a migration unless it is already applied
+func apply(ctx context.Context, db *sql.DB, version int, name string, query string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrapf(err, "could not begin migration %s", name)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", migrationLock); err != nil {
+		return errors.Wrapf(err, "could not lock migration %s", name)
+	}
+
+	var applied bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = $1)", version).Scan(&applied)
+	if err != nil {
+		return errors.Wrapf(err, "could not check migration %s", name)
+	}
+	if applied {
+		return nil
+	}
+
+	logrus.Infof("applying postgres migration %s", name)
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return errors.Wrapf(err, "could not apply migration %s", name)
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name) VALUES ($1, $2)", version, name); err != nil {
+		return errors.Wrapf(err, "could not record migration %s", name)
+	}
+	return errors.Wrapf(tx.Commit(), "could not commit migration %s", name)
+}
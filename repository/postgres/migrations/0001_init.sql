@@ -0,0 +1,118 @@
+CREATE TABLE sessions (
+    session_uid       NUMERIC(20, 0) NOT NULL,
+    rig               TEXT NOT NULL,
+    driver            TEXT NOT NULL,
+    track_id          SMALLINT NOT NULL,
+    formula           SMALLINT NOT NULL,
+    session_type      SMALLINT NOT NULL,
+    total_laps        SMALLINT NOT NULL,
+    track_length      INTEGER NOT NULL,
+    weather           SMALLINT NOT NULL,
+    track_temperature SMALLINT NOT NULL,
+    air_temperature   SMALLINT NOT NULL,
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at        TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (session_uid, rig)
+);
+
+CREATE TABLE participants (
+    session_uid   NUMERIC(20, 0) NOT NULL,
+    car_index     SMALLINT NOT NULL,
+    name          TEXT NOT NULL,
+    driver_id     SMALLINT NOT NULL,
+    team_id       SMALLINT NOT NULL,
+    race_number   SMALLINT NOT NULL,
+    nationality   SMALLINT NOT NULL,
+    ai_controlled BOOLEAN NOT NULL,
+    updated_at    TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (session_uid, car_index)
+);
+
+CREATE TABLE laps (
+    session_uid          NUMERIC(20, 0) NOT NULL,
+    car_index            SMALLINT NOT NULL,
+    lap_num              SMALLINT NOT NULL,
+    lap_time             REAL NOT NULL,
+    sector1_time         REAL,
+    sector2_time         REAL,
+    sector3_time         REAL,
+    valid                BOOLEAN NOT NULL,
+    pit_lap              BOOLEAN NOT NULL,
+    position             SMALLINT NOT NULL,
+    actual_tyre_compound SMALLINT NOT NULL,
+    visual_tyre_compound SMALLINT NOT NULL,
+    tyres_age_laps       SMALLINT NOT NULL,
+    completed_at         TIMESTAMPTZ NOT NULL
+);
+CREATE UNIQUE INDEX laps_session_car ON laps (session_uid, car_index, lap_num);
+
+CREATE TABLE stints (
+    session_uid          NUMERIC(20, 0) NOT NULL,
+    car_index            SMALLINT NOT NULL,
+    stint_number         INTEGER NOT NULL,
+    start_lap            SMALLINT NOT NULL,
+    end_lap              SMALLINT NOT NULL,
+    num_laps             INTEGER NOT NULL,
+    actual_tyre_compound SMALLINT NOT NULL,
+    visual_tyre_compound SMALLINT NOT NULL,
+    tyres_age_at_start   SMALLINT NOT NULL,
+    degradation_rate     REAL NOT NULL,
+    lap_time_trend       REAL NOT NULL,
+    best_lap_time        REAL,
+    average_lap_time     REAL,
+    ended_at             TIMESTAMPTZ NOT NULL
+);
+CREATE UNIQUE INDEX stints_session_car ON stints (session_uid, car_index, stint_number);
+
+CREATE TABLE stint_laps (
+    session_uid           NUMERIC(20, 0) NOT NULL,
+    car_index             SMALLINT NOT NULL,
+    stint_number          INTEGER NOT NULL,
+    lap_num               SMALLINT NOT NULL,
+    lap_time              REAL NOT NULL,
+    pit_lap               BOOLEAN NOT NULL,
+    rear_left_tyre_wear   SMALLINT NOT NULL,
+    rear_right_tyre_wear  SMALLINT NOT NULL,
+    front_left_tyre_wear  SMALLINT NOT NULL,
+    front_right_tyre_wear SMALLINT NOT NULL
+);
+CREATE UNIQUE INDEX stint_laps_session_car ON stint_laps (session_uid, car_index, stint_number, lap_num);
+
+CREATE TABLE telemetry (
+    time                                 TIMESTAMPTZ NOT NULL,
+    session_uid                          NUMERIC(20, 0) NOT NULL,
+    session_time                         REAL NOT NULL,
+    frame_identifier                     BIGINT NOT NULL,
+    car_index                            SMALLINT NOT NULL,
+    speed                                INTEGER NOT NULL,
+    throttle                             REAL,
+    steer                                REAL,
+    brake                                REAL,
+    clutch                               SMALLINT NOT NULL,
+    gear                                 SMALLINT NOT NULL,
+    engine_rpm                           INTEGER NOT NULL,
+    drs                                  BOOLEAN NOT NULL,
+    rev_lights_percent                   SMALLINT NOT NULL,
+    rear_left_brake_temperature          INTEGER NOT NULL,
+    rear_right_brake_temperature         INTEGER NOT NULL,
+    front_left_brake_temperature         INTEGER NOT NULL,
+    front_right_brake_temperature        INTEGER NOT NULL,
+    rear_left_tyre_surface_temperature   SMALLINT NOT NULL,
+    rear_right_tyre_surface_temperature  SMALLINT NOT NULL,
+    front_left_tyre_surface_temperature  SMALLINT NOT NULL,
+    front_right_tyre_surface_temperature SMALLINT NOT NULL,
+    rear_left_tyre_inner_temperature     SMALLINT NOT NULL,
+    rear_right_tyre_inner_temperature    SMALLINT NOT NULL,
+    front_left_tyre_inner_temperature    SMALLINT NOT NULL,
+    front_right_tyre_inner_temperature   SMALLINT NOT NULL,
+    engine_temperature                   INTEGER NOT NULL,
+    rear_left_tyre_pressure              REAL,
+    rear_right_tyre_pressure             REAL,
+    front_left_tyre_pressure             REAL,
+    front_right_tyre_pressure            REAL,
+    rear_left_surface_type               SMALLINT NOT NULL,
+    rear_right_surface_type              SMALLINT NOT NULL,
+    front_left_surface_type              SMALLINT NOT NULL,
+    front_right_surface_type             SMALLINT NOT NULL
+);
+CREATE INDEX telemetry_session_car ON telemetry (session_uid, car_index, time);
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/relational"
+)
+
+// Postgres writes the documents to relational tables: sessions, participants, laps, stints and telemetry.
+// The rows are buffered and written in batches, with COPY for the tables rows are only appended to.
+type Postgres struct {
+	db   *sql.DB
+	conf Config
+
+	writer *relational.Writer
+}
+
+func NewPostgres(conf Config) (*Postgres, error) {
+	defaults := DefaultConfig()
+	if conf.BatchSize <= 0 {
+		conf.BatchSize = defaults.BatchSize
+	}
+	if conf.FlushInterval <= 0 {
+		conf.FlushInterval = defaults.FlushInterval
+	}
+
+	db, err := sql.Open("postgres", conf.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open postgres")
+	}
+
+	ctx := context.Background()
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "could not reach postgres")
+	}
+	if err := migrate(ctx, db, conf.Timescale); err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "could not migrate postgres")
+	}
+
+	p := &Postgres{
+		db:   db,
+		conf: conf,
+	}
+	p.writer = relational.NewWriter("postgres", relational.NewConverter(0), conf.BatchSize, conf.FlushInterval, p.write)
+
+	return p, nil
+}
+
+// Store converts the document to rows and buffers them, the batch is written once full
+func (p *Postgres) Store(ctx context.Context, body *bytes.Reader) error {
+	if p == nil {
+		return errors.New("postgres is not initialized")
+	}
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return errors.Wrap(err, "could not read document")
+	}
+
+	return p.writer.Store(ctx, data)
+}
+
+// Close writes the rows still buffered and closes the connections
+func (p *Postgres) Close() error {
+	err := p.writer.Close()
+
+	if closeErr := p.db.Close(); closeErr != nil && err == nil {
+		err = errors.Wrap(closeErr, "could not close postgres")
+	}
+	return err
+}
+
+// write writes a batch in a single transaction
+func (p *Postgres) write(ctx context.Context, batch *relational.Batch) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not begin postgres transaction")
+	}
+	defer tx.Rollback()
+
+	for _, table := range relational.AllTables {
+		rows := batch.Rows(table)
+		if len(rows) == 0 {
+			continue
+		}
+		if len(table.Key) > 0 {
+			err = upsert(ctx, tx, table, rows)
+		} else {
+			err = copyRows(ctx, tx, table, rows)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return errors.Wrap(tx.Commit(), "could not commit postgres transaction")
+}
+
+// copyRows appends the rows to a table with COPY
+func copyRows(ctx context.Context, tx *sql.Tx, table *relational.Table, rows []relational.Row) error {
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn(table.Name, table.Columns...))
+	if err != nil {
+		return errors.Wrapf(err, "could not prepare copy to %s", table.Name)
+	}
+	defer stmt.Close()
+
+	for _, row := range rows {
+		if _, err := stmt.ExecContext(ctx, row...); err != nil {
+			return errors.Wrapf(err, "could not copy row to %s", table.Name)
+		}
+	}
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return errors.Wrapf(err, "could not copy rows to %s", table.Name)
+	}
+	return nil
+}
+
+// upsert inserts the rows of a table, replacing the rows with the same key
+func upsert(ctx context.Context, tx *sql.Tx, table *relational.Table, rows []relational.Row) error {
+	stmt, err := tx.PrepareContext(ctx, upsertQuery(table))
+	if err != nil {
+		return errors.Wrapf(err, "could not prepare upsert to %s", table.Name)
+	}
+	defer stmt.Close()
+
+	for _, row := range rows {
+		if _, err := stmt.ExecContext(ctx, row...); err != nil {
+			return errors.Wrapf(err, "could not upsert row to %s", table.Name)
+		}
+	}
+	return nil
+}
+
+// upsertQuery returns the INSERT ... ON CONFLICT query of a table with a key
+func upsertQuery(table *relational.Table) string {
+	placeholders := make([]string, len(table.Columns))
+	updates := []string{}
+	for i, column := range table.Columns {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		if !table.IsKey(column) {
+			updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+		}
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s) DO UPDATE SET %s",
+		table.Name, strings.Join(table.Columns, ", "), strings.Join(placeholders, ", "),
+		strings.Join(table.Key, ", "), strings.Join(updates, ", "))
+}
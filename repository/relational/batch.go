@@ -0,0 +1,79 @@
+package relational
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Batch holds the rows converted from documents until they are written
+type Batch struct {
+	rows map[string][]Row          // Rows of every table, by table name
+	keys map[string]map[string]int // Index of the row of every key, for the tables with a key
+	size int
+}
+
+// NewBatch creates an empty batch
+func NewBatch() *Batch {
+	return &Batch{
+		rows: map[string][]Row{},
+		keys: map[string]map[string]int{},
+	}
+}
+
+// Add adds a row to a table, it replaces the row with the same key if the table has one
+func (b *Batch) Add(table *Table, row Row) {
+	if len(table.Key) == 0 {
+		b.rows[table.Name] = append(b.rows[table.Name], row)
+		b.size++
+		return
+	}
+
+	parts := make([]string, len(table.Key))
+	for i, column := range table.Key {
+		parts[i] = fmt.Sprint(row[table.index(column)])
+	}
+	key := strings.Join(parts, "/")
+
+	keys, ok := b.keys[table.Name]
+	if !ok {
+		keys = map[string]int{}
+		b.keys[table.Name] = keys
+	}
+	if i, ok := keys[key]; ok {
+		b.rows[table.Name][i] = row
+		return
+	}
+	keys[key] = len(b.rows[table.Name])
+	b.rows[table.Name] = append(b.rows[table.Name], row)
+	b.size++
+}
+
+// Rows returns the rows of a table
+func (b *Batch) Rows(table *Table) []Row {
+	return b.rows[table.Name]
+}
+
+// Len returns the number of rows of the batch
+func (b *Batch) Len() int {
+	return b.size
+}
+
+// index returns the position of a column in the rows of the table
+func (t *Table) index(column string) int {
+	for i, c := range t.Columns {
+		if c == column {
+			return i
+		}
+	}
+	panic("unknown column " + column + " of table " + t.Name)
+}
+
+// IsKey returns whether a column is part of the key of the table
+func (t *Table) IsKey(column string) bool {
+	for _, c := range t.Key {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
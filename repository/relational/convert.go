@@ -0,0 +1,188 @@
+package relational
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+// Types of the analysis documents converted to rows
+const (
+	lapType   = "lap"
+	stintType = "stint"
+)
+
+// envelope is the part of every document telling how to convert it
+type envelope struct {
+	Header models.Header
+	Type   string
+}
+
+// maxCars is the number of cars the latest telemetry time is kept for when decimating
+const maxCars = 22 * 100
+
+// Converter converts the documents to rows: the session, participants, lap, stint and car telemetry
+// documents fill the tables, the other documents are left out.
+type Converter struct {
+	// telemetryInterval is the minimum session time between two telemetry rows of a car, 0 keeps every row
+	telemetryInterval float64
+	telemetryTimes    map[string]float64 // Session time of the latest telemetry row of every car
+}
+
+// NewConverter creates a converter, the telemetry is decimated to a row every telemetryInterval of session time
+func NewConverter(telemetryInterval time.Duration) *Converter {
+	return &Converter{
+		telemetryInterval: telemetryInterval.Seconds(),
+		telemetryTimes:    map[string]float64{},
+	}
+}
+
+// Convert adds the rows of a json document to the batch
+func (c *Converter) Convert(batch *Batch, body []byte) error {
+	doc := envelope{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return errors.Wrap(err, "could not decode document")
+	}
+
+	switch doc.Type {
+	case "":
+	case lapType:
+		lap := models.Lap{}
+		if err := json.Unmarshal(body, &lap); err != nil {
+			return errors.Wrap(err, "could not decode lap")
+		}
+		c.lap(batch, &lap)
+		return nil
+	case stintType:
+		stint := models.Stint{}
+		if err := json.Unmarshal(body, &stint); err != nil {
+			return errors.Wrap(err, "could not decode stint")
+		}
+		c.stint(batch, &stint)
+		return nil
+	default:
+		return nil
+	}
+
+	switch f1packet.PacketType(doc.Header.PacketID) {
+	case f1packet.SessionPacket:
+		session := models.SessionData{}
+		if err := json.Unmarshal(body, &session); err != nil {
+			return errors.Wrap(err, "could not decode session")
+		}
+		c.session(batch, &session)
+
+	case f1packet.ParticipantsPacket:
+		participants := models.ParticipantsData{}
+		if err := json.Unmarshal(body, &participants); err != nil {
+			return errors.Wrap(err, "could not decode participants")
+		}
+		c.participants(batch, &participants)
+
+	case f1packet.CarTelemetryPacket:
+		telemetry := models.CarTelemetryData{}
+		if err := json.Unmarshal(body, &telemetry); err != nil {
+			return errors.Wrap(err, "could not decode car telemetry")
+		}
+		c.telemetry(batch, &telemetry)
+	}
+	return nil
+}
+
+func (c *Converter) session(batch *Batch, s *models.SessionData) {
+	batch.Add(&Sessions, Row{
+		// the address names the rigs not configured
+		s.Header.SessionUID, s.Header.Source.Key(), s.Header.Source.Driver,
+		int64(s.TrackID), int64(s.Formula), int64(s.SessionType), int64(s.TotalLaps), int64(s.TrackLength),
+		int64(s.Weather), int64(s.TrackTemperature), int64(s.AirTemperature),
+		s.Timestamp,
+	})
+}
+
+func (c *Converter) participants(batch *Batch, p *models.ParticipantsData) {
+	d := p.Participants
+	batch.Add(&Participants, Row{
+		p.Header.SessionUID, int64(p.Header.PlayerCarIndex),
+		// the names of the raw packets are padded with null characters
+		strings.TrimRight(d.Name, "\x00"), int64(d.DriverID), int64(d.TeamID), int64(d.RaceNumber), int64(d.Nationality), d.AiControlled == 1,
+		p.Timestamp,
+	})
+}
+
+func (c *Converter) lap(batch *Batch, l *models.Lap) {
+	batch.Add(&Laps, Row{
+		l.Header.SessionUID, int64(l.CarIndex), int64(l.LapNum),
+		float64(l.LapTime), nullable(float64(l.Sector1Time)), nullable(float64(l.Sector2Time)), nullable(float64(l.Sector3Time)),
+		l.Valid, l.PitLap, int64(l.Position),
+		int64(l.ActualTyreCompound), int64(l.VisualTyreCompound), int64(l.TyresAgeLaps),
+		l.Timestamp,
+	})
+}
+
+func (c *Converter) stint(batch *Batch, s *models.Stint) {
+	batch.Add(&Stints, Row{
+		s.Header.SessionUID, int64(s.CarIndex), int64(s.StintNumber),
+		int64(s.StartLap), int64(s.EndLap), int64(s.NumLaps),
+		int64(s.ActualTyreCompound), int64(s.VisualTyreCompound), int64(s.TyresAgeAtStart),
+		float64(s.DegradationRate), float64(s.LapTimeTrend), nullable(float64(s.BestLapTime)), nullable(float64(s.AverageLapTime)),
+		s.Timestamp,
+	})
+	for _, l := range s.Laps {
+		batch.Add(&StintLaps, Row{
+			s.Header.SessionUID, int64(s.CarIndex), int64(s.StintNumber), int64(l.LapNum),
+			float64(l.LapTime), l.PitLap,
+			int64(l.RearLeftTyresWear), int64(l.RearRightTyresWear), int64(l.FrontLeftTyresWear), int64(l.FrontRightTyresWear),
+		})
+	}
+}
+
+func (c *Converter) telemetry(batch *Batch, t *models.CarTelemetryData) {
+	if c.telemetryInterval > 0 {
+		key := t.Header.SessionUID + "/" + strconv.Itoa(int(t.Header.PlayerCarIndex))
+		sessionTime := float64(t.Header.SessionTime)
+		// the documents are not stored in order, a row close to the latest one either way is left out
+		if latest, ok := c.telemetryTimes[key]; ok && math.Abs(sessionTime-latest) < c.telemetryInterval {
+			return
+		}
+		if len(c.telemetryTimes) >= maxCars {
+			c.telemetryTimes = map[string]float64{}
+		}
+		c.telemetryTimes[key] = sessionTime
+	}
+
+	d := t.CarTelemetryData
+	batch.Add(&Telemetry, Row{
+		t.Timestamp, t.Header.SessionUID, float64(t.Header.SessionTime), int64(t.Header.FrameIdentifier), int64(t.Header.PlayerCarIndex),
+		int64(d.Speed), number(d.Throttle), number(d.Steer), number(d.Brake), int64(d.Clutch), int64(d.Gear), int64(d.EngineRPM), d.Drs == 1, int64(d.RevLightsPercent),
+		int64(d.RearLeftBrakesTemperature), int64(d.RearRightBrakesTemperature), int64(d.FrontLeftBrakesTemperature), int64(d.FrontRightBrakesTemperature),
+		int64(d.RearLeftTyresSurfaceTemperature), int64(d.RearRightTyresSurfaceTemperature), int64(d.FrontLeftTyresSurfaceTemperature), int64(d.FrontRightTyresSurfaceTemperature),
+		int64(d.RearLeftTyresInnerTemperature), int64(d.RearRightTyresInnerTemperature), int64(d.FrontLeftTyresInnerTemperature), int64(d.FrontRightTyresInnerTemperature),
+		int64(d.EngineTemperature),
+		number(d.RearLeftTyresPressure), number(d.RearRightTyresPressure), number(d.FrontLeftTyresPressure), number(d.FrontRightTyresPressure),
+		int64(d.RearLeftSurfaceType), int64(d.RearRightSurfaceType), int64(d.FrontLeftSurfaceType), int64(d.FrontRightSurfaceType),
+	})
+}
+
+// number parses the telemetry formatted as a string, ie: the throttle
+func number(s string) interface{} {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil
+	}
+	return f
+}
+
+// nullable returns nil for the times not known yet
+func nullable(f float64) interface{} {
+	if f <= 0 {
+		return nil
+	}
+	return f
+}
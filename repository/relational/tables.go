@@ -0,0 +1,87 @@
+package relational
+
+// Table is a table the documents are converted to, the values of its rows follow the order of its columns
+type Table struct {
+	Name    string
+	Columns []string
+	Key     []string // Columns identifying a row, the latest row replaces the previous one. Rows are appended when empty
+}
+
+// Row holds the values of a row in the order of the columns of its table
+type Row []interface{}
+
+// Tables converted from the documents, in the order they are written
+var (
+	Sessions = Table{
+		Name: "sessions",
+		Columns: []string{
+			"session_uid", "rig", "driver",
+			"track_id", "formula", "session_type", "total_laps", "track_length",
+			"weather", "track_temperature", "air_temperature",
+			"updated_at",
+		},
+		// the rigs of an online session share its UID, every rig has its own row
+		Key: []string{"session_uid", "rig"},
+	}
+
+	Participants = Table{
+		Name: "participants",
+		Columns: []string{
+			"session_uid", "car_index",
+			"name", "driver_id", "team_id", "race_number", "nationality", "ai_controlled",
+			"updated_at",
+		},
+		Key: []string{"session_uid", "car_index"},
+	}
+
+	Laps = Table{
+		Name: "laps",
+		Columns: []string{
+			"session_uid", "car_index", "lap_num",
+			"lap_time", "sector1_time", "sector2_time", "sector3_time",
+			"valid", "pit_lap", "position",
+			"actual_tyre_compound", "visual_tyre_compound", "tyres_age_laps",
+			"completed_at",
+		},
+		Key: []string{"session_uid", "car_index", "lap_num"},
+	}
+
+	Stints = Table{
+		Name: "stints",
+		Columns: []string{
+			"session_uid", "car_index", "stint_number",
+			"start_lap", "end_lap", "num_laps",
+			"actual_tyre_compound", "visual_tyre_compound", "tyres_age_at_start",
+			"degradation_rate", "lap_time_trend", "best_lap_time", "average_lap_time",
+			"ended_at",
+		},
+		Key: []string{"session_uid", "car_index", "stint_number"},
+	}
+
+	StintLaps = Table{
+		Name: "stint_laps",
+		Columns: []string{
+			"session_uid", "car_index", "stint_number", "lap_num",
+			"lap_time", "pit_lap",
+			"rear_left_tyre_wear", "rear_right_tyre_wear", "front_left_tyre_wear", "front_right_tyre_wear",
+		},
+		Key: []string{"session_uid", "car_index", "stint_number", "lap_num"},
+	}
+
+	Telemetry = Table{
+		Name: "telemetry",
+		Columns: []string{
+			"time", "session_uid", "session_time", "frame_identifier", "car_index",
+			"speed", "throttle", "steer", "brake", "clutch", "gear", "engine_rpm", "drs", "rev_lights_percent",
+			"rear_left_brake_temperature", "rear_right_brake_temperature", "front_left_brake_temperature", "front_right_brake_temperature",
+			"rear_left_tyre_surface_temperature", "rear_right_tyre_surface_temperature", "front_left_tyre_surface_temperature", "front_right_tyre_surface_temperature",
+			"rear_left_tyre_inner_temperature", "rear_right_tyre_inner_temperature", "front_left_tyre_inner_temperature", "front_right_tyre_inner_temperature",
+			"engine_temperature",
+			"rear_left_tyre_pressure", "rear_right_tyre_pressure", "front_left_tyre_pressure", "front_right_tyre_pressure",
+			"rear_left_surface_type", "rear_right_surface_type", "front_left_surface_type", "front_right_surface_type",
+		},
+	}
+
+	// AllTables lists every table in the order they are written
+	AllTables = []*Table{&Sessions, &Participants, &Laps, &Stints, &StintLaps, &Telemetry}
+)
@@ -0,0 +1,112 @@
+package relational
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// WriteFunc writes a batch to a database
+type WriteFunc func(ctx context.Context, batch *Batch) error
+
+// Writer converts the documents to rows and buffers them,
+// the batch is written once it holds batchSize rows and every flushInterval.
+type Writer struct {
+	name      string // Name of the database, for the logs
+	batchSize int
+	write     WriteFunc
+
+	mu        sync.Mutex
+	converter *Converter
+	batch     *Batch
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewWriter starts writing the rows converted by converter with write every flushInterval until closed
+func NewWriter(name string, converter *Converter, batchSize int, flushInterval time.Duration, write WriteFunc) *Writer {
+	w := &Writer{
+		name:      name,
+		batchSize: batchSize,
+		write:     write,
+		converter: converter,
+		batch:     NewBatch(),
+		done:      make(chan struct{}),
+	}
+
+	w.wg.Add(1)
+	go w.run(flushInterval)
+
+	return w
+}
+
+// Store converts a document to rows and buffers them, the batch is written once full
+func (w *Writer) Store(ctx context.Context, data []byte) error {
+	w.mu.Lock()
+	if err := w.converter.Convert(w.batch, data); err != nil {
+		w.mu.Unlock()
+		return errors.Wrap(err, "could not convert document to rows")
+	}
+	if w.batch.Len() < w.batchSize {
+		w.mu.Unlock()
+		return nil
+	}
+	batch := w.take()
+	w.mu.Unlock()
+
+	return w.write(ctx, batch)
+}
+
+// Close stops the flushes and writes the rows still buffered
+func (w *Writer) Close() error {
+	close(w.done)
+	w.wg.Wait()
+
+	w.mu.Lock()
+	batch := w.take()
+	w.mu.Unlock()
+	return w.flush(context.Background(), batch)
+}
+
+// run writes the buffered rows every interval until closed
+func (w *Writer) run(interval time.Duration) {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+			w.mu.Lock()
+			batch := w.take()
+			w.mu.Unlock()
+			if err := w.flush(context.Background(), batch); err != nil {
+				logrus.WithError(err).Errorf("could not write rows to %s", w.name)
+			}
+		}
+	}
+}
+
+// take returns the buffered rows and starts a new batch, w.mu has to be held
+func (w *Writer) take() *Batch {
+	if w.batch.Len() == 0 {
+		return nil
+	}
+	batch := w.batch
+	w.batch = NewBatch()
+	return batch
+}
+
+// flush writes a batch, if any
+func (w *Writer) flush(ctx context.Context, batch *Batch) error {
+	if batch == nil {
+		return nil
+	}
+	return w.write(ctx, batch)
+}
@@ -0,0 +1,21 @@
+package sqlite
+
+import "time"
+
+type Config struct {
+	Path string // Path of the database file, created when missing
+
+	TelemetryInterval time.Duration // Minimum session time between two telemetry rows of a car. Default: 100ms, 0 keeps every row.
+
+	BatchSize     int           // Number of rows buffered before being written. Default: 5000.
+	FlushInterval time.Duration // Maximum time a row is buffered before being written. Default: 1s.
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Path:              "f1.db",
+		TelemetryInterval: 100 * time.Millisecond,
+		BatchSize:         5000,
+		FlushInterval:     time.Second,
+	}
+}
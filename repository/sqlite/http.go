@@ -0,0 +1,137 @@
+package sqlite
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/api"
+)
+
+const (
+	// DefaultSessions is the number of sessions returned when no limit is given
+	DefaultSessions = 50
+	// MaxSamples is the maximum number of telemetry samples returned at once
+	MaxSamples = 100000
+)
+
+// SessionsHandler returns the latest sessions stored, limit gives their number ( default 50 )
+func SessionsHandler(s *SQLite) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			api.Error(w, http.StatusMethodNotAllowed, "method %s not allowed", req.Method)
+			return
+		}
+
+		limit := DefaultSessions
+		if value := req.URL.Query().Get("limit"); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil || n <= 0 {
+				api.Error(w, http.StatusBadRequest, "invalid limit %q", value)
+				return
+			}
+			limit = n
+		}
+
+		sessions, err := s.Sessions(req.Context(), limit)
+		if err != nil {
+			logrus.WithError(err).Error("could not query sessions")
+			api.Error(w, http.StatusInternalServerError, "could not query sessions")
+			return
+		}
+		api.JSON(w, http.StatusOK, sessions)
+	})
+}
+
+// LapsHandler returns the laps stored of the session given by the session query parameter, car restricts them to a car
+func LapsHandler(s *SQLite) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			api.Error(w, http.StatusMethodNotAllowed, "method %s not allowed", req.Method)
+			return
+		}
+
+		query := req.URL.Query()
+		session := query.Get("session")
+		if session == "" {
+			api.Error(w, http.StatusBadRequest, "a session is required")
+			return
+		}
+		car := -1
+		if value := query.Get("car"); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 || n > 21 {
+				api.Error(w, http.StatusBadRequest, "invalid car %q", value)
+				return
+			}
+			car = n
+		}
+
+		laps, err := s.Laps(req.Context(), session, car)
+		if err != nil {
+			logrus.WithError(err).Error("could not query laps")
+			api.Error(w, http.StatusInternalServerError, "could not query laps")
+			return
+		}
+		api.JSON(w, http.StatusOK, laps)
+	})
+}
+
+// TelemetryHandler returns the telemetry stored of a car given by the query parameters:
+// session, car, from and to ( session time in seconds, default the whole session ) and limit ( default and at most 100000 )
+func TelemetryHandler(s *SQLite) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			api.Error(w, http.StatusMethodNotAllowed, "method %s not allowed", req.Method)
+			return
+		}
+
+		query := req.URL.Query()
+		session := query.Get("session")
+		if session == "" {
+			api.Error(w, http.StatusBadRequest, "a session is required")
+			return
+		}
+		car, err := strconv.Atoi(query.Get("car"))
+		if err != nil || car < 0 || car > 21 {
+			api.Error(w, http.StatusBadRequest, "invalid car %q", query.Get("car"))
+			return
+		}
+		from, err := floatParam(query.Get("from"), 0)
+		if err != nil {
+			api.Error(w, http.StatusBadRequest, "invalid from %q", query.Get("from"))
+			return
+		}
+		to, err := floatParam(query.Get("to"), math.MaxFloat32)
+		if err != nil {
+			api.Error(w, http.StatusBadRequest, "invalid to %q", query.Get("to"))
+			return
+		}
+		limit := MaxSamples
+		if value := query.Get("limit"); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil || n <= 0 || n > MaxSamples {
+				api.Error(w, http.StatusBadRequest, "invalid limit %q", value)
+				return
+			}
+			limit = n
+		}
+
+		samples, err := s.Telemetry(req.Context(), session, car, from, to, limit)
+		if err != nil {
+			logrus.WithError(err).Error("could not query telemetry")
+			api.Error(w, http.StatusInternalServerError, "could not query telemetry")
+			return
+		}
+		api.JSON(w, http.StatusOK, samples)
+	})
+}
+
+func floatParam(value string, fallback float64) (float64, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	return strconv.ParseFloat(value, 64)
+}
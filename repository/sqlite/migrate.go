@@ -0,0 +1,75 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// migrations are applied in the order of their version, the number prefixing their file name.
+// The version of the latest migration applied is kept in the user_version of the database.
+//
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// migrate applies the migrations not applied yet, each in its own transaction
+func migrate(ctx context.Context, db *sql.DB) error {
+	var current int
+	if err := db.QueryRowContext(ctx, "PRAGMA user_version").Scan(&current); err != nil {
+		return errors.Wrap(err, "could not read database version")
+	}
+
+	entries, err := migrations.ReadDir("migrations")
+	if err != nil {
+		return errors.Wrap(err, "could not list migrations")
+	}
+	names := []string{}
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		version, err := strconv.Atoi(strings.SplitN(name, "_", 2)[0])
+		if err != nil {
+			return errors.Wrapf(err, "invalid migration version %s", name)
+		}
+		if version <= current {
+			continue
+		}
+		query, err := migrations.ReadFile("migrations/" + name)
+		if err != nil {
+			return errors.Wrapf(err, "could not read migration %s", name)
+		}
+		if err := apply(ctx, db, version, name, string(query)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply applies a migration and sets the version of the database
+func apply(ctx context.Context, db *sql.DB, version int, name string, query string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrapf(err, "could not begin migration %s", name)
+	}
+	defer tx.Rollback()
+
+	logrus.Infof("applying sqlite migration %s", name)
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return errors.Wrapf(err, "could not apply migration %s", name)
+	}
+	// pragmas do not take parameters
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("PRAGMA user_version = %d", version)); err != nil {
+		return errors.Wrapf(err, "could not record migration %s", name)
+	}
+	return errors.Wrapf(tx.Commit(), "could not commit migration %s", name)
+}
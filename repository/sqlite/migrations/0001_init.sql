@@ -0,0 +1,118 @@
+CREATE TABLE sessions (
+    session_uid       TEXT NOT NULL,
+    rig               TEXT NOT NULL,
+    driver            TEXT NOT NULL,
+    track_id          INTEGER NOT NULL,
+    formula           INTEGER NOT NULL,
+    session_type      INTEGER NOT NULL,
+    total_laps        INTEGER NOT NULL,
+    track_length      INTEGER NOT NULL,
+    weather           INTEGER NOT NULL,
+    track_temperature INTEGER NOT NULL,
+    air_temperature   INTEGER NOT NULL,
+    created_at        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at        DATETIME NOT NULL,
+    PRIMARY KEY (session_uid, rig)
+);
+
+CREATE TABLE participants (
+    session_uid   TEXT NOT NULL,
+    car_index     INTEGER NOT NULL,
+    name          TEXT NOT NULL,
+    driver_id     INTEGER NOT NULL,
+    team_id       INTEGER NOT NULL,
+    race_number   INTEGER NOT NULL,
+    nationality   INTEGER NOT NULL,
+    ai_controlled BOOLEAN NOT NULL,
+    updated_at    DATETIME NOT NULL,
+    PRIMARY KEY (session_uid, car_index)
+);
+
+CREATE TABLE laps (
+    session_uid          TEXT NOT NULL,
+    car_index            INTEGER NOT NULL,
+    lap_num              INTEGER NOT NULL,
+    lap_time             REAL NOT NULL,
+    sector1_time         REAL,
+    sector2_time         REAL,
+    sector3_time         REAL,
+    valid                BOOLEAN NOT NULL,
+    pit_lap              BOOLEAN NOT NULL,
+    position             INTEGER NOT NULL,
+    actual_tyre_compound INTEGER NOT NULL,
+    visual_tyre_compound INTEGER NOT NULL,
+    tyres_age_laps       INTEGER NOT NULL,
+    completed_at         DATETIME NOT NULL
+);
+CREATE UNIQUE INDEX laps_session_car ON laps (session_uid, car_index, lap_num);
+
+CREATE TABLE stints (
+    session_uid          TEXT NOT NULL,
+    car_index            INTEGER NOT NULL,
+    stint_number         INTEGER NOT NULL,
+    start_lap            INTEGER NOT NULL,
+    end_lap              INTEGER NOT NULL,
+    num_laps             INTEGER NOT NULL,
+    actual_tyre_compound INTEGER NOT NULL,
+    visual_tyre_compound INTEGER NOT NULL,
+    tyres_age_at_start   INTEGER NOT NULL,
+    degradation_rate     REAL NOT NULL,
+    lap_time_trend       REAL NOT NULL,
+    best_lap_time        REAL,
+    average_lap_time     REAL,
+    ended_at             DATETIME NOT NULL
+);
+CREATE UNIQUE INDEX stints_session_car ON stints (session_uid, car_index, stint_number);
+
+CREATE TABLE stint_laps (
+    session_uid           TEXT NOT NULL,
+    car_index             INTEGER NOT NULL,
+    stint_number          INTEGER NOT NULL,
+    lap_num               INTEGER NOT NULL,
+    lap_time              REAL NOT NULL,
+    pit_lap               BOOLEAN NOT NULL,
+    rear_left_tyre_wear   INTEGER NOT NULL,
+    rear_right_tyre_wear  INTEGER NOT NULL,
+    front_left_tyre_wear  INTEGER NOT NULL,
+    front_right_tyre_wear INTEGER NOT NULL
+);
+CREATE UNIQUE INDEX stint_laps_session_car ON stint_laps (session_uid, car_index, stint_number, lap_num);
+
+CREATE TABLE telemetry (
+    time                                 DATETIME NOT NULL,
+    session_uid                          TEXT NOT NULL,
+    session_time                         REAL NOT NULL,
+    frame_identifier                     INTEGER NOT NULL,
+    car_index                            INTEGER NOT NULL,
+    speed                                INTEGER NOT NULL,
+    throttle                             REAL,
+    steer                                REAL,
+    brake                                REAL,
+    clutch                               INTEGER NOT NULL,
+    gear                                 INTEGER NOT NULL,
+    engine_rpm                           INTEGER NOT NULL,
+    drs                                  BOOLEAN NOT NULL,
+    rev_lights_percent                   INTEGER NOT NULL,
+    rear_left_brake_temperature          INTEGER NOT NULL,
+    rear_right_brake_temperature         INTEGER NOT NULL,
+    front_left_brake_temperature         INTEGER NOT NULL,
+    front_right_brake_temperature        INTEGER NOT NULL,
+    rear_left_tyre_surface_temperature   INTEGER NOT NULL,
+    rear_right_tyre_surface_temperature  INTEGER NOT NULL,
+    front_left_tyre_surface_temperature  INTEGER NOT NULL,
+    front_right_tyre_surface_temperature INTEGER NOT NULL,
+    rear_left_tyre_inner_temperature     INTEGER NOT NULL,
+    rear_right_tyre_inner_temperature    INTEGER NOT NULL,
+    front_left_tyre_inner_temperature    INTEGER NOT NULL,
+    front_right_tyre_inner_temperature   INTEGER NOT NULL,
+    engine_temperature                   INTEGER NOT NULL,
+    rear_left_tyre_pressure              REAL,
+    rear_right_tyre_pressure             REAL,
+    front_left_tyre_pressure             REAL,
+    front_right_tyre_pressure            REAL,
+    rear_left_surface_type               INTEGER NOT NULL,
+    rear_right_surface_type              INTEGER NOT NULL,
+    front_left_surface_type              INTEGER NOT NULL,
+    front_right_surface_type             INTEGER NOT NULL
+);
+CREATE INDEX telemetry_session_car ON telemetry (session_uid, car_index, session_time);
@@ -0,0 +1,145 @@
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+)
+
+// Session is a session stored in the database
+type Session struct {
+	SessionUID      string
+	Rig             string
+	Driver          string
+	TrackID         int8
+	TrackName       string
+	Formula         uint8
+	SessionType     uint8
+	SessionTypeName string
+	TotalLaps       uint8
+	Weather         uint8
+	WeatherName     string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+
+	Laps        int     // Number of laps completed by every car
+	BestLapTime float32 // Best valid lap of the session in seconds, 0 if none
+}
+
+// Lap is a lap stored in the database
+type Lap struct {
+	CarIndex           uint8
+	Name               string // Participant name, empty when not known
+	LapNum             uint8
+	LapTime            float32
+	Sector1Time        float32 // Sector times in seconds, 0 when not known
+	Sector2Time        float32
+	Sector3Time        float32
+	Valid              bool
+	PitLap             bool
+	Position           uint8
+	ActualTyreCompound uint8
+	VisualTyreCompound uint8
+	TyresAgeLaps       uint8
+	CompletedAt        time.Time
+}
+
+// Sample is a telemetry row stored in the database
+type Sample struct {
+	Time        time.Time
+	SessionTime float32
+	Speed       uint16
+	Throttle    float32
+	Steer       float32
+	Brake       float32
+	Gear        int8
+	EngineRPM   uint16
+	Drs         bool
+}
+
+// Sessions returns the latest sessions, the latest updated first
+func (s *SQLite) Sessions(ctx context.Context, limit int) ([]Session, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT s.session_uid, s.rig, s.driver, s.track_id, s.formula, s.session_type, s.total_laps, s.weather, s.created_at, s.updated_at,
+			(SELECT COUNT(*) FROM laps l WHERE l.session_uid = s.session_uid),
+			(SELECT COALESCE(MIN(l.lap_time), 0) FROM laps l WHERE l.session_uid = s.session_uid AND l.valid)
+		FROM sessions s
+		ORDER BY s.updated_at DESC
+		LIMIT ?`, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not query sessions")
+	}
+	defer rows.Close()
+
+	sessions := []Session{}
+	for rows.Next() {
+		session := Session{}
+		err := rows.Scan(&session.SessionUID, &session.Rig, &session.Driver, &session.TrackID, &session.Formula, &session.SessionType,
+			&session.TotalLaps, &session.Weather, &session.CreatedAt, &session.UpdatedAt, &session.Laps, &session.BestLapTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read session")
+		}
+		session.TrackName = models.TrackName(session.TrackID)
+		session.SessionTypeName = models.SessionTypeName(session.SessionType)
+		session.WeatherName = models.WeatherName(session.Weather)
+		sessions = append(sessions, session)
+	}
+	return sessions, errors.Wrap(rows.Err(), "could not read sessions")
+}
+
+// Laps returns the laps of a session ordered by car and lap number, of every car when car is negative
+func (s *SQLite) Laps(ctx context.Context, session string, car int) ([]Lap, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT l.car_index, COALESCE(p.name, ''), l.lap_num, l.lap_time,
+			COALESCE(l.sector1_time, 0), COALESCE(l.sector2_time, 0), COALESCE(l.sector3_time, 0),
+			l.valid, l.pit_lap, l.position, l.actual_tyre_compound, l.visual_tyre_compound, l.tyres_age_laps, l.completed_at
+		FROM laps l
+		LEFT JOIN participants p ON p.session_uid = l.session_uid AND p.car_index = l.car_index
+		WHERE l.session_uid = ? AND (? < 0 OR l.car_index = ?)
+		ORDER BY l.car_index, l.lap_num`, session, car, car)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not query laps of session %s", session)
+	}
+	defer rows.Close()
+
+	laps := []Lap{}
+	for rows.Next() {
+		lap := Lap{}
+		err := rows.Scan(&lap.CarIndex, &lap.Name, &lap.LapNum, &lap.LapTime, &lap.Sector1Time, &lap.Sector2Time, &lap.Sector3Time,
+			&lap.Valid, &lap.PitLap, &lap.Position, &lap.ActualTyreCompound, &lap.VisualTyreCompound, &lap.TyresAgeLaps, &lap.CompletedAt)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read lap")
+		}
+		laps = append(laps, lap)
+	}
+	return laps, errors.Wrapf(rows.Err(), "could not read laps of session %s", session)
+}
+
+// Telemetry returns the telemetry of a car between two session times in seconds, at most limit samples
+func (s *SQLite) Telemetry(ctx context.Context, session string, car int, from, to float64, limit int) ([]Sample, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT time, session_time, speed, COALESCE(throttle, 0), COALESCE(steer, 0), COALESCE(brake, 0), gear, engine_rpm, drs
+		FROM telemetry
+		WHERE session_uid = ? AND car_index = ? AND session_time BETWEEN ? AND ?
+		ORDER BY session_time
+		LIMIT ?`, session, car, from, to, limit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not query telemetry of session %s", session)
+	}
+	defer rows.Close()
+
+	samples := []Sample{}
+	for rows.Next() {
+		sample := Sample{}
+		err := rows.Scan(&sample.Time, &sample.SessionTime, &sample.Speed, &sample.Throttle, &sample.Steer, &sample.Brake,
+			&sample.Gear, &sample.EngineRPM, &sample.Drs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read telemetry")
+		}
+		samples = append(samples, sample)
+	}
+	return samples, errors.Wrapf(rows.Err(), "could not read telemetry of session %s", session)
+}
@@ -0,0 +1,132 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	_ "modernc.org/sqlite"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/relational"
+)
+
+// pragmas are set on every connection: the write-ahead log lets the API read while the batches are written
+var pragmas = []string{"journal_mode(WAL)", "synchronous(NORMAL)", "busy_timeout(5000)"}
+
+// SQLite writes the documents to an embedded database: sessions, participants, laps, stints and decimated telemetry.
+// The rows are buffered and written in batches, one transaction per batch.
+type SQLite struct {
+	db   *sql.DB
+	conf Config
+
+	writer *relational.Writer
+}
+
+func NewSQLite(conf Config) (*SQLite, error) {
+	defaults := DefaultConfig()
+	if conf.Path == "" {
+		conf.Path = defaults.Path
+	}
+	if conf.BatchSize <= 0 {
+		conf.BatchSize = defaults.BatchSize
+	}
+	if conf.FlushInterval <= 0 {
+		conf.FlushInterval = defaults.FlushInterval
+	}
+
+	query := url.Values{"_pragma": pragmas}
+	db, err := sql.Open("sqlite", "file:"+conf.Path+"?"+query.Encode())
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open sqlite database %s", conf.Path)
+	}
+
+	ctx := context.Background()
+	if err := migrate(ctx, db); err != nil {
+		db.Close()
+		return nil, errors.Wrapf(err, "could not migrate sqlite database %s", conf.Path)
+	}
+
+	s := &SQLite{
+		db:   db,
+		conf: conf,
+	}
+	s.writer = relational.NewWriter("sqlite", relational.NewConverter(conf.TelemetryInterval), conf.BatchSize, conf.FlushInterval, s.write)
+
+	return s, nil
+}
+
+// Store converts the document to rows and buffers them, the batch is written once full
+func (s *SQLite) Store(ctx context.Context, body *bytes.Reader) error {
+	if s == nil {
+		return errors.New("sqlite is not initialized")
+	}
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return errors.Wrap(err, "could not read document")
+	}
+
+	return s.writer.Store(ctx, data)
+}
+
+// Close writes the rows still buffered and closes the database
+func (s *SQLite) Close() error {
+	err := s.writer.Close()
+
+	if closeErr := s.db.Close(); closeErr != nil && err == nil {
+		err = errors.Wrap(closeErr, "could not close sqlite database")
+	}
+	return err
+}
+
+// write writes a batch in a single transaction
+func (s *SQLite) write(ctx context.Context, batch *relational.Batch) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not begin sqlite transaction")
+	}
+	defer tx.Rollback()
+
+	for _, table := range relational.AllTables {
+		rows := batch.Rows(table)
+		if len(rows) == 0 {
+			continue
+		}
+
+		stmt, err := tx.PrepareContext(ctx, insertQuery(table))
+		if err != nil {
+			return errors.Wrapf(err, "could not prepare insert to %s", table.Name)
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row...); err != nil {
+				stmt.Close()
+				return errors.Wrapf(err, "could not insert row to %s", table.Name)
+			}
+		}
+		stmt.Close()
+	}
+
+	return errors.Wrap(tx.Commit(), "could not commit sqlite transaction")
+}
+
+// insertQuery returns the INSERT query of a table, the rows with the same key are replaced
+func insertQuery(table *relational.Table) string {
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(table.Columns)), ", ")
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table.Name, strings.Join(table.Columns, ", "), placeholders)
+	if len(table.Key) == 0 {
+		return query
+	}
+
+	updates := []string{}
+	for _, column := range table.Columns {
+		if !table.IsKey(column) {
+			updates = append(updates, fmt.Sprintf("%s = excluded.%s", column, column))
+		}
+	}
+	return fmt.Sprintf("%s ON CONFLICT (%s) DO UPDATE SET %s", query, strings.Join(table.Key, ", "), strings.Join(updates, ", "))
+}
@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"time"
 
 	"github.com/mailgun/holster/v3/syncutil"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis"
 	"github.com/Tommy-42/f1-2020-go-telemetry/models"
 	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
 	"github.com/Tommy-42/f1-2020-go-telemetry/repository"
@@ -16,75 +18,157 @@ import (
 
 const (
 	F1Version uint16 = 2020
+
+	// sourceIdleTimeout is the time after which a source that sent no packet is forgotten
+	sourceIdleTimeout = 5 * time.Minute
 )
 
+// Packet is a raw UDP packet along with the source it was received from
+type Packet struct {
+	Source models.Source
+	Data   []byte
+}
+
 // HandlerPacket ...
 type HandlerPacket struct {
 	repo repository.Repository
 
-	handlerChan chan []byte
+	handlerChan chan Packet
+	fanOut      *syncutil.FanOut
+
+	// sources is only accessed by the dispatching goroutine
+	sources map[string]*source
+
+	// analyzers created for every session of every source
+	factories []analysis.Factory
 }
 
 // NewHandlerPacket ...
-func NewHandlerPacket(repo repository.Repository) *HandlerPacket {
+func NewHandlerPacket(repo repository.Repository, factories ...analysis.Factory) *HandlerPacket {
 	h := &HandlerPacket{
 		repo:        repo,
-		handlerChan: make(chan []byte, 10000),
+		handlerChan: make(chan Packet, 10000),
+		fanOut:      syncutil.NewFanOut(100),
+		sources:     map[string]*source{},
+		factories:   factories,
 	}
 
-	ctx := context.Background()
-	go func() {
-
-		fanOut := syncutil.NewFanOut(100)
+	go h.dispatch(context.Background())
 
-		for pkt := range h.handlerChan {
-			fanOut.Run(func(cast interface{}) error {
-				// avoid data race
-				packet := cast.([]byte)
+	return h
+}
 
-				// decode packet to the correct one
-				data, err := h.decodePacket(ctx, packet)
-				if err != nil {
-					if errors.Is(err, ErrIgnorePacket) {
-						return nil
-					}
-					logrus.WithError(err).Errorf("found error while decoding packet")
-					return err
+// dispatch hands the packets over to the goroutine of their source, and forgets the sources gone quiet
+func (h *HandlerPacket) dispatch(ctx context.Context) {
+	ticker := time.NewTicker(sourceIdleTimeout / 5)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case pkt, ok := <-h.handlerChan:
+			if !ok {
+				return
+			}
+			src, ok := h.sources[pkt.Source.Key()]
+			if !ok {
+				logrus.WithFields(sourceFields(pkt.Source)).Info("receiving packets from a new source")
+				src = newSource(pkt.Source, h.factories)
+				h.sources[pkt.Source.Key()] = src
+				go h.process(ctx, src)
+			}
+			src.lastPacket = time.Now()
+			// a source not keeping up loses packets rather than holding the other sources back
+			select {
+			case src.packets <- pkt.Data:
+			default:
+				if src.dropped == 0 {
+					logrus.WithFields(sourceFields(src.Source)).Warn("the packets of the source are not processed fast enough, dropping packets")
 				}
-
-				// handle packet
-				err = h.storeData(ctx, data)
-				if err != nil {
-					logrus.WithError(err).Errorf("found error while handling packet")
+				src.dropped++
+			}
+
+		case now := <-ticker.C:
+			for key, src := range h.sources {
+				if src.dropped > 0 {
+					logrus.WithFields(sourceFields(src.Source)).Warnf("dropped %d packets", src.dropped)
+					src.dropped = 0
 				}
-				return err
-			}, pkt)
+				if now.Sub(src.lastPacket) < sourceIdleTimeout {
+					continue
+				}
+				logrus.WithFields(sourceFields(src.Source)).Infof("no packet received for %s, forgetting the source", sourceIdleTimeout)
+				// process ends the session of the source once its packets are handled
+				close(src.packets)
+				delete(h.sources, key)
+			}
 		}
-	}()
-
-	return h
+	}
 }
 
 // HandlerChan ...
-func (h *HandlerPacket) HandlerChan() chan []byte {
+func (h *HandlerPacket) HandlerChan() chan Packet {
 	return h.handlerChan
 }
 
+// process decodes the packets of a single source in the order they were received
+// and hands the documents over to be stored concurrently.
+func (h *HandlerPacket) process(ctx context.Context, src *source) {
+	defer func() {
+		h.store(ctx, src, src.close())
+	}()
+
+	for packet := range src.packets {
+		// decode packet to the correct one
+		raw, data, err := h.decodePacket(ctx, src, packet)
+
+		// documents left by the analyzers of a session that just ended
+		docs := src.drain()
+
+		if err != nil {
+			if !errors.Is(err, ErrIgnorePacket) {
+				logrus.WithError(err).WithFields(sourceFields(src.Source)).Errorf("found error while decoding packet")
+			}
+		} else {
+			docs = append(docs, data)
+			docs = append(docs, src.analyze(raw)...)
+		}
+
+		h.store(ctx, src, docs)
+	}
+}
+
+// store hands the documents of a source over to be stored concurrently
+func (h *HandlerPacket) store(ctx context.Context, src *source, docs []models.F1Data) {
+	for _, doc := range docs {
+		h.fanOut.Run(func(cast interface{}) error {
+			// handle packet
+			err := h.storeData(ctx, cast.(models.F1Data))
+			if err != nil {
+				logrus.WithError(err).WithFields(sourceFields(src.Source)).Errorf("found error while handling packet")
+			}
+			return err
+		}, doc)
+	}
+}
+
 // decodePacket ...
-func (h *HandlerPacket) decodePacket(ctx context.Context, packet []byte) (models.F1Data, error) {
+// It returns the packet decoded into its models/packet type along with the document to store.
+func (h *HandlerPacket) decodePacket(ctx context.Context, src *source, packet []byte) (interface{}, models.F1Data, error) {
 
 	reader := bytes.NewReader(packet)
 
 	header := f1packet.PacketHeader{}
 	err := binary.Read(reader, binary.LittleEndian, &header)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not decode header")
+		return nil, nil, errors.Wrap(err, "could not decode header")
 	}
 
 	if header.PacketFormat != F1Version {
-		return nil, ErrIgnorePacket
+		return nil, nil, ErrIgnorePacket
 	}
 
+	src.track(header)
+
 	reader.Reset(packet)
 
 	switch f1packet.PacketType(header.PacketID) {
@@ -94,14 +178,15 @@ func (h *HandlerPacket) decodePacket(ctx context.Context, packet []byte) (models
 		err = binary.Read(reader, binary.LittleEndian, placeholder)
 		if err != nil {
 			logrus.Errorf("Packet Header: %+v", header)
-			return nil, errors.Wrap(err, "could not decode binary data PacketMotionData")
+			return nil, nil, errors.Wrap(err, "could not decode binary data PacketMotionData")
 		}
 
 		data := models.NewMotionData(placeholder)
 		if data == nil {
-			return nil, ErrIgnorePacket
+			return nil, nil, ErrIgnorePacket
 		}
-		return data, nil
+		data.Header.Source = src.Source
+		return placeholder, data, nil
 
 	case f1packet.SessionPacket:
 
@@ -109,14 +194,15 @@ func (h *HandlerPacket) decodePacket(ctx context.Context, packet []byte) (models
 		err = binary.Read(reader, binary.LittleEndian, placeholder)
 		if err != nil {
 			logrus.Errorf("Packet Header: %+v", header)
-			return nil, errors.Wrap(err, "could not decode binary data PacketSessionData")
+			return nil, nil, errors.Wrap(err, "could not decode binary data PacketSessionData")
 		}
 
 		data := models.NewSessionData(placeholder)
 		if data == nil {
-			return nil, ErrIgnorePacket
+			return nil, nil, ErrIgnorePacket
 		}
-		return data, nil
+		data.Header.Source = src.Source
+		return placeholder, data, nil
 
 	case f1packet.LapDataPacket:
 
@@ -124,32 +210,34 @@ func (h *HandlerPacket) decodePacket(ctx context.Context, packet []byte) (models
 		err = binary.Read(reader, binary.LittleEndian, placeholder)
 		if err != nil {
 			logrus.Errorf("Packet Header: %+v", header)
-			return nil, errors.Wrap(err, "could not decode binary data PacketLapData")
+			return nil, nil, errors.Wrap(err, "could not decode binary data PacketLapData")
 		}
 
 		data := models.NewLapData(placeholder)
 		if data == nil {
-			return nil, ErrIgnorePacket
+			return nil, nil, ErrIgnorePacket
 		}
-		return data, nil
+		data.Header.Source = src.Source
+		return placeholder, data, nil
 
 	case f1packet.EventPacket:
 
 		// Ignore them for now
-		return nil, ErrIgnorePacket
+		return nil, nil, ErrIgnorePacket
 
 		placeholder := &f1packet.PacketEventData{}
 		err = binary.Read(reader, binary.LittleEndian, placeholder)
 		if err != nil {
 			logrus.Errorf("Packet Header: %+v", header)
-			return nil, errors.Wrap(err, "could not decode binary data PacketEventData")
+			return nil, nil, errors.Wrap(err, "could not decode binary data PacketEventData")
 		}
 
 		data := models.NewEventData(placeholder)
 		if data == nil {
-			return nil, ErrIgnorePacket
+			return nil, nil, ErrIgnorePacket
 		}
-		return data, nil
+		data.Header.Source = src.Source
+		return placeholder, data, nil
 
 	case f1packet.ParticipantsPacket:
 
@@ -157,14 +245,15 @@ func (h *HandlerPacket) decodePacket(ctx context.Context, packet []byte) (models
 		err = binary.Read(reader, binary.LittleEndian, placeholder)
 		if err != nil {
 			logrus.Errorf("Packet Header: %+v", header)
-			return nil, errors.Wrap(err, "could not decode binary data PacketParticipantsData")
+			return nil, nil, errors.Wrap(err, "could not decode binary data PacketParticipantsData")
 		}
 
 		data := models.NewParticipantsData(placeholder)
 		if data == nil {
-			return nil, ErrIgnorePacket
+			return nil, nil, ErrIgnorePacket
 		}
-		return data, nil
+		data.Header.Source = src.Source
+		return placeholder, data, nil
 
 	case f1packet.CarSetupsPacket:
 
@@ -172,14 +261,15 @@ func (h *HandlerPacket) decodePacket(ctx context.Context, packet []byte) (models
 		err = binary.Read(reader, binary.LittleEndian, placeholder)
 		if err != nil {
 			logrus.Errorf("Packet Header: %+v", header)
-			return nil, errors.Wrap(err, "could not decode binary data PacketCarSetupData")
+			return nil, nil, errors.Wrap(err, "could not decode binary data PacketCarSetupData")
 		}
 
 		data := models.NewCarSetupData(placeholder)
 		if data == nil {
-			return nil, ErrIgnorePacket
+			return nil, nil, ErrIgnorePacket
 		}
-		return data, nil
+		data.Header.Source = src.Source
+		return placeholder, data, nil
 
 	case f1packet.CarStatusPacket:
 
@@ -187,15 +277,16 @@ func (h *HandlerPacket) decodePacket(ctx context.Context, packet []byte) (models
 		err = binary.Read(reader, binary.LittleEndian, placeholder)
 		if err != nil {
 			logrus.Errorf("Packet Header: %+v", header)
-			return nil, errors.Wrap(err, "could not decode binary data PacketCarStatusData")
+			return nil, nil, errors.Wrap(err, "could not decode binary data PacketCarStatusData")
 		}
 
 		data := models.NewCarStatusData(placeholder)
 		if data == nil {
-			return nil, ErrIgnorePacket
+			return nil, nil, ErrIgnorePacket
 		}
+		data.Header.Source = src.Source
 
-		return data, nil
+		return placeholder, data, nil
 
 	case f1packet.CarTelemetryPacket:
 
@@ -203,14 +294,15 @@ func (h *HandlerPacket) decodePacket(ctx context.Context, packet []byte) (models
 		err = binary.Read(reader, binary.LittleEndian, placeholder)
 		if err != nil {
 			logrus.Errorf("Packet Header: %+v", header)
-			return nil, errors.Wrap(err, "could not decode binary data PacketCarTelemetryData")
+			return nil, nil, errors.Wrap(err, "could not decode binary data PacketCarTelemetryData")
 		}
 
 		data := models.NewCarTelemetryData(placeholder)
 		if data == nil {
-			return nil, ErrIgnorePacket
+			return nil, nil, ErrIgnorePacket
 		}
-		return data, nil
+		data.Header.Source = src.Source
+		return placeholder, data, nil
 
 	case f1packet.FinalClassificationPacket:
 
@@ -218,14 +310,15 @@ func (h *HandlerPacket) decodePacket(ctx context.Context, packet []byte) (models
 		err = binary.Read(reader, binary.LittleEndian, placeholder)
 		if err != nil {
 			logrus.Errorf("Packet Header: %+v", header)
-			return nil, errors.Wrap(err, "could not decode binary data PacketFinalClassificationData")
+			return nil, nil, errors.Wrap(err, "could not decode binary data PacketFinalClassificationData")
 		}
 
 		data := models.NewFinalClassificationData(placeholder)
 		if data == nil {
-			return nil, ErrIgnorePacket
+			return nil, nil, ErrIgnorePacket
 		}
-		return data, nil
+		data.Header.Source = src.Source
+		return placeholder, data, nil
 
 	case f1packet.LobbyInfoPacket:
 
@@ -233,16 +326,17 @@ func (h *HandlerPacket) decodePacket(ctx context.Context, packet []byte) (models
 		err = binary.Read(reader, binary.LittleEndian, placeholder)
 		if err != nil {
 			logrus.Errorf("Packet Header: %+v", header)
-			return nil, errors.Wrap(err, "could not decode binary data PacketLobbyInfoData")
+			return nil, nil, errors.Wrap(err, "could not decode binary data PacketLobbyInfoData")
 		}
 
 		data := models.NewLobbyInfoData(placeholder)
 		if data == nil {
-			return nil, ErrIgnorePacket
+			return nil, nil, ErrIgnorePacket
 		}
-		return data, nil
+		data.Header.Source = src.Source
+		return placeholder, data, nil
 	default:
-		return nil, ErrUnknownPacket
+		return nil, nil, ErrUnknownPacket
 	}
 }
 
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	f1packet "github.com/Tommy-42/f1-2020-go-telemetry/models/packet"
+)
+
+// source holds the state kept for a single sender.
+// Packets of a source are processed in order by a dedicated goroutine so the state of rigs never mixes.
+type source struct {
+	models.Source
+
+	packets    chan []byte
+	lastPacket time.Time // Time the latest packet was received, only accessed by the dispatching goroutine
+	dropped    uint64    // Number of packets dropped since last reported, only accessed by the dispatching goroutine
+
+	sessionUID uint64 // Session currently running on the rig
+	received   uint64 // Number of packets received for the current session
+
+	factories []analysis.Factory
+	analyzers []analysis.Analyzer // Analyzers of the current session
+	pending   []models.F1Data     // Documents flushed by the analyzers of the previous session
+}
+
+func newSource(s models.Source, factories []analysis.Factory) *source {
+	return &source{
+		Source:    s,
+		packets:   make(chan []byte, 1000),
+		factories: factories,
+	}
+}
+
+// track updates the state of the source with the header of a newly received packet
+func (s *source) track(header f1packet.PacketHeader) {
+	if header.SessionUID != s.sessionUID {
+		if s.sessionUID != 0 {
+			logrus.WithFields(sourceFields(s.Source)).Infof("session %d ended after %d packets", s.sessionUID, s.received)
+		}
+		logrus.WithFields(sourceFields(s.Source)).Infof("new session %d", header.SessionUID)
+		s.sessionUID = header.SessionUID
+		s.received = 0
+
+		for _, a := range s.analyzers {
+			s.pending = append(s.pending, a.Flush()...)
+		}
+		s.analyzers = make([]analysis.Analyzer, 0, len(s.factories))
+		for _, factory := range s.factories {
+			s.analyzers = append(s.analyzers, factory(s.Source))
+		}
+	}
+	s.received++
+}
+
+// analyze runs the analyzers of the current session over a decoded packet
+func (s *source) analyze(packet interface{}) []models.F1Data {
+	docs := []models.F1Data{}
+	for _, a := range s.analyzers {
+		docs = append(docs, a.Analyze(packet)...)
+	}
+	return docs
+}
+
+// close ends the session of a source gone quiet, it returns the documents flushed by its analyzers
+func (s *source) close() []models.F1Data {
+	docs := s.drain()
+	for _, a := range s.analyzers {
+		docs = append(docs, a.Flush()...)
+	}
+	s.analyzers = nil
+	return docs
+}
+
+// drain returns the documents flushed at the end of the previous session
+func (s *source) drain() []models.F1Data {
+	docs := s.pending
+	s.pending = nil
+	return docs
+}
+
+func sourceFields(s models.Source) logrus.Fields {
+	return logrus.Fields{
+		"address": s.Address,
+		"port":    s.Port,
+		"rig":     s.Rig,
+		"driver":  s.Driver,
+	}
+}
@@ -2,15 +2,53 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/bestlap"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/braking"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/compare"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/corner"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/fuel"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/incident"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/lap"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/overtake"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/pitstop"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/records"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/stint"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/summary"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/timing"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/trace"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/trackmap"
+	"github.com/Tommy-42/f1-2020-go-telemetry/analysis/traction"
+	"github.com/Tommy-42/f1-2020-go-telemetry/api"
+	"github.com/Tommy-42/f1-2020-go-telemetry/api/rpc"
+	"github.com/Tommy-42/f1-2020-go-telemetry/capture"
 	"github.com/Tommy-42/f1-2020-go-telemetry/config"
+	"github.com/Tommy-42/f1-2020-go-telemetry/models"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository"
 	"github.com/Tommy-42/f1-2020-go-telemetry/repository/elastic"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/influx"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/kafka"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/mqtt"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/nats"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/postgres"
+	"github.com/Tommy-42/f1-2020-go-telemetry/repository/sqlite"
 	"github.com/Tommy-42/f1-2020-go-telemetry/service/handler"
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	// minReadBackoff and maxReadBackoff bound the time waited before reading again from a failing udp socket
+	minReadBackoff = 10 * time.Millisecond
+	maxReadBackoff = 5 * time.Second
+)
+
 // Service ...
 type Service struct {
 	buffer []byte
@@ -25,28 +63,366 @@ func NewService() *Service {
 // Start ...
 func (s *Service) Start(ctx context.Context) error {
 
-	config := config.Config{
-		Elastic: elastic.DefaultConfig(),
+	conf := config.Config{
+		Repositories: []string{config.RepositoryElastic},
+		Elastic:      elastic.DefaultConfig(),
+		Influx:       influx.DefaultConfig(),
+		Postgres:     postgres.DefaultConfig(),
+		SQLite:       sqlite.DefaultConfig(),
+		Kafka:        kafka.DefaultConfig(),
+		MQTT:         mqtt.DefaultConfig(),
+		NATS:         nats.DefaultConfig(),
+		Ports:        []int{config.DefaultPort},
+	}
+
+	if env := os.Getenv("F1_REPOSITORIES"); env != "" {
+		repositories, err := config.ParseRepositories(env)
+		if err != nil {
+			logrus.WithError(err).Error("could not parse F1_REPOSITORIES")
+			return err
+		}
+		conf.Repositories = repositories
 	}
 
 	if es := os.Getenv("ELASTICSEARCH_HOST"); es != "" {
-		config.Elastic.Addresses = []string{es}
+		conf.Elastic.Addresses = []string{es}
+	}
+
+	if host := os.Getenv("INFLUXDB_HOST"); host != "" {
+		conf.Influx.Address = host
+	}
+	if org := os.Getenv("INFLUXDB_ORG"); org != "" {
+		conf.Influx.Org = org
+	}
+	if bucket := os.Getenv("INFLUXDB_BUCKET"); bucket != "" {
+		conf.Influx.Bucket = bucket
+	}
+	conf.Influx.Token = os.Getenv("INFLUXDB_TOKEN")
+	conf.Influx.Database = os.Getenv("INFLUXDB_DATABASE")
+
+	if url := os.Getenv("POSTGRES_URL"); url != "" {
+		conf.Postgres.URL = url
+	}
+	conf.Postgres.Timescale = os.Getenv("POSTGRES_TIMESCALE") == "true"
+
+	if path := os.Getenv("SQLITE_PATH"); path != "" {
+		conf.SQLite.Path = path
+	}
+
+	if brokers := os.Getenv("KAFKA_BROKERS"); brokers != "" {
+		conf.Kafka.Brokers = strings.Split(brokers, ",")
 	}
+	if prefix, ok := os.LookupEnv("KAFKA_TOPIC_PREFIX"); ok {
+		conf.Kafka.TopicPrefix = prefix
+	}
+	if acks := os.Getenv("KAFKA_ACKS"); acks != "" {
+		conf.Kafka.Acks = acks
+	}
+	if compression := os.Getenv("KAFKA_COMPRESSION"); compression != "" {
+		conf.Kafka.Compression = compression
+	}
+	if env := os.Getenv("KAFKA_BATCH_SIZE"); env != "" {
+		size, err := strconv.Atoi(env)
+		if err != nil {
+			logrus.WithError(err).Error("could not parse KAFKA_BATCH_SIZE")
+			return err
+		}
+		conf.Kafka.BatchSize = size
+	}
+	if env := os.Getenv("KAFKA_FLUSH_INTERVAL"); env != "" {
+		interval, err := time.ParseDuration(env)
+		if err != nil {
+			logrus.WithError(err).Error("could not parse KAFKA_FLUSH_INTERVAL")
+			return err
+		}
+		conf.Kafka.FlushInterval = interval
+	}
+
+	if broker := os.Getenv("MQTT_BROKER"); broker != "" {
+		conf.MQTT.Broker = broker
+	}
+	if id := os.Getenv("MQTT_CLIENT_ID"); id != "" {
+		conf.MQTT.ClientID = id
+	}
+	conf.MQTT.Username = os.Getenv("MQTT_USERNAME")
+	conf.MQTT.Password = os.Getenv("MQTT_PASSWORD")
+	if prefix := os.Getenv("MQTT_TOPIC_PREFIX"); prefix != "" {
+		conf.MQTT.TopicPrefix = prefix
+	}
+	if env := os.Getenv("MQTT_QOS"); env != "" {
+		qos, err := strconv.ParseUint(env, 10, 8)
+		if err != nil {
+			logrus.WithError(err).Error("could not parse MQTT_QOS")
+			return err
+		}
+		conf.MQTT.QoS = byte(qos)
+	}
+	if env := os.Getenv("MQTT_INTERVAL"); env != "" {
+		interval, err := time.ParseDuration(env)
+		if err != nil {
+			logrus.WithError(err).Error("could not parse MQTT_INTERVAL")
+			return err
+		}
+		conf.MQTT.Interval = interval
+	}
+	if env := os.Getenv("MQTT_SLOW_INTERVAL"); env != "" {
+		interval, err := time.ParseDuration(env)
+		if err != nil {
+			logrus.WithError(err).Error("could not parse MQTT_SLOW_INTERVAL")
+			return err
+		}
+		conf.MQTT.SlowInterval = interval
+	}
+
+	if url := os.Getenv("NATS_URL"); url != "" {
+		conf.NATS.URL = url
+	}
+	if prefix := os.Getenv("NATS_SUBJECT_PREFIX"); prefix != "" {
+		conf.NATS.SubjectPrefix = prefix
+	}
+	conf.NATS.Stream = os.Getenv("NATS_STREAM")
+	if env := os.Getenv("NATS_MAX_AGE"); env != "" {
+		age, err := time.ParseDuration(env)
+		if err != nil {
+			logrus.WithError(err).Error("could not parse NATS_MAX_AGE")
+			return err
+		}
+		conf.NATS.MaxAge = age
+	}
+
+	if env := os.Getenv("F1_UDP_PORTS"); env != "" {
+		ports, err := config.ParsePorts(env)
+		if err != nil {
+			logrus.WithError(err).Error("could not parse F1_UDP_PORTS")
+			return err
+		}
+		conf.Ports = ports
+	}
+
+	if env := os.Getenv("F1_RIGS"); env != "" {
+		rigs, err := config.ParseRigs(env)
+		if err != nil {
+			logrus.WithError(err).Error("could not parse F1_RIGS")
+			return err
+		}
+		conf.Rigs = rigs
+	}
+
+	conf.APIAddress = os.Getenv("F1_API_ADDRESS")
+	conf.GRPCAddress = os.Getenv("F1_GRPC_ADDRESS")
+	conf.CaptureDir = os.Getenv("F1_CAPTURE")
+
+	// the live timing towers are computed by the analyzers and read by the mqtt repository
+	towers := timing.NewTowers()
+
+	repos := repository.Multi{}
+	var sqliteRepo *sqlite.SQLite
+	defer func() {
+		if err := repos.Close(); err != nil {
+			logrus.WithError(err).Error("could not close repositories")
+		}
+	}()
+	for _, name := range conf.Repositories {
+		logrus.Infof("starting New Repository %s", name)
+		switch name {
+		case config.RepositoryElastic:
+			esRepo, err := elastic.NewES(conf.Elastic)
+			if err != nil {
+				logrus.WithError(err).Error("could not start elastic repository")
+				return err
+			}
+			repos = append(repos, esRepo)
 
-	logrus.Info("starting New Repository")
-	esRepo, err := elastic.NewES(config.Elastic)
+		case config.RepositoryInflux:
+			influxRepo, err := influx.NewInflux(conf.Influx)
+			if err != nil {
+				logrus.WithError(err).Error("could not start influx repository")
+				return err
+			}
+			repos = append(repos, influxRepo)
+
+		case config.RepositoryPostgres:
+			postgresRepo, err := postgres.NewPostgres(conf.Postgres)
+			if err != nil {
+				logrus.WithError(err).Error("could not start postgres repository")
+				return err
+			}
+			repos = append(repos, postgresRepo)
+
+		case config.RepositorySQLite:
+			repo, err := sqlite.NewSQLite(conf.SQLite)
+			if err != nil {
+				logrus.WithError(err).Error("could not start sqlite repository")
+				return err
+			}
+			sqliteRepo = repo
+			repos = append(repos, sqliteRepo)
+
+		case config.RepositoryKafka:
+			kafkaRepo, err := kafka.NewKafka(conf.Kafka)
+			if err != nil {
+				logrus.WithError(err).Error("could not start kafka repository")
+				return err
+			}
+			repos = append(repos, kafkaRepo)
+
+		case config.RepositoryMQTT:
+			mqttRepo, err := mqtt.NewMQTT(conf.MQTT, towers)
+			if err != nil {
+				logrus.WithError(err).Error("could not start mqtt repository")
+				return err
+			}
+			repos = append(repos, mqttRepo)
+
+		case config.RepositoryNATS:
+			natsRepo, err := nats.NewNATS(conf.NATS)
+			if err != nil {
+				logrus.WithError(err).Error("could not start nats repository")
+				return err
+			}
+			repos = append(repos, natsRepo)
+		}
+	}
+
+	// the grpc server streams the documents to its subscribers as a repository
+	var grpcServer *rpc.Server
+	if conf.GRPCAddress != "" {
+		grpcServer = rpc.NewServer(conf.GRPCAddress, sqliteRepo)
+		repos = append(repos, grpcServer)
+	}
+
+	fuelHistory, err := fuel.NewHistory(os.Getenv("F1_FUEL_HISTORY"))
+	if err != nil {
+		logrus.WithError(err).Error("could not load fuel history")
+		return err
+	}
+
+	trackMaps, err := trackmap.NewStore(os.Getenv("F1_TRACK_MAPS"))
+	if err != nil {
+		logrus.WithError(err).Error("could not open track map store")
+		return err
+	}
+
+	corners, err := corner.NewStore(os.Getenv("F1_CORNERS"))
+	if err != nil {
+		logrus.WithError(err).Error("could not open corner store")
+		return err
+	}
+
+	laps, err := compare.NewStore(os.Getenv("F1_LAPS"))
+	if err != nil {
+		logrus.WithError(err).Error("could not open lap store")
+		return err
+	}
+
+	summaries, err := summary.NewStore(os.Getenv("F1_SUMMARIES"))
+	if err != nil {
+		logrus.WithError(err).Error("could not open summary store")
+		return err
+	}
+
+	personalBests, err := records.NewStore(os.Getenv("F1_RECORDS"))
 	if err != nil {
-		logrus.WithError(err).Error("could not start elastic repository")
+		logrus.WithError(err).Error("could not load records")
 		return err
 	}
 
+	bestLaps := bestlap.NewReports()
+
 	logrus.Info("starting New Handler Packet")
-	handlerPacket := handler.NewHandlerPacket(esRepo)
+	handlerPacket := handler.NewHandlerPacket(repos,
+		lap.New,
+		stint.New,
+		fuel.NewFactory(fuelHistory),
+		pitstop.New,
+		trackmap.NewFactory(trackMaps),
+		timing.NewFactory(towers),
+		overtake.NewFactory(corners),
+		incident.New,
+		summary.NewFactory(summaries),
+		records.NewFactory(personalBests),
+		trace.NewFactory(
+			corner.NewFactory(corners),
+			braking.New,
+			traction.NewFactory(corners),
+			bestlap.NewFactory(bestLaps),
+			compare.NewFactory(laps),
+		),
+	)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-	logrus.Info("starting Listening on UDP port 20777")
-	udp, err := net.ResolveUDPAddr("udp4", ":20777")
+	var packets *capture.Writer
+	if conf.CaptureDir != "" {
+		if err := os.MkdirAll(conf.CaptureDir, 0755); err != nil {
+			logrus.WithError(err).Errorf("could not create capture directory %s", conf.CaptureDir)
+			return err
+		}
+		path := filepath.Join(conf.CaptureDir, fmt.Sprintf("capture-%s.f1cap", time.Now().UTC().Format("20060102-150405")))
+		f, err := os.Create(path)
+		if err != nil {
+			logrus.WithError(err).Errorf("could not create capture %s", path)
+			return err
+		}
+		packets, err = capture.NewWriter(f)
+		if err != nil {
+			f.Close()
+			logrus.WithError(err).Errorf("could not start capture %s", path)
+			return err
+		}
+		defer func() {
+			if err := packets.Close(); err != nil {
+				logrus.WithError(err).Errorf("could not close capture %s", path)
+			}
+		}()
+		logrus.Infof("capturing packets to %s", path)
+	}
+
+	errs := make(chan error, len(conf.Ports)+2)
+	for _, port := range conf.Ports {
+		go func(port int) {
+			errs <- s.listen(ctx, conf, port, handlerPacket, packets)
+		}(port)
+	}
+
+	if conf.APIAddress != "" {
+		server := api.NewServer(conf.APIAddress)
+		server.Handle("/theoretical-best", bestLaps)
+		server.Handle("/timing", towers)
+		server.Handle("/timing/stream", timing.StreamHandler(towers))
+		server.Handle("/leaderboard", records.LeaderboardHandler(personalBests))
+		server.Handle("/records", records.DriverHandler(personalBests))
+		server.Handle("/laps", compare.LapsHandler(laps))
+		server.Handle("/compare", compare.Handler(laps))
+		if sqliteRepo != nil {
+			server.Handle("/history/sessions", sqlite.SessionsHandler(sqliteRepo))
+			server.Handle("/history/laps", sqlite.LapsHandler(sqliteRepo))
+			server.Handle("/history/telemetry", sqlite.TelemetryHandler(sqliteRepo))
+		}
+		go func() {
+			errs <- server.Start(ctx)
+		}()
+	}
+
+	if grpcServer != nil {
+		go func() {
+			errs <- grpcServer.Start(ctx)
+		}()
+	}
+
+	// the first listener to stop takes the others down with it
+	return <-errs
+}
+
+// listen reads the packets sent to a UDP port and forwards them, tagged with their source, to the handler.
+// The packets are captured too when packets is not nil.
+func (s *Service) listen(ctx context.Context, conf config.Config, port int, handlerPacket *handler.HandlerPacket, packets *capture.Writer) error {
+
+	logrus.Infof("starting Listening on UDP port %d", port)
+	udp, err := net.ResolveUDPAddr("udp4", fmt.Sprintf(":%d", port))
 	if err != nil {
-		logrus.WithError(err).Errorf("could not resolve udp addr with the port %s", "20777")
+		logrus.WithError(err).Errorf("could not resolve udp addr with the port %d", port)
 		return err
 	}
 
@@ -57,19 +433,62 @@ func (s *Service) Start(ctx context.Context) error {
 	}
 	defer connection.Close()
 
+	go func() {
+		// unblock ReadFromUDP once the context is cancelled
+		<-ctx.Done()
+		connection.Close()
+	}()
+
 	buffer := make([]byte, 2048)
+	backoff := time.Duration(0)
 	for {
+		n, addr, err := connection.ReadFromUDP(buffer)
 		if err := ctx.Err(); err != nil {
 			logrus.WithError(err).Errorf("context cancelled")
 			return err
 		}
-
-		n, addr, err := connection.ReadFromUDP(buffer)
 		if err != nil {
-			logrus.WithError(err).Errorf("error reading from udp, received %d from %s:%d", n, addr.IP.String(), addr.Port)
+			// a socket failing persistently is retried less and less often
+			backoff *= 2
+			if backoff < minReadBackoff {
+				backoff = minReadBackoff
+			} else if backoff > maxReadBackoff {
+				backoff = maxReadBackoff
+			}
+			logrus.WithError(err).Errorf("error reading from udp on port %d, received %d, retrying in %s", port, n, backoff)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			continue
 		}
+		backoff = 0
 		// logrus.Debugf("reading from udp, received %d from %s:%d", n, addr.IP.String(), addr.Port)
-		handlerPacket.HandlerChan() <- buffer
+
+		source := models.Source{
+			Address: addr.String(),
+			Port:    port,
+		}
+		if rig, ok := conf.RigFor(addr.IP, port); ok {
+			source.Rig = rig.Name
+			source.Driver = rig.Driver
+		}
+
+		// the buffer is reused by the next read, the handler gets its own copy
+		data := make([]byte, n)
+		copy(data, buffer[:n])
+
+		if packets != nil {
+			if err := packets.Write(time.Now(), source, data); err != nil {
+				logrus.WithError(err).Errorf("could not capture packet from %s", addr.String())
+			}
+		}
+
+		handlerPacket.HandlerChan() <- handler.Packet{
+			Source: source,
+			Data:   data,
+		}
 	}
 }
 